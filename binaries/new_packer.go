@@ -6,6 +6,7 @@ import (
 	"github.com/ed3899/kumo/common/iota"
 	"github.com/ed3899/kumo/manager"
 	"github.com/ed3899/kumo/utils/cmd"
+	"github.com/ed3899/kumo/utils/tool_events"
 	"github.com/samber/oops"
 )
 
@@ -46,14 +47,12 @@ func (p *Packer) Init() error {
 }
 
 func (p *Packer) Build() error {
-
-	_cmd := exec.Command(p.Path, "build", ".")
 	oopsBuilder := oops.
 		Code("Build").
 		In("binaries").
 		Tags("Packer")
 
-	err := cmd.RunCmdAndStream(_cmd)
+	err := p.run("build", ".")
 	if err != nil {
 		err := oopsBuilder.
 			Wrapf(err, "Error occured running and streaming packer build command")
@@ -65,4 +64,6 @@ func (p *Packer) Build() error {
 
 type Packer struct {
 	Path string
+	// Set to run with -machine-readable and get the parsed events instead of the raw output, see run.
+	OnEvent func(*tool_events.Event)
 }
@@ -1,11 +1,15 @@
 package binaries
 
 import (
+	"fmt"
 	"os/exec"
 
+	"github.com/ed3899/kumo/common/constants"
 	"github.com/ed3899/kumo/common/iota"
 	"github.com/ed3899/kumo/manager"
 	"github.com/ed3899/kumo/utils/cmd"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/ed3899/kumo/utils/tool_events"
 	"github.com/samber/oops"
 )
 
@@ -47,14 +51,37 @@ func (t *Terraform) Init() error {
 	return err
 }
 
+// Selects the workspace, creating it if it doesn't exist yet. Every kumo environment has its own workspace, and
+// therefore its own state.
+func (t *Terraform) SelectWorkspace(name string) error {
+	oopsBuilder := oops.
+		Code("SelectWorkspace").
+		In("binaries").
+		Tags("Terraform").
+		With("name", name)
+
+	_cmd := exec.Command(t.Path, "workspace", "select", "-or-create", name)
+
+	err := cmd.RunCmdAndStream(_cmd)
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "Error occured while running and streaming terraform workspace select command")
+
+		return err
+	}
+
+	return nil
+}
+
+// Applies the config without terraform asking for approval, it can't read the answer from a streamed command.
+// Callers ask first, see cmd.ConfirmCost, or use Plan and ApplyPlan to review the changes.
 func (t *Terraform) Apply() error {
-	_cmd := exec.Command(t.Path, "apply", "-auto-approve")
 	oopsBuilder := oops.
-		Code("Init").
+		Code("Apply").
 		In("binaries").
 		Tags("Terraform")
 
-	err := cmd.RunCmdAndStream(_cmd)
+	err := t.run("apply", "-auto-approve")
 	if err != nil {
 		err = oopsBuilder.
 			Wrapf(err, "Error occured while running and streaming terraform apply command")
@@ -65,15 +92,140 @@ func (t *Terraform) Apply() error {
 	return nil
 }
 
+// Saves the plan to apply, or to destroy everything, in the plan file. The plan is applied with ApplyPlan.
+func (t *Terraform) Plan(pathToPlan string, destroy bool) error {
+	oopsBuilder := oops.
+		Code("Plan").
+		In("binaries").
+		Tags("Terraform").
+		With("pathToPlan", pathToPlan).
+		With("destroy", destroy)
+
+	args := []string{fmt.Sprintf("-out=%s", pathToPlan)}
+	if destroy {
+		args = append(args, "-destroy")
+	}
+
+	err := t.run("plan", args...)
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "Error occured while running and streaming terraform plan command")
+
+		return err
+	}
+
+	return nil
+}
+
+// Returns the plan file as printed by terraform show -json, see terraform_plan.ParseTerraformPlan.
+func (t *Terraform) ShowPlan(pathToPlan string) ([]byte, error) {
+	oopsBuilder := oops.
+		Code("ShowPlan").
+		In("binaries").
+		Tags("Terraform").
+		With("pathToPlan", pathToPlan)
+
+	output, err := exec.Command(t.Path, "show", "-json", pathToPlan).Output()
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			oopsBuilder = oopsBuilder.With("stderr", string(exitError.Stderr))
+		}
+
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occured while running terraform show command")
+	}
+
+	return output, nil
+}
+
+// Returns the instance outputs of the last apply as printed by terraform output -json, i.e its id, public IPv4 or
+// IPv6, DNS name and availability zone.
+func (t *Terraform) Output() (*terraform_state.TerraformOutputs, error) {
+	oopsBuilder := oops.
+		Code("Output").
+		In("binaries").
+		Tags("Terraform")
+
+	output, err := exec.Command(t.Path, "output", "-json").Output()
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			oopsBuilder = oopsBuilder.With("stderr", string(exitError.Stderr))
+		}
+
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occured while running terraform output command")
+	}
+
+	outputs, err := terraform_state.ParseTerraformOutputs(output)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occured while parsing terraform outputs")
+	}
+
+	return outputs, nil
+}
+
+// Applies a plan saved by Plan. Terraform doesn't ask for approval of a saved plan, and refuses it if the state
+// changed since it was saved.
+func (t *Terraform) ApplyPlan(pathToPlan string) error {
+	oopsBuilder := oops.
+		Code("ApplyPlan").
+		In("binaries").
+		Tags("Terraform").
+		With("pathToPlan", pathToPlan)
+
+	err := t.run("apply", pathToPlan)
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "Error occured while running and streaming terraform apply command")
+
+		return err
+	}
+
+	return nil
+}
+
+// Saves the plan to put the instance in the given state, either running or stopped, in the plan file. Only the
+// targets and what they depend on are planned, so callers check the plan before applying it with ApplyPlan.
+func (t *Terraform) PlanInstanceState(
+	pathToPlan,
+	state string,
+	targets []string,
+) error {
+	oopsBuilder := oops.
+		Code("PlanInstanceState").
+		In("binaries").
+		Tags("Terraform").
+		With("pathToPlan", pathToPlan).
+		With("state", state).
+		With("targets", targets)
+
+	args := []string{
+		fmt.Sprintf("-out=%s", pathToPlan),
+		"-var", fmt.Sprintf("%s=%s", constants.TERRAFORM_INSTANCE_STATE_VAR, state),
+	}
+	for _, target := range targets {
+		args = append(args, fmt.Sprintf("-target=%s", target))
+	}
+
+	err := t.run("plan", args...)
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "Error occured while running and streaming terraform plan command")
+
+		return err
+	}
+
+	return nil
+}
+
 func (t *Terraform) Destroy() error {
 	oopsBuilder := oops.
 		Code("Destroy").
 		In("binaries").
 		Tags("Terraform")
 
-	_cmd := exec.Command(t.Path, "destroy", "-auto-approve")
-
-	err := cmd.RunCmdAndStream(_cmd)
+	err := t.run("destroy", "-auto-approve")
 	if err != nil {
 		err := oopsBuilder.
 			Wrapf(err, "Error occured while running and streaming terraform destroy command")
@@ -86,4 +238,6 @@ func (t *Terraform) Destroy() error {
 
 type Terraform struct {
 	Path string
+	// Set to run with -json and get the parsed events instead of the raw output, see run.
+	OnEvent func(*tool_events.Event)
 }
@@ -0,0 +1,35 @@
+package binaries
+
+import (
+	"os/exec"
+
+	"github.com/ed3899/kumo/utils/cmd"
+	"github.com/ed3899/kumo/utils/tool_events"
+)
+
+// Runs a packer subcommand that supports -machine-readable. Without OnEvent its output is streamed as is,
+// otherwise it runs with -machine-readable and every event is handed to OnEvent. Lines that aren't machine
+// readable are handed over as messages.
+func (p *Packer) run(subcommand string, args ...string) error {
+	if p.OnEvent == nil {
+		return cmd.RunCmdAndStream(exec.Command(p.Path, append([]string{subcommand}, args...)...))
+	}
+
+	_cmd := exec.Command(p.Path, append([]string{subcommand, "-machine-readable"}, args...)...)
+
+	return cmd.RunCmdAndScan(_cmd, func(line []byte) {
+		event, err := tool_events.ParsePackerEvent(string(line))
+		if err != nil {
+			event = &tool_events.Event{
+				Tool:    "packer",
+				Kind:    tool_events.MESSAGE,
+				Level:   tool_events.LEVEL_INFO,
+				Message: string(line),
+			}
+		}
+
+		if event != nil {
+			p.OnEvent(event)
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package binaries
+
+import (
+	"os/exec"
+
+	"github.com/ed3899/kumo/utils/cmd"
+	"github.com/ed3899/kumo/utils/tool_events"
+)
+
+// Runs a terraform subcommand that supports -json. Without OnEvent its output is streamed as is, otherwise it
+// runs with -json and every line is handed to OnEvent. Lines that aren't json are handed over as messages.
+func (t *Terraform) run(subcommand string, args ...string) error {
+	if t.OnEvent == nil {
+		return cmd.RunCmdAndStream(exec.Command(t.Path, append([]string{subcommand}, args...)...))
+	}
+
+	_cmd := exec.Command(t.Path, append([]string{subcommand, "-json"}, args...)...)
+
+	return cmd.RunCmdAndScan(_cmd, func(line []byte) {
+		event, err := tool_events.ParseTerraformEvent(line)
+		if err != nil {
+			event = &tool_events.Event{
+				Tool:    "terraform",
+				Kind:    tool_events.MESSAGE,
+				Level:   tool_events.LEVEL_INFO,
+				Message: string(line),
+			}
+		}
+
+		t.OnEvent(event)
+	})
+}
@@ -1,9 +1,13 @@
 package tests
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/ed3899/kumo/binaries"
 	"github.com/ed3899/kumo/common/iota"
 	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/tool_events"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -42,3 +46,25 @@ var _ = Describe("NewPacker", func() {
 		})
 	})
 })
+
+var _ = Describe("Build with OnEvent", Label("unit"), func() {
+	It("should run with -machine-readable and hand over the events", func() {
+		// A fake packer printing its arguments, then the artifact it built.
+		exePath := filepath.Join(GinkgoT().TempDir(), "packer")
+		Expect(os.WriteFile(exePath, []byte("#!/bin/sh\necho \"1690000000,,ui,say,$*\"\necho '1690000000,amazon-ebs.ubuntu,artifact-count,1'\necho '1690000000,amazon-ebs.ubuntu,artifact,0,id,us-east-1:ami-0c3fd0f5d33134a76'\n"), 0755)).To(Succeed())
+
+		events := []*tool_events.Event{}
+		packer := &binaries.Packer{
+			Path: exePath,
+			OnEvent: func(event *tool_events.Event) {
+				events = append(events, event)
+			},
+		}
+
+		Expect(packer.Build()).To(Succeed())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Message).To(Equal("build -machine-readable ."))
+		Expect(events[1].Kind).To(Equal(tool_events.ARTIFACT))
+		Expect(events[1].Id).To(Equal("us-east-1:ami-0c3fd0f5d33134a76"))
+	})
+})
@@ -1,9 +1,13 @@
 package tests
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/ed3899/kumo/binaries"
 	"github.com/ed3899/kumo/common/iota"
 	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/tool_events"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -41,4 +45,74 @@ var _ = Describe("NewTerraform", func() {
 			Expect(terraform).To(BeNil())
 		})
 	})
-})
\ No newline at end of file
+})
+var _ = Describe("ShowPlan", Label("unit"), func() {
+	It("should return what terraform show -json prints", func() {
+		// A fake terraform printing its arguments.
+		exePath := filepath.Join(GinkgoT().TempDir(), "terraform")
+		Expect(os.WriteFile(exePath, []byte("#!/bin/sh\necho \"{\\\"args\\\": \\\"$*\\\"}\"\n"), 0755)).To(Succeed())
+
+		terraform := &binaries.Terraform{Path: exePath}
+
+		output, err := terraform.ShowPlan("up.tfplan")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output).To(MatchJSON(`{"args": "show -json up.tfplan"}`))
+	})
+
+	It("should return an error when terraform fails", func() {
+		exePath := filepath.Join(GinkgoT().TempDir(), "terraform")
+		Expect(os.WriteFile(exePath, []byte("#!/bin/sh\necho 'no plan' >&2\nexit 1\n"), 0755)).To(Succeed())
+
+		terraform := &binaries.Terraform{Path: exePath}
+
+		_, err := terraform.ShowPlan("up.tfplan")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Output", Label("unit"), func() {
+	It("should return the outputs printed by terraform output -json", func() {
+		// A fake terraform printing an IPv6 only instance when called with output -json.
+		exePath := filepath.Join(GinkgoT().TempDir(), "terraform")
+		Expect(os.WriteFile(exePath, []byte("#!/bin/sh\n[ \"$*\" = 'output -json' ] || exit 1\necho '{\"instance_id\": {\"value\": \"i-0a1b2c3d4e5f67890\"}, \"public_ip\": {\"value\": \"\"}, \"public_ipv6\": {\"value\": \"2600:1f18::1\"}}'\n"), 0755)).To(Succeed())
+
+		terraform := &binaries.Terraform{Path: exePath}
+
+		outputs, err := terraform.Output()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outputs.InstanceId).To(Equal("i-0a1b2c3d4e5f67890"))
+		Expect(outputs.Address()).To(Equal("2600:1f18::1"))
+	})
+
+	It("should return an error when terraform fails", func() {
+		exePath := filepath.Join(GinkgoT().TempDir(), "terraform")
+		Expect(os.WriteFile(exePath, []byte("#!/bin/sh\necho 'no state' >&2\nexit 1\n"), 0755)).To(Succeed())
+
+		terraform := &binaries.Terraform{Path: exePath}
+
+		_, err := terraform.Output()
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Apply with OnEvent", Label("unit"), func() {
+	It("should run with -json and hand over the events", func() {
+		// A fake terraform printing its arguments as an event, then a line that isn't json.
+		exePath := filepath.Join(GinkgoT().TempDir(), "terraform")
+		Expect(os.WriteFile(exePath, []byte("#!/bin/sh\necho \"{\\\"@level\\\": \\\"info\\\", \\\"@message\\\": \\\"$*\\\", \\\"type\\\": \\\"version\\\"}\"\necho 'not json'\n"), 0755)).To(Succeed())
+
+		events := []*tool_events.Event{}
+		terraform := &binaries.Terraform{
+			Path: exePath,
+			OnEvent: func(event *tool_events.Event) {
+				events = append(events, event)
+			},
+		}
+
+		Expect(terraform.Apply()).To(Succeed())
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Message).To(Equal("apply -json -auto-approve"))
+		Expect(events[1].Kind).To(Equal(tool_events.MESSAGE))
+		Expect(events[1].Message).To(Equal("not json"))
+	})
+})
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/ed3899/kumo/binaries"
+	"github.com/ed3899/kumo/utils/terraform_plan"
+	"github.com/samber/oops"
+)
+
+// Prints the plan saved by terraform plan and applies it once confirm agrees. Returns whether it was applied.
+// An applied plan is removed, since terraform refuses to apply it twice. A declined plan is kept to be applied
+// later with --from-plan.
+//
+// Example:
+//
+//	(os.Stdout, terraform, "terraform/aws/up.tfplan", confirm) -> answer "y" -> (true, nil)
+func ApplySavedPlan(
+	writer io.Writer,
+	terraform *binaries.Terraform,
+	pathToPlan string,
+	confirm func() (bool, error),
+) (bool, error) {
+	oopsBuilder := oops.
+		Code("ApplySavedPlan").
+		In("cmd").
+		With("pathToPlan", pathToPlan)
+
+	content, err := terraform.ShowPlan(pathToPlan)
+	if err != nil {
+		return false, oopsBuilder.
+			Wrapf(err, "failed to show plan")
+	}
+
+	plan, err := terraform_plan.ParseTerraformPlan(content)
+	if err != nil {
+		return false, oopsBuilder.
+			Wrapf(err, "failed to parse plan")
+	}
+
+	err = PrintTerraformPlan(writer, plan)
+	if err != nil {
+		return false, oopsBuilder.
+			Wrapf(err, "failed to print plan")
+	}
+
+	confirmed, err := confirm()
+	if err != nil {
+		return false, oopsBuilder.
+			Wrapf(err, "failed to confirm plan")
+	}
+	if !confirmed {
+		return false, nil
+	}
+
+	err = terraform.ApplyPlan(pathToPlan)
+	if err != nil {
+		return false, oopsBuilder.
+			Wrapf(err, "failed to apply plan")
+	}
+
+	err = os.Remove(pathToPlan)
+	if err != nil {
+		return true, oopsBuilder.
+			Wrapf(err, "failed to remove applied plan")
+	}
+
+	return true, nil
+}
@@ -1,50 +1,44 @@
 package cmd
 
 import (
+	"fmt"
 	"log"
-	"os"
 
 	"github.com/ed3899/kumo/binaries"
 	"github.com/ed3899/kumo/common/iota"
-	"github.com/ed3899/kumo/download"
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/prompt"
 
 	"github.com/samber/oops"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // Returns a cobra command. The build command is used to build an AMI with ready to use tools.
 func Build() *cobra.Command {
-	return &cobra.Command{
+	var (
+		_config *config.Config
+		yes     bool
+	)
+
+	command := &cobra.Command{
 		Use:   "build",
 		Short: "Build an AMI with ready to use tools",
-		Long:  `Build an AMI with ready to use tools.`,
+		Long: `Build an AMI with ready to use tools. The estimated cost of the temporary instance used to build is
+		shown before building, use --yes to build without being asked.`,
 		PreRun: func(cmd *cobra.Command, args []string) {
 			oopsBuilder := oops.
 				Code("Build").
 				In("cmd").
 				Tags("Cobra", "PreRun")
 
-			cwd, err := os.Getwd()
-			if err != nil {
-				log.Fatalf(
-					"%+v",
-					oopsBuilder.
-						Wrapf(err, "Error occurred while getting current working directory"),
-				)
-			}
-
-			viper.SetConfigName("kumo.config")
-			viper.SetConfigType("yaml")
-			viper.AddConfigPath(cwd)
-
-			err = viper.ReadInConfig()
+			var err error
+			_config, err = ReadConfig()
 			if err != nil {
 				log.Fatalf(
 					"%+v",
 					oopsBuilder.
-						Wrapf(err, "Error occurred while reading config file. Make sure a kumo.config.yaml file exists in the current working directory"),
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
 				)
 			}
 		},
@@ -63,7 +57,7 @@ func Build() *cobra.Command {
 				}
 			}()
 
-			_manager, err := manager.NewManager(iota.CloudIota(viper.GetString("cloud")), iota.Packer)
+			_manager, err := manager.NewManager(iota.CloudIota(_config.Cloud), iota.Packer, _config)
 			if err != nil {
 				err := oopsBuilder.
 					Wrapf(err, "failed to create new manager")
@@ -71,6 +65,18 @@ func Build() *cobra.Command {
 				panic(err)
 			}
 
+			confirmed, err := ConfirmCost(prompt.NewPrompt(cmd.InOrStdin(), cmd.OutOrStdout()), _manager, "Build?", yes)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to confirm cost")
+
+				panic(err)
+			}
+			if !confirmed {
+				fmt.Fprintf(cmd.OutOrStdout(), "Build cancelled\n")
+				return
+			}
+
 			err = _manager.SetCloudCredentials()
 			if err != nil {
 				err := oopsBuilder.
@@ -89,76 +95,41 @@ func Build() *cobra.Command {
 			}
 			defer _manager.UnsetPluginsEnvironmentVars()
 
-			err = _manager.CreateTemplate()
+			err = _manager.RenderVars()
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to create template")
+					Wrapf(err, "failed to render vars")
 
 				panic(err)
 			}
 
-			template, err := _manager.ParseTemplate()
+			err = EnsureTool(cmd.OutOrStdout(), _manager)
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to parse template")
+					Wrapf(err, "failed to install %s", _manager.Tool.Name())
 
 				panic(err)
 			}
-			defer _manager.DeleteTemplate()
 
-			vars, err := _manager.CreateVars()
+			packer, err := binaries.NewPacker(_manager)
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to create vars")
+					Wrapf(err, "failed to create new packer")
 
 				panic(err)
 			}
-			defer vars.Close()
 
-			err = template.Execute(vars, _manager.Environment)
+			renderer, closeEventLog, err := NewEventRenderer(cmd.OutOrStdout())
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to execute template")
+					Wrapf(err, "failed to create event renderer")
 
 				panic(err)
 			}
+			defer closeEventLog()
 
-			if !_manager.ToolExecutableExists() {
-				_download, err := download.NewDownload(_manager)
-				if err != nil {
-					err := oopsBuilder.
-						Wrapf(err, "failed to create new download")
-
-					panic(err)
-				}
-
-				defer _download.RemoveZip()
-
-				err = _download.DownloadAndShowProgress()
-				if err != nil {
-					err := oopsBuilder.
-						Wrapf(err, "failed to download")
-
-					panic(err)
-				}
-
-				err = _download.ExtractAndShowProgress()
-				if err != nil {
-					err := oopsBuilder.
-						Wrapf(err, "failed to extract")
-
-					panic(err)
-				}
-
-				_download.ProgressShutdown()
-			}
-
-			packer, err := binaries.NewPacker(_manager)
-			if err != nil {
-				err := oopsBuilder.
-					Wrapf(err, "failed to create new packer")
-
-				panic(err)
+			if renderer != nil {
+				packer.OnEvent = renderer.Handle
 			}
 
 			err = _manager.GoToDirRun()
@@ -185,6 +156,25 @@ func Build() *cobra.Command {
 
 				panic(err)
 			}
+
+			// The raw output of packer already shows the artifact
+			if renderer == nil || len(renderer.Artifacts) == 0 {
+				return
+			}
+
+			imageId, err := _manager.Provider.ImageId(renderer.Artifacts[len(renderer.Artifacts)-1])
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get the image id of the build")
+
+				panic(err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Built image %s, kumo up deploys it\n", imageId)
 		},
 	}
+
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Build without asking for confirmation")
+
+	return command
 }
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/prompt"
+	"github.com/samber/oops"
+)
+
+// Prints the cost estimate of what the manager is about to run and asks to go on. With yes, the estimate is
+// printed without asking. A missing price doesn't stop anything, it is reported instead of the estimate.
+//
+// Example:
+//
+//	(prompt, manager, "Deploy?", false) -> answer "y" -> (true, nil)
+func ConfirmCost(
+	_prompt *prompt.Prompt,
+	_manager *manager.Manager,
+	question string,
+	yes bool,
+) (bool, error) {
+	oopsBuilder := oops.
+		Code("ConfirmCost").
+		In("cmd").
+		With("question", question)
+
+	estimate, err := _manager.EstimateCost()
+	if err != nil {
+		fmt.Fprintf(_prompt.Writer, "No cost estimate, %v. Update the price table with kumo pricing refresh\n", err)
+	} else {
+		err = PrintEstimate(_prompt.Writer, estimate)
+		if err != nil {
+			return false, oopsBuilder.
+				Wrapf(err, "failed to print estimate")
+		}
+	}
+
+	if yes {
+		return true, nil
+	}
+
+	confirmed, err := _prompt.Confirm(question, false)
+	if err != nil {
+		return false, oopsBuilder.
+			Wrapf(err, "failed to confirm")
+	}
+
+	return confirmed, nil
+}
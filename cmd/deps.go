@@ -0,0 +1,347 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/dependencies"
+	"github.com/ed3899/kumo/utils/host"
+	"github.com/ed3899/kumo/utils/url"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The deps command groups the commands managing the packer and terraform versions
+// installed under dependencies.
+func Deps() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "deps",
+		Short: "Manage the installed packer and terraform versions",
+		Long: `kumo runs the packer and terraform versions pinned in Versions of kumo.config.yaml, else the ones
+		recorded in dependencies/kumo.lock.json, else the ones it ships with. Several versions are kept side by side
+		under dependencies/<tool>/<version>.`,
+	}
+
+	command.AddCommand(
+		DepsList(),
+		DepsInstall(),
+		DepsUpgrade(),
+		DepsPrune(),
+	)
+
+	return command
+}
+
+// Returns a cobra command. The deps list command shows the installed versions and the one in use.
+func DepsList() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the installed versions",
+		Long: `Lists the installed versions of packer and terraform, the one kumo runs is marked with a *. See kumo
+		doctor for why it was chosen.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_config = readDepsConfig("DepsList")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("DepsList").
+				In("cmd").
+				Tags("Cobra", "Run")
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			pathToDependencies, lock := readDepsLock(oopsBuilder)
+
+			for _, tool := range iota.Tools() {
+				resolution, err := dependencies.ResolveExecutable(tool, _config, lock, pathToDependencies)
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to resolve the %s executable", tool.Name())
+
+					panic(err)
+				}
+
+				installedVersions, err := dependencies.GetInstalledVersions(pathToDependencies, tool)
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to get the installed versions of %s", tool.Name())
+
+					panic(err)
+				}
+
+				inUse := func(installedVersion string) bool {
+					return resolution.Source == dependencies.SourceDependencies && installedVersion == resolution.Version
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\n", tool.Name())
+				for _, installedVersion := range installedVersions {
+					marker := " "
+					if inUse(installedVersion) {
+						marker = "*"
+					}
+
+					fmt.Fprintf(cmd.OutOrStdout(), "  %s %s\n", marker, installedVersion)
+				}
+
+				switch {
+				case resolution.Source != dependencies.SourceDependencies:
+					fmt.Fprintf(cmd.OutOrStdout(), "  * %s %s (%s)\n", resolution.Version, resolution.Path, resolution.Reason)
+
+				case !lo.Contains(installedVersions, resolution.Version):
+					fmt.Fprintf(cmd.OutOrStdout(), "  * %s (not installed, run kumo deps install)\n", resolution.Version)
+				}
+			}
+		},
+	}
+}
+
+// Returns a cobra command. The deps install command installs the versions kumo runs.
+func DepsInstall() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "install [packer|terraform]...",
+		Short: "Install the versions in use",
+		Long: `Installs the versions of packer and terraform kumo runs, or of the given tools only. An installed binary
+		whose version output doesn't match is replaced.`,
+		Args: cobra.MaximumNArgs(2),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_config = readDepsConfig("DepsInstall")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("DepsInstall").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			tools, err := ToolsFromArgs(args)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to read the tools")
+
+				panic(err)
+			}
+
+			_, lock := readDepsLock(oopsBuilder)
+
+			for _, tool := range tools {
+				version := dependencies.ResolveVersion(tool, _config, lock)
+
+				err = InstallTool(cmd.OutOrStdout(), tool, version, dependencies.GetReleasesUrl(_config))
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to install %s %s", tool.Name(), version)
+
+					panic(err)
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s installed\n", tool.Name(), version)
+			}
+		},
+	}
+}
+
+// Returns a cobra command. The deps upgrade command installs the latest versions and records them in the lock.
+func DepsUpgrade() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "upgrade [packer|terraform]...",
+		Short: "Install the latest versions",
+		Long: `Installs the latest versions of packer and terraform, or of the given tools only, and records them in
+		dependencies/kumo.lock.json. A version pinned in kumo.config.yaml still takes precedence.`,
+		Args: cobra.MaximumNArgs(2),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_config = readDepsConfig("DepsUpgrade")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("DepsUpgrade").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			tools, err := ToolsFromArgs(args)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to read the tools")
+
+				panic(err)
+			}
+
+			for _, tool := range tools {
+				version, err := dependencies.GetLatestVersion(url.BuildHashicorpCheckpointUrl(tool.Name()))
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to get the latest version of %s", tool.Name())
+
+					panic(err)
+				}
+
+				err = InstallTool(cmd.OutOrStdout(), tool, version, dependencies.GetReleasesUrl(_config))
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to install %s %s", tool.Name(), version)
+
+					panic(err)
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "%s upgraded to %s\n", tool.Name(), version)
+
+				if pinnedVersion := dependencies.PinnedVersion(tool, _config); pinnedVersion != "" && pinnedVersion != version {
+					fmt.Fprintf(cmd.OutOrStdout(), "kumo.config.yaml pins %s %s, remove it from Versions to run %s\n", tool.Name(), pinnedVersion, version)
+				}
+			}
+		},
+	}
+}
+
+// Returns a cobra command. The deps prune command removes the installed versions not in use.
+func DepsPrune() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "prune",
+		Short: "Remove the versions not in use",
+		Long: `Removes the installed versions of packer and terraform other than the ones kumo runs, along with the
+		binaries of kumo releases that didn't keep versions side by side.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_config = readDepsConfig("DepsPrune")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("DepsPrune").
+				In("cmd").
+				Tags("Cobra", "Run")
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			pathToDependencies, lock := readDepsLock(oopsBuilder)
+
+			for _, tool := range iota.Tools() {
+				version := dependencies.ResolveVersion(tool, _config, lock)
+
+				installedVersions, err := dependencies.GetInstalledVersions(pathToDependencies, tool)
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to get the installed versions of %s", tool.Name())
+
+					panic(err)
+				}
+
+				for _, installedVersion := range installedVersions {
+					if installedVersion == version {
+						continue
+					}
+
+					err = os.RemoveAll(filepath.Join(pathToDependencies, tool.Name(), installedVersion))
+					if err != nil {
+						err := oopsBuilder.
+							Wrapf(err, "failed to remove %s %s", tool.Name(), installedVersion)
+
+						panic(err)
+					}
+
+					lock.Forget(tool, installedVersion)
+
+					fmt.Fprintf(cmd.OutOrStdout(), "%s %s removed\n", tool.Name(), installedVersion)
+				}
+
+				// Left by kumo releases keeping a single version of the tool.
+				for _, legacyPath := range []string{
+					filepath.Join(pathToDependencies, tool.Name(), host.ExecutableName(tool.Name(), runtime.GOOS)),
+					filepath.Join(pathToDependencies, fmt.Sprintf("%s.zip", tool.Name())),
+				} {
+					err = os.Remove(legacyPath)
+					if err != nil && !os.IsNotExist(err) {
+						err := oopsBuilder.
+							Wrapf(err, "failed to remove %s", legacyPath)
+
+						panic(err)
+					}
+				}
+			}
+
+			err := lock.Write(dependencies.PathToLock(pathToDependencies))
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to write the dependencies lock")
+
+				panic(err)
+			}
+		},
+	}
+}
+
+// Reads the kumo.config.yaml pinning versions, if any. Exits on an invalid one.
+func readDepsConfig(code string) *config.Config {
+	_config, err := ReadOptionalConfig()
+	if err != nil {
+		log.Fatalf(
+			"%+v",
+			oops.
+				Code(code).
+				In("cmd").
+				Tags("Cobra", "PreRun").
+				Wrapf(err, "Error occurred while reading config file. Make sure kumo.config.yaml in the current working directory is valid"),
+		)
+	}
+
+	return _config
+}
+
+// Returns the path to the dependencies and their lock. Panics on error, for the recover of the commands above.
+func readDepsLock(oopsBuilder oops.OopsErrorBuilder) (string, *dependencies.Lock) {
+	pathToDependencies, err := dependencies.GetPathToDependencies()
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "failed to get the path to the dependencies")
+
+		panic(err)
+	}
+
+	lock, err := dependencies.ReadLock(dependencies.PathToLock(pathToDependencies))
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "failed to read the dependencies lock")
+
+		panic(err)
+	}
+
+	return pathToDependencies, lock
+}
@@ -1,49 +1,47 @@
 package cmd
 
 import (
+	"fmt"
 	"log"
-	"os"
 
 	"github.com/ed3899/kumo/binaries"
 	"github.com/ed3899/kumo/common/iota"
-	"github.com/ed3899/kumo/download"
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/file"
+	"github.com/ed3899/kumo/utils/prompt"
 	"github.com/samber/oops"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // Returns a cobra command. The destroy command is used to destroy a deployed cloud environment.
 func Destroy() *cobra.Command {
-	return &cobra.Command{
+	var (
+		_config  *config.Config
+		yes      bool
+		plan     bool
+		fromPlan bool
+	)
+
+	command := &cobra.Command{
 		Use:   "destroy",
 		Short: "Destroy your cloud environment",
-		Long:  `Destroy your last deployed cloud environment. Doesn't destroy the AMI. It will also remove the SSH config file.`,
+		Long: `Destroy your last deployed cloud environment. Doesn't destroy the AMI. It will also remove the SSH config file.
+		Asks for confirmation first, unless --yes is set. Use --plan to review what is destroyed first, a declined plan is saved and can be applied later with
+		--from-plan.`,
 		PreRun: func(cmd *cobra.Command, args []string) {
 			oopsBuilder := oops.
 				Code("Build").
 				In("cmd").
 				Tags("Cobra", "PreRun")
 
-			cwd, err := os.Getwd()
+			var err error
+			_config, err = ReadConfig()
 			if err != nil {
 				log.Fatalf(
 					"%+v",
 					oopsBuilder.
-						Wrapf(err, "Error occurred while getting current working directory"),
-				)
-			}
-
-			viper.SetConfigName("kumo.config")
-			viper.SetConfigType("yaml")
-			viper.AddConfigPath(cwd)
-
-			err = viper.ReadInConfig()
-			if err != nil {
-				log.Fatalf(
-					"%+v",
-					oopsBuilder.
-						Wrapf(err, "Error occurred while reading config file. Make sure a kumo.config.yaml file exists in the current working directory"),
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
 				)
 			}
 		},
@@ -62,7 +60,7 @@ func Destroy() *cobra.Command {
 				}
 			}()
 
-			_manager, err := manager.NewManager(iota.CloudIota(viper.GetString("cloud")), iota.Terraform)
+			_manager, err := manager.NewManager(iota.CloudIota(_config.Cloud), iota.Terraform, _config)
 			if err != nil {
 				err := oopsBuilder.
 					Wrapf(err, "failed to create new manager")
@@ -70,33 +68,41 @@ func Destroy() *cobra.Command {
 				panic(err)
 			}
 
-			if !_manager.ToolExecutableExists() {
-				_download, err := download.NewDownload(_manager)
-				if err != nil {
-					err := oopsBuilder.
-						Wrapf(err, "failed to create new download")
-
-					panic(err)
-				}
+			if fromPlan && !file.IsFilePresent(_manager.Path.Terraform.DestroyPlan) {
+				err := oopsBuilder.
+					Errorf("no saved plan at %s, save one with kumo destroy --plan", _manager.Path.Terraform.DestroyPlan)
 
-				defer _download.RemoveZip()
-				defer _download.ProgressShutdown()
+				panic(err)
+			}
 
-				err = _download.DownloadAndShowProgress()
+			if !plan && !fromPlan && !yes {
+				confirmed, err := prompt.NewPrompt(cmd.InOrStdin(), cmd.OutOrStdout()).Confirm("Destroy?", false)
 				if err != nil {
 					err := oopsBuilder.
-						Wrapf(err, "failed to download")
+						Wrapf(err, "failed to confirm")
 
 					panic(err)
 				}
+				if !confirmed {
+					fmt.Fprintf(cmd.OutOrStdout(), "Destroy cancelled\n")
+					return
+				}
+			}
 
-				err = _download.ExtractAndShowProgress()
-				if err != nil {
-					err := oopsBuilder.
-						Wrapf(err, "failed to extract")
+			err = _manager.RenderVars()
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to render vars")
 
-					panic(err)
-				}
+				panic(err)
+			}
+
+			err = EnsureTool(cmd.OutOrStdout(), _manager)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to install %s", _manager.Tool.Name())
+
+				panic(err)
 			}
 
 			terraform, err := binaries.NewTerraform(_manager)
@@ -107,6 +113,19 @@ func Destroy() *cobra.Command {
 				panic(err)
 			}
 
+			renderer, closeEventLog, err := NewEventRenderer(cmd.OutOrStdout())
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create event renderer")
+
+				panic(err)
+			}
+			defer closeEventLog()
+
+			if renderer != nil {
+				terraform.OnEvent = renderer.Handle
+			}
+
 			err = _manager.GoToDirRun()
 			if err != nil {
 				err := oopsBuilder.
@@ -124,14 +143,52 @@ func Destroy() *cobra.Command {
 				panic(err)
 			}
 
-			err = terraform.Destroy()
+			err = terraform.SelectWorkspace(_config.Environment())
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to apply")
+					Wrapf(err, "failed to select workspace")
 
 				panic(err)
 			}
 
+			if plan || fromPlan {
+				if plan {
+					err = terraform.Plan(_manager.Path.Terraform.DestroyPlan, true)
+					if err != nil {
+						err := oopsBuilder.
+							Wrapf(err, "failed to plan")
+
+						panic(err)
+					}
+				}
+
+				applied, err := ApplySavedPlan(cmd.OutOrStdout(), terraform, _manager.Path.Terraform.DestroyPlan, func() (bool, error) {
+					if yes {
+						return true, nil
+					}
+
+					return prompt.NewPrompt(cmd.InOrStdin(), cmd.OutOrStdout()).Confirm("Destroy?", false)
+				})
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to apply saved plan")
+
+					panic(err)
+				}
+				if !applied {
+					fmt.Fprintf(cmd.OutOrStdout(), "The plan is saved, apply it with kumo destroy --from-plan\n")
+					return
+				}
+			} else {
+				err = terraform.Destroy()
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to apply")
+
+					panic(err)
+				}
+			}
+
 			err = _manager.DeleteSshConfig()
 			if err != nil {
 				err := oopsBuilder.
@@ -141,4 +198,11 @@ func Destroy() *cobra.Command {
 			}
 		},
 	}
+
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Destroy without asking for confirmation")
+	command.Flags().BoolVar(&plan, "plan", false, "Review what is destroyed first, a declined plan is saved")
+	command.Flags().BoolVar(&fromPlan, "from-plan", false, "Destroy with the plan saved by --plan")
+	command.MarkFlagsMutuallyExclusive("plan", "from-plan")
+
+	return command
 }
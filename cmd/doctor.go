@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/dependencies"
+	"github.com/ed3899/kumo/utils/file"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The doctor command shows which packer and terraform kumo runs and why.
+func Doctor() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Show which packer and terraform kumo runs and why",
+		Long: `Shows the packer and terraform executables kumo runs and why they were chosen: the path set in
+		Executables of kumo.config.yaml, else the one on PATH if its version satisfies the constraint, else the
+		version kumo downloads under dependencies.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_config = readDepsConfig("Doctor")
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Doctor").
+				In("cmd").
+				Tags("Cobra", "Run")
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			pathToDependencies, lock := readDepsLock(oopsBuilder)
+
+			healthy := true
+			for _, tool := range iota.Tools() {
+				resolution, err := dependencies.ResolveExecutable(tool, _config, lock, pathToDependencies)
+				if err != nil {
+					healthy = false
+					fmt.Fprintf(cmd.OutOrStdout(), "%s\n  error: %v\n", tool.Name(), err)
+					continue
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", tool.Name(), resolution.Version)
+				fmt.Fprintf(cmd.OutOrStdout(), "  path:   %s\n", resolution.Path)
+				fmt.Fprintf(cmd.OutOrStdout(), "  source: %s\n", resolution.Source)
+				fmt.Fprintf(cmd.OutOrStdout(), "  reason: %s\n", resolution.Reason)
+
+				if resolution.Source != dependencies.SourceDependencies {
+					continue
+				}
+
+				if !file.IsFilePresent(resolution.Path) {
+					fmt.Fprintf(cmd.OutOrStdout(), "  status: not installed, downloaded on first use or with kumo deps install\n")
+					continue
+				}
+
+				installedVersion, err := dependencies.GetExecutableVersion(resolution.Path)
+				if err != nil || installedVersion != resolution.Version {
+					fmt.Fprintf(cmd.OutOrStdout(), "  status: doesn't report the expected version, replaced on first use or with kumo deps install\n")
+					continue
+				}
+
+				fmt.Fprintf(cmd.OutOrStdout(), "  status: installed\n")
+			}
+
+			if !healthy {
+				log.Fatalf("%+v", oopsBuilder.Errorf("failed to resolve every tool, fix Executables in kumo.config.yaml"))
+			}
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"io"
+
+	"github.com/ed3899/kumo/dependencies"
+	"github.com/ed3899/kumo/manager"
+	"github.com/samber/oops"
+)
+
+// Resolves the tool executable of the manager, see dependencies.ResolveExecutable, and installs it unless it runs
+// one set in the config or found on PATH. Called right before binaries.NewPacker or binaries.NewTerraform, so
+// commands that don't run the tool neither probe PATH nor read the dependencies lock.
+func EnsureTool(
+	out io.Writer,
+	_manager *manager.Manager,
+) error {
+	oopsBuilder := oops.
+		Code("EnsureTool").
+		In("cmd").
+		With("tool", _manager.Tool.Name())
+
+	lock, err := dependencies.ReadLock(_manager.Path.DependenciesLock)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to read the dependencies lock")
+	}
+
+	resolution, err := dependencies.ResolveExecutable(_manager.Tool, _manager.Config, lock, _manager.Path.Dependencies)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to resolve the %s executable", _manager.Tool.Name())
+	}
+
+	_manager.Path.Executable = resolution.Path
+	_manager.ToolVersion = resolution.Version
+	_manager.ToolSource = resolution.Source
+
+	if _manager.ToolSource != dependencies.SourceDependencies {
+		return nil
+	}
+
+	return InstallTool(out, _manager.Tool, _manager.ToolVersion, dependencies.GetReleasesUrl(_manager.Config))
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"log"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The forward command is used to forward ports to and from the deployed instance.
+func Forward() *cobra.Command {
+	var (
+		_config     *config.Config
+		localSpecs  []string
+		remoteSpecs []string
+	)
+
+	command := &cobra.Command{
+		Use:   "forward [spec...]",
+		Short: "Forward ports to and from your cloud environment",
+		Long: `Forwards ports over ssh until interrupted, reconnecting whenever the connection drops. Specs use the ssh
+		-L/-R format, [bind_address:]port:host:hostport. Positional specs and -L listen on your machine and connect
+		from the instance, i.e kumo forward 8080:localhost:3000 opens the dev server running on port 3000 of the
+		instance at localhost:8080. -R listens on the instance and connects from your machine. Without specs the
+		Forwards section of kumo.config.yaml is used.`,
+		Args: cobra.ArbitraryArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Forward").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Forward").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			localSpecs = append(localSpecs, args...)
+			if len(localSpecs) == 0 && len(remoteSpecs) == 0 {
+				localSpecs = _config.Forwards.Local
+				remoteSpecs = _config.Forwards.Remote
+			}
+
+			if len(localSpecs) == 0 && len(remoteSpecs) == 0 {
+				err := oopsBuilder.
+					Errorf("nothing to forward, pass a spec like 8080:localhost:3000 or add a Forwards section to kumo.config.yaml")
+
+				panic(err)
+			}
+
+			_manager, err := NewInstanceManager(_config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			err = RunForwards(_manager, localSpecs, remoteSpecs)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to run forwards")
+
+				panic(err)
+			}
+		},
+	}
+
+	command.Flags().StringArrayVarP(&localSpecs, "local", "L", nil, "Local forward, [bind_address:]port:host:hostport, can be repeated")
+	command.Flags().StringArrayVarP(&remoteSpecs, "remote", "R", nil, "Remote forward, [bind_address:]port:host:hostport, can be repeated")
+
+	return command
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/prompt"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The init command is used to scaffold a kumo.config.yaml file.
+func Init() *cobra.Command {
+	var (
+		defaults bool
+		force    bool
+	)
+
+	command := &cobra.Command{
+		Use:   "init",
+		Short: "Create a kumo.config.yaml file in the current working directory",
+		Long: `Asks for the cloud, credentials, region, instance type, AMI user, git identity and tools, offering only
+		the clouds and tools kumo knows about, and writes a commented kumo.config.yaml file in the current working
+		directory. Use --defaults to write a valid starter file without asking anything, i.e from scripts.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Init").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("defaults", defaults).
+				With("force", force)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get current working directory")
+
+				panic(err)
+			}
+			pathToConfig := filepath.Join(cwd, constants.KUMO_CONFIG)
+
+			if _, err := os.Stat(pathToConfig); err == nil && !force {
+				err := oopsBuilder.
+					Errorf("%s already exists, use --force to overwrite it", pathToConfig)
+
+				panic(err)
+			}
+
+			currentExecutablePath, err := os.Executable()
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get current executable path")
+
+				panic(err)
+			}
+
+			knownTools, err := KnownTools()
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get known tools")
+
+				panic(err)
+			}
+
+			_config, err := config.Default()
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create default config")
+
+				panic(err)
+			}
+
+			if !defaults {
+				err = PromptConfig(prompt.NewPrompt(cmd.InOrStdin(), cmd.OutOrStdout()), _config, knownTools)
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to prompt for config")
+
+					panic(err)
+				}
+			}
+
+			err = config.Write(
+				filepath.Join(
+					filepath.Dir(currentExecutablePath),
+					iota.Templates.Name(),
+					constants.CONFIG_TEMPLATE_DIR,
+					constants.KUMO_CONFIG_TEMPLATE,
+				),
+				pathToConfig,
+				_config,
+			)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to write config")
+
+				panic(err)
+			}
+
+			// The answers aren't checked one by one, so report anything to fix in the written file.
+			_, err = config.Load(pathToConfig, KnownCloudRules(), knownTools)
+			if err != nil {
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s was written, but needs fixing before running kumo:\n%v\n", pathToConfig, err)
+				os.Exit(1)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s was written\n", pathToConfig)
+			if defaults {
+				fmt.Fprintf(cmd.OutOrStdout(), "Replace the placeholder values before running kumo build\n")
+			}
+		},
+	}
+
+	command.Flags().BoolVar(&defaults, "defaults", false, "Write a valid starter file without asking anything")
+	command.Flags().BoolVar(&force, "force", false, "Overwrite an existing kumo.config.yaml file")
+
+	return command
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/dependencies"
+	"github.com/ed3899/kumo/download"
+	"github.com/ed3899/kumo/utils/file"
+	"github.com/samber/oops"
+)
+
+// Installs a version of the tool under dependencies/<tool>/<version> and records it in the dependencies lock. An
+// installed executable whose version output doesn't match is replaced. The release is downloaded from the given
+// base URL, see dependencies.GetReleasesUrl.
+func InstallTool(
+	out io.Writer,
+	tool iota.Tool,
+	version,
+	releasesUrl string,
+) error {
+	oopsBuilder := oops.
+		Code("InstallTool").
+		In("cmd").
+		With("tool", tool.Name()).
+		With("version", version).
+		With("releasesUrl", releasesUrl)
+
+	pathToDependencies, err := dependencies.GetPathToDependencies()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to get the path to the dependencies")
+	}
+
+	pathToLock := dependencies.PathToLock(pathToDependencies)
+	pathToExecutable := dependencies.PathToExecutable(pathToDependencies, tool, version)
+
+	lock, err := dependencies.ReadLock(pathToLock)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to read the dependencies lock")
+	}
+
+	if file.IsFilePresent(pathToExecutable) {
+		installedVersion, err := dependencies.GetExecutableVersion(pathToExecutable)
+		if err == nil && installedVersion == version {
+			lock.Record(tool, version, "")
+
+			err = lock.Write(pathToLock)
+			if err != nil {
+				return oopsBuilder.
+					Wrapf(err, "failed to write the dependencies lock")
+			}
+
+			return nil
+		}
+
+		fmt.Fprintf(out, "%s %s doesn't report the expected version, replacing it\n", tool.Name(), version)
+
+		err = os.RemoveAll(filepath.Dir(pathToExecutable))
+		if err != nil {
+			return oopsBuilder.
+				Wrapf(err, "failed to remove %s", filepath.Dir(pathToExecutable))
+		}
+	}
+
+	_download, err := download.NewDownload(tool, version, releasesUrl)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to create new download")
+	}
+
+	err = _download.DownloadAndShowProgress()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to download")
+	}
+	defer _download.RemoveZip()
+
+	err = _download.ExtractAndShowProgress()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to extract")
+	}
+
+	_download.ProgressShutdown()
+
+	installedVersion, err := dependencies.GetExecutableVersion(pathToExecutable)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to get the version of the installed %s", tool.Name())
+	}
+	if installedVersion != version {
+		return oopsBuilder.
+			With("installedVersion", installedVersion).
+			Errorf("the installed %s reports version %s instead of %s", tool.Name(), installedVersion, version)
+	}
+
+	lock.Record(tool, version, _download.Sha256)
+
+	err = lock.Write(pathToLock)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to write the dependencies lock")
+	}
+
+	return nil
+}
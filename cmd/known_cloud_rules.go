@@ -0,0 +1,17 @@
+package cmd
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/provider"
+	"github.com/samber/lo"
+)
+
+// Returns the rules the config is defaulted and validated with, those of the registered providers. Providers
+// are registered by importing their package, see main.go.
+func KnownCloudRules() []config.CloudRules {
+	return lo.FilterMap(provider.Clouds(), func(c iota.Cloud, _ int) (config.CloudRules, bool) {
+		_provider, err := provider.Get(c)
+		return _provider, err == nil
+	})
+}
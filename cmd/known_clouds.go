@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider"
+	"github.com/samber/lo"
+)
+
+// Returns the clouds that can be set as Cloud, that is the ones with a registered provider. Providers are
+// registered by importing their package, see main.go.
+func KnownClouds() []string {
+	return lo.Map(provider.Clouds(), func(c iota.Cloud, _ int) string {
+		return c.Name()
+	})
+}
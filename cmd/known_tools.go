@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/utils/ansible"
+	"github.com/samber/oops"
+)
+
+// Returns the tools that can be listed in AMI.Tools, that is the tags of the ansible playbooks shipped
+// next to the kumo executable.
+func KnownTools() ([]string, error) {
+	oopsBuilder := oops.
+		Code("KnownTools").
+		In("cmd")
+
+	currentExecutablePath, err := os.Executable()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get current executable path")
+	}
+
+	knownTools, err := ansible.GetTags(
+		filepath.Join(
+			filepath.Dir(currentExecutablePath),
+			iota.Packer.Name(),
+			constants.ANSIBLE_DIR,
+			constants.ANSIBLE_PLAYBOOKS_DIR,
+			constants.ANSIBLE_MAIN_PLAYBOOK,
+		),
+	)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the tools available in the ansible playbooks")
+	}
+
+	return knownTools, nil
+}
@@ -8,9 +8,21 @@ import (
 )
 
 func init() {
+	kumo.PersistentFlags().StringVar(&environmentName, "env", "", "Environment to work on, overrides the Name in kumo.config.yaml")
+	kumo.PersistentFlags().BoolVar(&structuredOutput, "structured", false, "Show kumo's own progress of terraform and packer instead of their raw output")
+	kumo.PersistentFlags().StringVar(&eventLogPath, "event-log", "", "Append the terraform and packer events to this file as JSON lines, implies --structured")
 	kumo.AddCommand(*Commands()...)
 }
 
+// Set through the --env flag.
+var environmentName string
+
+// Set through the --structured and --event-log flags.
+var (
+	structuredOutput bool
+	eventLogPath     string
+)
+
 var kumo = &cobra.Command{
 	Use:     "kumo",
 	Short:   "🌩️ Your quick and easy cloud development environment.",
@@ -21,10 +33,23 @@ var kumo = &cobra.Command{
 // Assembles all the commands and returns them as a slice.
 func Commands() *CobraCmds {
 	return &CobraCmds{
+		Init(),
 		Build(),
 		Up(),
 		Destroy(),
+		Stop(),
+		Start(),
 		Reset(),
+		Validate(),
+		Status(),
+		Ssh(),
+		Push(),
+		Pull(),
+		Sync(),
+		Forward(),
+		Pricing(),
+		Deps(),
+		Doctor(),
 	}
 }
 
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/ed3899/kumo/utils/tool_events"
+	"github.com/samber/oops"
+)
+
+// Returns the renderer of the terraform and packer events, rendering kumo's own progress to the writer, when
+// --structured or --event-log is set. Returns a nil renderer otherwise, so the raw output is streamed. The events
+// are appended to the event log as JSON lines, the returned func closes it. The renderer keeps the artifacts and
+// outputs of the run, see TerraformOutputs.
+//
+// Example:
+//
+//	(os.Stdout) -> (renderer, eventLog.Close, nil)
+func NewEventRenderer(
+	writer io.Writer,
+) (*tool_events.Renderer, func() error, error) {
+	oopsBuilder := oops.
+		Code("NewEventRenderer").
+		In("cmd").
+		With("eventLogPath", eventLogPath)
+
+	noop := func() error { return nil }
+
+	if !structuredOutput && eventLogPath == "" {
+		return nil, noop, nil
+	}
+
+	if eventLogPath == "" {
+		return tool_events.NewRenderer(writer, nil), noop, nil
+	}
+
+	eventLog, err := os.OpenFile(eventLogPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, noop, oopsBuilder.
+			Wrapf(err, "failed to open event log %s", eventLogPath)
+	}
+
+	return tool_events.NewRenderer(writer, eventLog), eventLog.Close, nil
+}
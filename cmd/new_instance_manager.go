@@ -0,0 +1,15 @@
+package cmd
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+)
+
+// Returns a manager for the commands working with the deployed instance of the environment, i.e kumo ssh or kumo
+// status, without running any tool. It's a Packer manager since a Terraform one builds the Terraform environment,
+// which needs the Packer manifest that may not exist yet. The paths of both tools are set either way, among them
+// the Terraform state, the ssh config and the price table.
+func NewInstanceManager(_config *config.Config) (*manager.Manager, error) {
+	return manager.NewManager(iota.CloudIota(_config.Cloud), iota.Packer, _config)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/price_table"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The pricing command groups the commands managing the price table used for the cost
+// estimates of build and up.
+func Pricing() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "pricing",
+		Short: "Manage the price table used for cost estimates",
+		Long: `kumo ships an offline table of on-demand prices per region, used to estimate the cost of build and up
+		before running them. Prices change, refresh the table from a file when they do.`,
+	}
+
+	command.AddCommand(PricingRefresh())
+
+	return command
+}
+
+// Returns a cobra command. The pricing refresh command replaces the price table of the cloud with a file.
+func PricingRefresh() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "refresh <file>",
+		Short: "Replace the price table with a file",
+		Long: `Replaces the price table of the cloud in kumo.config.yaml with the given JSON file. The file is checked
+		first, the current table is kept if it isn't valid. See pricing/aws.json for the format.`,
+		Args: cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("PricingRefresh").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("PricingRefresh").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			_manager, err := NewInstanceManager(_config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			priceTable, err := price_table.ReadPriceTable(args[0])
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to read the new price table")
+
+				panic(err)
+			}
+
+			content, err := os.ReadFile(args[0])
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to read %s", args[0])
+
+				panic(err)
+			}
+
+			err = os.MkdirAll(filepath.Dir(_manager.Path.PriceTable), 0755)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create %s", filepath.Dir(_manager.Path.PriceTable))
+
+				panic(err)
+			}
+
+			// Written next to the table first, so an interrupted refresh can't leave half a table behind.
+			pathToNewPriceTable := fmt.Sprintf("%s.new", _manager.Path.PriceTable)
+
+			err = os.WriteFile(pathToNewPriceTable, content, 0644)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to write %s", pathToNewPriceTable)
+
+				panic(err)
+			}
+
+			err = os.Rename(pathToNewPriceTable, _manager.Path.PriceTable)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to replace %s", _manager.Path.PriceTable)
+
+				panic(err)
+			}
+
+			fmt.Fprintf(
+				cmd.OutOrStdout(),
+				"Price table of %s refreshed, %d regions with prices of %s\n",
+				_manager.Cloud.Name(),
+				len(priceTable.Regions),
+				priceTable.Updated,
+			)
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ed3899/kumo/transfer"
+	"github.com/vbauerster/mpb/v8/decor"
+)
+
+// Prints a one line summary of the batch, followed by a warning per conflict. Nothing is printed if the
+// batch didn't change anything.
+func PrintBatch(
+	writer io.Writer,
+	batch *transfer.Batch,
+) {
+	if len(batch.Pushed) == 0 && len(batch.Deleted) == 0 && len(batch.Conflicts) == 0 {
+		return
+	}
+
+	parts := []string{
+		fmt.Sprintf("pushed %d (% .2f)", len(batch.Pushed), decor.SizeB1024(batch.Bytes)),
+	}
+	if len(batch.Deleted) > 0 {
+		parts = append(parts, fmt.Sprintf("deleted %d", len(batch.Deleted)))
+	}
+	if len(batch.Conflicts) > 0 {
+		parts = append(parts, fmt.Sprintf("%d conflicts", len(batch.Conflicts)))
+	}
+
+	changed := append(append([]string{}, batch.Pushed...), batch.Deleted...)
+	if len(changed) > 0 && len(changed) <= 3 {
+		parts = append(parts, strings.Join(changed, " "))
+	}
+
+	fmt.Fprintf(writer, "%s %s\n", time.Now().Format(time.TimeOnly), strings.Join(parts, ", "))
+
+	for _, conflict := range batch.Conflicts {
+		fmt.Fprintf(writer, "  warning: %s is newer on the instance, skipped. Pull it or use --force to overwrite it\n", conflict)
+	}
+}
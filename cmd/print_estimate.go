@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ed3899/kumo/utils/price_table"
+)
+
+// Prints the cost estimate in a human readable way. The volume and the total are left out when the estimate
+// has no volume.
+func PrintEstimate(
+	writer io.Writer,
+	estimate *price_table.Estimate,
+) error {
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tabWriter, "Estimated cost in %s, on-demand prices of %s:\n", estimate.Currency, estimate.Updated)
+	fmt.Fprintf(tabWriter, "  %s in %s\t%.4f/hour\n", estimate.InstanceType, estimate.Region, estimate.InstanceHourly)
+
+	if estimate.VolumeType != "" {
+		fmt.Fprintf(tabWriter, "  %d GB %s volume\t%.2f/month\n", estimate.VolumeSize, estimate.VolumeType, estimate.VolumeMonthly)
+		fmt.Fprintf(tabWriter, "  Total\t%.4f/hour, %.2f/month\n", estimate.Hourly(), estimate.Monthly())
+	}
+
+	return tabWriter.Flush()
+}
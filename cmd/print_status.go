@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ed3899/kumo/manager"
+)
+
+// Prints the status in a human readable way.
+func PrintStatus(
+	writer io.Writer,
+	status *manager.Status,
+) error {
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tabWriter, "Environment:\t%s (%s)\n", status.Environment, status.Cloud)
+
+	if status.Instance != nil {
+		fmt.Fprintf(tabWriter, "Instance:\t%s (%s)\n", status.Instance.Id, status.Instance.State)
+		fmt.Fprintf(tabWriter, "Instance type:\t%s\n", status.Instance.Type)
+		fmt.Fprintf(tabWriter, "Availability zone:\t%s\n", status.Instance.AvailabilityZone)
+		fmt.Fprintf(tabWriter, "Public IP:\t%s\n", status.Instance.PublicIp)
+		if status.Instance.PublicIpv6 != "" {
+			fmt.Fprintf(tabWriter, "Public IPv6:\t%s\n", status.Instance.PublicIpv6)
+		}
+		fmt.Fprintf(tabWriter, "Public DNS:\t%s\n", status.Instance.PublicDns)
+		fmt.Fprintf(tabWriter, "AMI in use:\t%s\n", status.Instance.AmiId)
+	} else {
+		fmt.Fprintf(tabWriter, "Instance:\tnot deployed\n")
+	}
+
+	if status.LastBuild != nil {
+		fmt.Fprintf(tabWriter, "Last built AMI:\t%s\n", status.LastBuild.AmiId)
+		fmt.Fprintf(tabWriter, "Last build run:\t%s (%s)\n", status.LastBuild.RunUuid, status.LastBuild.BuiltAt.Local().Format(time.RFC1123))
+	} else {
+		fmt.Fprintf(tabWriter, "Last built AMI:\tnone\n")
+	}
+
+	fmt.Fprintf(tabWriter, "SSH config:\t%s\n", localFileStatus(status.SshConfig))
+	fmt.Fprintf(tabWriter, "Idle shutdown:\t%s\n", idleShutdownStatus(status.IdleShutdown, status.Instance))
+
+	return tabWriter.Flush()
+}
+
+func localFileStatus(status *manager.LocalFileStatus) string {
+	switch {
+	case !status.Present:
+		return fmt.Sprintf("missing (%s)", status.Path)
+
+	case status.InSync:
+		return fmt.Sprintf("in sync (%s)", status.Path)
+
+	default:
+		return fmt.Sprintf("out of sync, %s (%s)", status.Reason, status.Path)
+	}
+}
+
+func idleShutdownStatus(status *manager.IdleShutdownStatus, instance *manager.InstanceStatus) string {
+	if status == nil {
+		return "disabled"
+	}
+
+	policy := fmt.Sprintf("after %dm idle (CPU < %d%%)", status.Minutes, status.CpuThreshold)
+
+	switch {
+	case status.Error != "":
+		return fmt.Sprintf("stops %s, unknown idle time: %s", policy, status.Error)
+
+	case status.ShutdownAt != nil:
+		return fmt.Sprintf(
+			"idle since %s, stops at %s (in %s)",
+			status.IdleSince.Local().Format(time.Kitchen),
+			status.ShutdownAt.Local().Format(time.Kitchen),
+			time.Until(*status.ShutdownAt).Round(time.Minute),
+		)
+
+	case instance == nil || instance.Address == "":
+		return fmt.Sprintf("enabled, stops %s", policy)
+
+	default:
+		return fmt.Sprintf("in use, stops %s", policy)
+	}
+}
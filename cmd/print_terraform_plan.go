@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ed3899/kumo/utils/terraform_plan"
+)
+
+var planSymbols = map[string]string{
+	terraform_plan.CREATE:  "+",
+	terraform_plan.UPDATE:  "~",
+	terraform_plan.REPLACE: "-/+",
+	terraform_plan.DELETE:  "-",
+}
+
+// Prints the resources the plan changes and the totals, like terraform's own summary.
+func PrintTerraformPlan(
+	writer io.Writer,
+	plan *terraform_plan.TerraformPlan,
+) error {
+	if len(plan.Changes) == 0 {
+		_, err := fmt.Fprintf(writer, "No changes, the environment matches the configuration\n")
+		return err
+	}
+
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 1, ' ', 0)
+
+	fmt.Fprintf(tabWriter, "Terraform will:\n")
+	for _, change := range plan.Changes {
+		fmt.Fprintf(tabWriter, "  %s\t%s\t%s\n", planSymbols[change.Action], change.Action, change.Address)
+	}
+
+	add, change, destroy := plan.Counts()
+	fmt.Fprintf(tabWriter, "Plan: %d to add, %d to change, %d to destroy\n", add, change, destroy)
+
+	return tabWriter.Flush()
+}
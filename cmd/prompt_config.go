@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/provider"
+	"github.com/ed3899/kumo/utils/prompt"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// Asks for the values of the config, offering the current ones as defaults. Only the clouds and tools
+// kumo knows about are offered.
+func PromptConfig(
+	_prompt *prompt.Prompt,
+	_config *config.Config,
+	knownTools []string,
+) error {
+	oopsBuilder := oops.
+		Code("PromptConfig").
+		In("cmd")
+
+	var err error
+	ask := func(value *string, question string) {
+		if err == nil {
+			*value, err = _prompt.Ask(question, *value)
+		}
+	}
+	askSecret := func(value *string, question string) {
+		if err == nil {
+			*value, err = _prompt.AskSecret(question, *value)
+		}
+	}
+
+	_config.Cloud, err = _prompt.Select("Cloud", KnownClouds(), _config.Cloud)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for cloud")
+	}
+
+	_provider, err := provider.Get(iota.CloudIota(_config.Cloud))
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to get the provider")
+	}
+
+	err = _provider.PromptConfig(_prompt, _config)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for %s config values", _config.Cloud)
+	}
+
+	ask(&_config.AMI.User, "User to create on the instance")
+	_config.AMI.Home = _config.AMI.User
+	ask(&_config.AMI.Home, "Home dir of the user")
+	askSecret(&_config.AMI.Password, "Password of the user")
+	ask(&_config.Git.Username, "Git username")
+	ask(&_config.Git.Email, "Git email")
+
+	if err == nil {
+		_config.AMI.Tools, err = _prompt.MultiSelect("Tools to install", knownTools, _config.AMI.Tools)
+	}
+
+	if lo.Contains(_config.AMI.Tools, "github") {
+		askSecret(&_config.GitHub.PersonalAccessTokenClassic, "GitHub personal access token (classic)")
+	}
+
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for config values")
+	}
+
+	return nil
+}
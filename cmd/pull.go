@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/remote"
+	"github.com/ed3899/kumo/transfer"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+	"github.com/vbauerster/mpb/v8/decor"
+)
+
+// Returns a cobra command. The pull command is used to fetch files from the deployed instance.
+func Pull() *cobra.Command {
+	var (
+		_config  *config.Config
+		excludes []string
+	)
+
+	command := &cobra.Command{
+		Use:   "pull <remote> [local]",
+		Short: "Fetch files from your cloud environment",
+		Long: `Copies a remote file or dir from the deployed instance over SFTP, recursively. Relative remote paths are
+		resolved from the home dir of the AMI user and the local path defaults to the name of the remote path in the
+		current working directory. Paths matched by the .gitignore and .kumoignore files at the root of the remote
+		dir, or by --exclude, are skipped.`,
+		Args: cobra.RangeArgs(1, 2),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Pull").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Pull").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			remotePath := args[0]
+
+			localPath := path.Base(remotePath)
+			if len(args) == 2 {
+				localPath = args[1]
+			}
+
+			localPath, err := filepath.Abs(localPath)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get absolute path of %s", localPath)
+
+				panic(err)
+			}
+
+			_manager, err := NewInstanceManager(_config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			_remote, err := remote.NewRemote(_manager)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to connect to the instance")
+
+				panic(err)
+			}
+			defer _remote.Close()
+
+			summary, err := transfer.NewTransfer(_remote.Sftp, os.Stdout, excludes).Pull(remotePath, localPath)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to pull %s", remotePath)
+
+				panic(err)
+			}
+
+			fmt.Printf(
+				"Pulled %d files (% .2f) to %s, skipped %d\n",
+				summary.Files,
+				decor.SizeB1024(summary.Bytes),
+				localPath,
+				summary.Skipped,
+			)
+		},
+	}
+
+	command.Flags().StringSliceVar(&excludes, "exclude", nil, "Extra .gitignore style patterns to skip, can be repeated")
+
+	return command
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/remote"
+	"github.com/ed3899/kumo/transfer"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+	"github.com/vbauerster/mpb/v8/decor"
+)
+
+// Returns a cobra command. The push command is used to send files to the deployed instance.
+func Push() *cobra.Command {
+	var (
+		_config  *config.Config
+		excludes []string
+	)
+
+	command := &cobra.Command{
+		Use:   "push <local> [remote]",
+		Short: "Send files to your cloud environment",
+		Long: `Copies a local file or dir to the deployed instance over SFTP, recursively. The remote path defaults to
+		the name of the local path in the home dir of the AMI user. Paths matched by the .gitignore and .kumoignore
+		files at the root of the local dir, or by --exclude, are skipped.`,
+		Args: cobra.RangeArgs(1, 2),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Push").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Push").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			localPath, err := filepath.Abs(args[0])
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get absolute path of %s", args[0])
+
+				panic(err)
+			}
+
+			remotePath := path.Base(filepath.ToSlash(localPath))
+			if len(args) == 2 {
+				remotePath = args[1]
+			}
+
+			_manager, err := NewInstanceManager(_config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			_remote, err := remote.NewRemote(_manager)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to connect to the instance")
+
+				panic(err)
+			}
+			defer _remote.Close()
+
+			summary, err := transfer.NewTransfer(_remote.Sftp, os.Stdout, excludes).Push(localPath, remotePath)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to push %s", localPath)
+
+				panic(err)
+			}
+
+			fmt.Printf(
+				"Pushed %d files (% .2f) to %s, skipped %d\n",
+				summary.Files,
+				decor.SizeB1024(summary.Bytes),
+				remotePath,
+				summary.Skipped,
+			)
+		},
+	}
+
+	command.Flags().StringSliceVar(&excludes, "exclude", nil, "Extra .gitignore style patterns to skip, can be repeated")
+
+	return command
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/config"
+	"github.com/samber/oops"
+)
+
+// Reads and validates the kumo.config.yaml file at the current working directory. The config is defaulted and
+// validated with the rules of the registered providers, see KnownCloudRules, and AMI.Tools entries are checked
+// against the tags of the ansible playbooks shipped next to the kumo executable. The --env flag takes precedence
+// over the Name in the file.
+func ReadConfig() (*config.Config, error) {
+	oopsBuilder := oops.
+		Code("ReadConfig").
+		In("cmd")
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while getting current working directory")
+	}
+
+	knownTools, err := KnownTools()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get known tools")
+	}
+
+	_config, err := config.Load(filepath.Join(cwd, constants.KUMO_CONFIG), KnownCloudRules(), knownTools)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to load %s", constants.KUMO_CONFIG)
+	}
+
+	if environmentName != "" {
+		if !config.IsValidName(environmentName) {
+			return nil, oopsBuilder.
+				Errorf("invalid environment name %q, use 1 to 32 lowercase alphanumeric characters or '-' (hyphen)", environmentName)
+		}
+
+		_config.Name = environmentName
+	}
+
+	return _config, nil
+}
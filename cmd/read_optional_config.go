@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/file"
+	"github.com/samber/oops"
+)
+
+// Same as ReadConfig, but returns a nil config when there is no kumo.config.yaml at the current working directory.
+// Used by the commands working without one, i.e kumo deps.
+func ReadOptionalConfig() (*config.Config, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, oops.
+			Code("ReadOptionalConfig").
+			In("cmd").
+			Wrapf(err, "Error occurred while getting current working directory")
+	}
+
+	if !file.IsFilePresent(filepath.Join(cwd, constants.KUMO_CONFIG)) {
+		return nil, nil
+	}
+
+	return ReadConfig()
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/ed3899/kumo/common/constants"
 	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/provider"
 	"github.com/samber/oops"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -19,7 +21,7 @@ func Reset() *cobra.Command {
 	return &cobra.Command{
 		Use:   "reset",
 		Short: "Resets kumo state. Doesn't delete AMIs or deployments. Be cautious!",
-		Long:  `Deletes all the files created by kumo under all available clouds found in the kumo.config.yaml file. This command should be used only if you wish to start from scratch, without any state. Usually this shouldn't be necessary as the size of the files created by kumo is very small.`,
+		Long:  `Deletes all the files created by kumo under all the clouds kumo supports. This command should be used only if you wish to start from scratch, without any state. Usually this shouldn't be necessary as the size of the files created by kumo is very small.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			oopsBuilder := oops.
 				Code("Reset").
@@ -52,9 +54,7 @@ func Reset() *cobra.Command {
 			unsuccesfulItemsChan := make(chan *UnsuccesfulItem, 1000)
 			wg := &sync.WaitGroup{}
 
-			clouds := []iota.Cloud{
-				iota.Aws,
-			}
+			clouds := provider.Clouds()
 
 			// Delete plugins and vars directories for packer and terraform.
 			for _, c := range clouds {
@@ -62,7 +62,7 @@ func Reset() *cobra.Command {
 
 				go func(cloud iota.Cloud) {
 					defer wg.Done()
-					_manager, err := manager.NewManager(cloud, iota.Packer)
+					_manager, err := manager.NewManager(cloud, iota.Packer, &config.Config{})
 					if err != nil {
 						// Return without handling error. We only care about deleting files. See explanation below in the
 						// terraform section.
@@ -94,7 +94,7 @@ func Reset() *cobra.Command {
 
 				go func(cloud iota.Cloud) {
 					defer wg.Done()
-					_manager, err := manager.NewManager(cloud, iota.Terraform)
+					_manager, err := manager.NewManager(cloud, iota.Terraform, &config.Config{})
 					if err != nil {
 						// Return without handling error. We only care about deleting files. Under the hood
 						// NewManager with terraform creates an environment which needs a packer manifest to
@@ -171,6 +171,7 @@ func Reset() *cobra.Command {
 					terraformFilePath(c, constants.TERRAFORM_LOCK),
 					terraformFilePath(c, constants.TERRAFORM_STATE),
 					terraformFilePath(c, constants.TERRAFORM_BACKUP),
+					terraformFilePath(c, constants.TERRAFORM_WORKSPACES_DIR),
 				)
 			}
 
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+
+	"github.com/ed3899/kumo/forward"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/remote"
+	"github.com/ed3899/kumo/utils/forward_spec"
+	"github.com/samber/oops"
+	"go.uber.org/zap"
+)
+
+// Forwards the ports of the local and remote specs over ssh until interrupted, reconnecting whenever the
+// connection drops.
+func RunForwards(
+	_manager *manager.Manager,
+	localSpecs,
+	remoteSpecs []string,
+) error {
+	oopsBuilder := oops.
+		Code("RunForwards").
+		In("cmd").
+		With("localSpecs", localSpecs).
+		With("remoteSpecs", remoteSpecs)
+
+	parse := func(specs []string) ([]*forward_spec.ForwardSpec, error) {
+		parsed := []*forward_spec.ForwardSpec{}
+		for _, spec := range specs {
+			forwardSpec, err := forward_spec.ParseForwardSpec(spec)
+			if err != nil {
+				return nil, err
+			}
+			parsed = append(parsed, forwardSpec)
+		}
+
+		return parsed, nil
+	}
+
+	local, err := parse(localSpecs)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to parse local forwards")
+	}
+
+	_remote, err := parse(remoteSpecs)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to parse remote forwards")
+	}
+
+	logger, _ := zap.NewProduction(
+		zap.AddCaller(),
+	)
+	defer logger.Sync()
+
+	forwarder := forward.NewForwarder(
+		func() (forward.Client, error) {
+			return remote.NewSshClient(_manager)
+		},
+		local,
+		_remote,
+		logger,
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	logger.Info("Press Ctrl+C to stop forwarding")
+
+	err = forwarder.Run(ctx)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to forward ports")
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ed3899/kumo/binaries"
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/terraform_plan"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// Stops or starts the deployed instance of the manager's environment. The vars are rendered first, so the apply
+// only changes the instance state and not what the last kumo up of another environment left in the vars. Only the
+// power resources of the provider are planned, and the plan is refused if it changes anything else, i.e a newer
+// image or instance type that would replace the instance and its disk. After a start the ssh config is generated
+// again, since the instance gets a new public ip.
+func SetInstanceState(
+	out io.Writer,
+	_manager *manager.Manager,
+	state string,
+) error {
+	oopsBuilder := oops.
+		Code("SetInstanceState").
+		In("cmd").
+		With("state", state)
+
+	err := _manager.RenderVars()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to render vars")
+	}
+
+	err = EnsureTool(out, _manager)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to install %s", _manager.Tool.Name())
+	}
+
+	terraform, err := binaries.NewTerraform(_manager)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to create new terraform")
+	}
+
+	renderer, closeEventLog, err := NewEventRenderer(out)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to create event renderer")
+	}
+	defer closeEventLog()
+
+	if renderer != nil {
+		terraform.OnEvent = renderer.Handle
+	}
+
+	err = _manager.GoToDirRun()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to chdir to manager dir")
+	}
+	defer _manager.GoToDirInitial()
+
+	err = terraform.Init()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to init")
+	}
+
+	err = terraform.SelectWorkspace(_manager.Config.Environment())
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to select workspace")
+	}
+
+	powerResources := _manager.Provider.PowerResources()
+	pathToPlan := _manager.Path.Terraform.PowerPlan
+
+	err = terraform.PlanInstanceState(pathToPlan, state, lo.Keys(powerResources))
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to plan the instance state")
+	}
+	defer os.Remove(pathToPlan)
+
+	content, err := terraform.ShowPlan(pathToPlan)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to show plan")
+	}
+
+	plan, err := terraform_plan.ParseTerraformPlan(content)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to parse plan")
+	}
+
+	changesOutside := plan.ChangesOutside(powerResources)
+	if len(changesOutside) > 0 {
+		addresses := lo.Map(changesOutside, func(change *terraform_plan.SummarizedChange, _ int) string {
+			return fmt.Sprintf("%s (%s)", change.Address, change.Action)
+		})
+
+		return oopsBuilder.
+			With("changes", addresses).
+			Errorf("the environment changed since the last kumo up, run kumo up to review and apply: %s", strings.Join(addresses, ", "))
+	}
+
+	err = terraform.ApplyPlan(pathToPlan)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to set the instance state")
+	}
+
+	if state != constants.INSTANCE_STATE_RUNNING {
+		return nil
+	}
+
+	outputs, err := TerraformOutputs(terraform, renderer)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to read terraform outputs")
+	}
+
+	err = _manager.CreateSshConfig(outputs)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to generate ssh config")
+	}
+
+	return nil
+}
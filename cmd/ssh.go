@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"errors"
+	"log"
+	"os"
+	"os/exec"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The ssh command is used to connect to the deployed instance.
+func Ssh() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "ssh [-- command...]",
+		Short: "Connect to your cloud environment",
+		Long: `Opens a shell on the deployed instance using the system ssh client, with the identity file, user and port
+		generated by kumo up. Anything after -- is run on the instance instead of a shell, and kumo exits with the
+		exit code of that command.`,
+		Args: cobra.ArbitraryArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Ssh").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Ssh").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			if len(args) > 0 && cmd.ArgsLenAtDash() != 0 {
+				err := oopsBuilder.
+					Errorf("the remote command must come after --, i.e kumo ssh -- ls -la")
+
+				panic(err)
+			}
+
+			sshPath, err := exec.LookPath("ssh")
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to find the ssh client, make sure OpenSSH is installed and in your PATH")
+
+				panic(err)
+			}
+
+			_manager, err := NewInstanceManager(_config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			sshArgs, err := _manager.SshArgs(args)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get ssh arguments")
+
+				panic(err)
+			}
+
+			_cmd := exec.Command(sshPath, sshArgs...)
+			_cmd.Stdin = os.Stdin
+			_cmd.Stdout = os.Stdout
+			_cmd.Stderr = os.Stderr
+
+			err = _cmd.Run()
+
+			var exitError *exec.ExitError
+			if errors.As(err, &exitError) {
+				os.Exit(exitError.ExitCode())
+			}
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to run ssh")
+
+				panic(err)
+			}
+		},
+	}
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"log"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The start command is used to start an instance stopped with kumo stop.
+func Start() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "start",
+		Short: "Start your stopped cloud environment",
+		Long: `Starts the instance stopped with kumo stop. The instance gets a new public ip, so the ssh config is
+		generated again.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Start").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Start").
+				In("cmd").
+				Tags("Cobra", "Run")
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			_manager, err := manager.NewManager(iota.CloudIota(_config.Cloud), iota.Terraform, _config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			err = SetInstanceState(cmd.OutOrStdout(), _manager, constants.INSTANCE_STATE_RUNNING)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to start the instance")
+
+				panic(err)
+			}
+		},
+	}
+}
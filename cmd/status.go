@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/remote"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The status command is used to report the deployment and build state.
+func Status() *cobra.Command {
+	var (
+		_config    *config.Config
+		jsonOutput bool
+	)
+
+	command := &cobra.Command{
+		Use:   "status",
+		Short: "Show what kumo thinks is built and deployed",
+		Long: `Reports the instance recorded in the Terraform state, the last image recorded in the Packer manifest and
+		whether the ssh config matches the instance. Only local files are read, nothing is requested from the cloud.
+		When idle shutdown is enabled the instance is asked over ssh since when it is idle. Use --json for scripting.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Status").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Status").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			_manager, err := NewInstanceManager(_config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			status, err := _manager.GetStatus()
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get status")
+
+				panic(err)
+			}
+
+			if status.IdleShutdown != nil && status.Instance != nil && status.Instance.Address != "" {
+				idleSince, err := getIdleSince(_manager)
+				if err != nil {
+					status.IdleShutdown.Error = err.Error()
+				} else {
+					status.IdleShutdown.SetIdleSince(idleSince)
+				}
+			}
+
+			if jsonOutput {
+				jsonEncoder := json.NewEncoder(cmd.OutOrStdout())
+				jsonEncoder.SetIndent("", "  ")
+
+				err = jsonEncoder.Encode(status)
+			} else {
+				err = PrintStatus(cmd.OutOrStdout(), status)
+			}
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to print status")
+
+				panic(err)
+			}
+		},
+	}
+
+	command.Flags().BoolVar(&jsonOutput, "json", false, "Print the status as JSON")
+
+	return command
+}
+
+// Returns since when the instance is idle according to its idle shutdown watchdog.
+func getIdleSince(
+	_manager *manager.Manager,
+) (*time.Time, error) {
+	client, err := remote.NewSshClient(_manager)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return remote.GetIdleSince(client)
+}
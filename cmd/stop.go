@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"log"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+)
+
+// Returns a cobra command. The stop command is used to stop the deployed instance without destroying it.
+func Stop() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "stop",
+		Short: "Stop your cloud environment, keeping its disk",
+		Long: `Stops the deployed instance so compute isn't billed anymore. The EBS volume, and everything else kumo up
+		created, is kept. Run kumo start to start it again or kumo up to start it and apply config changes.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Stop").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Stop").
+				In("cmd").
+				Tags("Cobra", "Run")
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			_manager, err := manager.NewManager(iota.CloudIota(_config.Cloud), iota.Terraform, _config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			err = SetInstanceState(cmd.OutOrStdout(), _manager, constants.INSTANCE_STATE_STOPPED)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to stop the instance")
+
+				panic(err)
+			}
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/remote"
+	"github.com/ed3899/kumo/transfer"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// Returns a cobra command. The sync command is used to keep a workspace on the deployed instance in sync with a
+// local dir.
+func Sync() *cobra.Command {
+	var (
+		_config  *config.Config
+		excludes []string
+		watch    bool
+		force    bool
+		debounce time.Duration
+	)
+
+	command := &cobra.Command{
+		Use:   "sync [local] [remote]",
+		Short: "Sync a local dir to your cloud environment",
+		Long: `Pushes the new and changed files of a local dir, the current working directory by default, to the
+		deployed instance. The remote dir defaults to the name of the local dir in the home dir of the AMI user. With
+		--watch kumo keeps watching the local dir and pushes every batch of changes, deleting the remote copies of
+		deleted and renamed paths. Files newer on the instance are left alone and reported as conflicts unless
+		--force is set. Excludes work the same as with kumo push.`,
+		Args: cobra.RangeArgs(0, 2),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Sync").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Sync").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			logger, _ := zap.NewProduction(
+				zap.AddCaller(),
+			)
+			defer logger.Sync()
+
+			localPath := "."
+			if len(args) > 0 {
+				localPath = args[0]
+			}
+
+			localPath, err := filepath.Abs(localPath)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to get absolute path of %s", localPath)
+
+				panic(err)
+			}
+
+			remotePath := path.Base(filepath.ToSlash(localPath))
+			if len(args) == 2 {
+				remotePath = args[1]
+			}
+
+			_manager, err := NewInstanceManager(_config)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new manager")
+
+				panic(err)
+			}
+
+			_remote, err := remote.NewRemote(_manager)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to connect to the instance")
+
+				panic(err)
+			}
+			defer _remote.Close()
+
+			_sync := transfer.NewSync(transfer.NewTransfer(_remote.Sftp, os.Stdout, excludes), localPath, remotePath, force)
+
+			batch, err := _sync.SyncAll()
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to sync %s", localPath)
+
+				panic(err)
+			}
+			PrintBatch(os.Stdout, batch)
+
+			if !watch {
+				return
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+
+			logger.Info("Watching for changes, press Ctrl+C to stop",
+				zap.String("local", localPath),
+				zap.String("remote", remotePath),
+			)
+
+			err = _sync.Watch(ctx, debounce, func(batch *transfer.Batch, err error) {
+				if err != nil {
+					logger.Error("Batch failed, run kumo sync again to push the missed changes", zap.Error(err))
+				}
+
+				if batch != nil {
+					PrintBatch(os.Stdout, batch)
+				}
+			})
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to watch %s", localPath)
+
+				panic(err)
+			}
+		},
+	}
+
+	command.Flags().BoolVar(&watch, "watch", false, "Keep watching the local dir and push every change")
+	command.Flags().BoolVar(&force, "force", false, "Overwrite files that are newer on the instance")
+	command.Flags().DurationVar(&debounce, "debounce", 500*time.Millisecond, "Time without changes to wait for before pushing a batch")
+	command.Flags().StringSliceVar(&excludes, "exclude", nil, "Extra .gitignore style patterns to skip, can be repeated")
+
+	return command
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"encoding/json"
+
+	"github.com/ed3899/kumo/binaries"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/ed3899/kumo/utils/tool_events"
+	"github.com/samber/oops"
+)
+
+// Returns the instance outputs of the last apply. They're taken from the outputs event of the apply when its events
+// were rendered, otherwise terraform output is run.
+//
+// Example:
+//
+//	(terraform, renderer) -> (&terraform_state.TerraformOutputs{PublicIp: "3.91.10.20", ...}, nil)
+func TerraformOutputs(
+	terraform *binaries.Terraform,
+	renderer *tool_events.Renderer,
+) (*terraform_state.TerraformOutputs, error) {
+	oopsBuilder := oops.
+		Code("TerraformOutputs").
+		In("cmd")
+
+	if renderer == nil || len(renderer.Outputs) == 0 {
+		return terraform.Output()
+	}
+
+	// Shaped like terraform output -json, which is what ParseTerraformOutputs reads.
+	outputs := map[string]*terraform_state.TerraformOutput{}
+	for name, value := range renderer.Outputs {
+		outputs[name] = &terraform_state.TerraformOutput{Value: value}
+	}
+
+	content, err := json.Marshal(outputs)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to encode the outputs of the events")
+	}
+
+	terraformOutputs, err := terraform_state.ParseTerraformOutputs(content)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to parse the outputs of the events")
+	}
+
+	return terraformOutputs, nil
+}
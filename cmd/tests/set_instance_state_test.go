@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/cmd"
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/provider/local"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetInstanceState", func() {
+	var (
+		runDir string
+
+		// Returns a terraform manager of the environment running the fake terraform in runDir.
+		newManager = func(_config *config.Config) *manager.Manager {
+			_config.Executables.Terraform = filepath.Join(runDir, "terraform")
+
+			_manager, err := manager.NewManager(local.Cloud, iota.Terraform, _config)
+			Expect(err).NotTo(HaveOccurred())
+
+			templates, err := filepath.Abs(filepath.Join("..", "..", "templates", iota.Terraform.Name()))
+			Expect(err).NotTo(HaveOccurred())
+			initialDir, err := os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+
+			_manager.Path.Template.Merged = filepath.Join(runDir, constants.MERGED_TEMPLATE_NAME)
+			_manager.Path.Template.Cloud = filepath.Join(templates, local.Cloud.TemplateFiles().Cloud)
+			_manager.Path.Template.Base = filepath.Join(templates, local.Cloud.TemplateFiles().Base)
+			_manager.Path.Vars = filepath.Join(runDir, iota.Terraform.VarsName())
+			_manager.Path.Terraform.PowerPlan = filepath.Join(runDir, constants.TERRAFORM_POWER_PLAN)
+			_manager.Path.Dir.Run = runDir
+			_manager.Path.Dir.Initial = initialDir
+
+			return _manager
+		}
+	)
+
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("the fake terraform is a shell script")
+		}
+
+		runDir = GinkgoT().TempDir()
+
+		// Keeps the args and the vars terraform plan runs with, shows plan.json as the plan and keeps the args of
+		// terraform apply.
+		script := `#!/bin/sh
+case "$1" in
+  version) echo 'Terraform v1.5.7' ;;
+  plan) echo "$@" > planned_args; cat .auto.tfvars > planned_vars ;;
+  show) cat plan.json ;;
+  apply) echo "$@" > applied_args ;;
+esac
+`
+		Expect(os.WriteFile(filepath.Join(runDir, "terraform"), []byte(script), 0755)).To(Succeed())
+	})
+
+	writePlan := func(resourceChanges string) {
+		Expect(os.WriteFile(filepath.Join(runDir, "plan.json"), []byte(`{"resource_changes": [`+resourceChanges+`]}`), 0644)).To(Succeed())
+	}
+
+	It("should stop the environment with its own vars, not those of the last up", Label("unit"), func() {
+		lastUp := newManager(&config.Config{
+			Name:  "other",
+			Cloud: local.Cloud.Name(),
+			Up:    config.Up{AmiId: "sha256:other"},
+			Local: config.Local{SshPort: 2223},
+		})
+		Expect(lastUp.RenderVars()).To(Succeed())
+		writePlan(`{"address": "null_resource.kumo-container-power", "mode": "managed", "change": {"actions": ["delete", "create"]}}`)
+
+		stopped := &config.Config{
+			Name:  "dev",
+			Cloud: local.Cloud.Name(),
+			Up:    config.Up{AmiId: "sha256:dev"},
+			Local: config.Local{SshPort: 2222},
+		}
+		Expect(cmd.SetInstanceState(GinkgoWriter, newManager(stopped), constants.INSTANCE_STATE_STOPPED)).To(Succeed())
+
+		args, err := os.ReadFile(filepath.Join(runDir, "planned_args"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(args)).To(ContainSubstring("INSTANCE_STATE=stopped"))
+		Expect(string(args)).To(ContainSubstring("-target=null_resource.kumo-container-power"))
+		Expect(filepath.Join(runDir, "applied_args")).To(BeAnExistingFile())
+
+		vars, err := os.ReadFile(filepath.Join(runDir, "planned_vars"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(vars)).To(ContainSubstring(`"sha256:dev"`))
+		Expect(string(vars)).To(ContainSubstring(`"` + stopped.ScopedName(constants.KEY_NAME) + `"`))
+		Expect(string(vars)).To(ContainSubstring(`"` + stopped.ScopedName(constants.TERRAFORM_NAME_TAG) + `"`))
+		Expect(string(vars)).NotTo(ContainSubstring("other"))
+	})
+
+	It("should refuse a plan that changes more than the power state", Label("unit"), func() {
+		writePlan(`{"address": "docker_container.kumo-container", "mode": "managed", "change": {"actions": ["delete", "create"]}},
+			{"address": "null_resource.kumo-container-power", "mode": "managed", "change": {"actions": ["delete", "create"]}}`)
+
+		started := &config.Config{
+			Name:  "dev",
+			Cloud: local.Cloud.Name(),
+			Up:    config.Up{AmiId: "sha256:newer"},
+			Local: config.Local{SshPort: 2222},
+		}
+		err := cmd.SetInstanceState(GinkgoWriter, newManager(started), constants.INSTANCE_STATE_RUNNING)
+		Expect(err).To(MatchError(ContainSubstring("docker_container.kumo-container (replace)")))
+		Expect(filepath.Join(runDir, "applied_args")).NotTo(BeAnExistingFile())
+	})
+})
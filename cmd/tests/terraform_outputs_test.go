@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/ed3899/kumo/cmd"
+	"github.com/ed3899/kumo/utils/tool_events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TerraformOutputs", func() {
+	It("should read the outputs of the rendered events", Label("unit"), func() {
+		renderer := tool_events.NewRenderer(io.Discard, nil)
+		renderer.Handle(&tool_events.Event{
+			Kind: tool_events.OUTPUTS,
+			Outputs: map[string]json.RawMessage{
+				"instance_id": json.RawMessage(`"i-0abc"`),
+				"public_ip":   json.RawMessage(`"3.91.10.20"`),
+			},
+		})
+
+		// No terraform needed when the outputs were rendered
+		outputs, err := cmd.TerraformOutputs(nil, renderer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outputs.InstanceId).To(Equal("i-0abc"))
+		Expect(outputs.PublicIp).To(Equal("3.91.10.20"))
+	})
+
+	It("should fail on invalid outputs", Label("unit"), func() {
+		renderer := tool_events.NewRenderer(io.Discard, nil)
+		renderer.Handle(&tool_events.Event{
+			Kind: tool_events.OUTPUTS,
+			Outputs: map[string]json.RawMessage{
+				"public_ip": json.RawMessage(`"not an ip"`),
+			},
+		})
+
+		_, err := cmd.TerraformOutputs(nil, renderer)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/samber/oops"
+)
+
+// Returns the tools named in the args, or all of them when there is none.
+//
+// Example:
+//
+//	([]string{"packer"}) -> ([]iota.Tool{iota.Packer}, nil)
+//	([]string{}) -> ([]iota.Tool{iota.Packer, iota.Terraform}, nil)
+func ToolsFromArgs(
+	args []string,
+) ([]iota.Tool, error) {
+	if len(args) == 0 {
+		return iota.Tools(), nil
+	}
+
+	tools := make([]iota.Tool, 0, len(args))
+	for _, arg := range args {
+		tool, err := iota.ToolIota(arg)
+		if err != nil {
+			return nil, oops.
+				Code("ToolsFromArgs").
+				In("cmd").
+				With("args", args).
+				Wrapf(err, "failed to read the tool %s", arg)
+		}
+
+		tools = append(tools, tool)
+	}
+
+	return tools, nil
+}
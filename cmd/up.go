@@ -1,26 +1,38 @@
 package cmd
 
 import (
+	"fmt"
 	"log"
-	"os"
 
 	"github.com/ed3899/kumo/binaries"
 	"github.com/ed3899/kumo/common/iota"
-	"github.com/ed3899/kumo/download"
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/file"
+	"github.com/ed3899/kumo/utils/prompt"
 	"github.com/samber/oops"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // Returns a cobra command. The up command is used to deploy a cloud environment.
 func Up() *cobra.Command {
-	return &cobra.Command{
+	var (
+		_config   *config.Config
+		noForward bool
+		yes       bool
+		plan      bool
+		fromPlan  bool
+	)
+
+	command := &cobra.Command{
 		Use:   "up",
 		Short: "Deploy your cloud environment",
 		Long: `Deploy you cloud development environment. If no AMI is specified in the config file, Kumo will
 		deploy the latest AMI built. It generates an SSH config file for you to easily SSH into your
-		instances.`,
+		instances. If kumo.config.yaml has a Forwards section, the forwards are kept open once the instance is
+		up until interrupted. The estimated cost of the instance and its volume is shown before deploying, use
+		--yes to deploy without being asked. Use --plan to review the changes first, a declined plan is saved
+		and can be applied later with --from-plan.`,
 		Args: cobra.NoArgs,
 		PreRun: func(cmd *cobra.Command, args []string) {
 			oopsBuilder := oops.
@@ -28,25 +40,13 @@ func Up() *cobra.Command {
 				In("cmd").
 				Tags("Cobra", "PreRun")
 
-			cwd, err := os.Getwd()
+			var err error
+			_config, err = ReadConfig()
 			if err != nil {
 				log.Fatalf(
 					"%+v",
 					oopsBuilder.
-						Wrapf(err, "Error occurred while getting current working directory"),
-				)
-			}
-
-			viper.SetConfigName("kumo.config")
-			viper.SetConfigType("yaml")
-			viper.AddConfigPath(cwd)
-
-			err = viper.ReadInConfig()
-			if err != nil {
-				log.Fatalf(
-					"%+v",
-					oopsBuilder.
-						Wrapf(err, "Error occurred while reading config file. Make sure a kumo.config.yaml file exists in the current working directory"),
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
 				)
 			}
 		},
@@ -66,7 +66,7 @@ func Up() *cobra.Command {
 				}
 			}()
 
-			_manager, err := manager.NewManager(iota.CloudIota(viper.GetString("cloud")), iota.Terraform)
+			_manager, err := manager.NewManager(iota.CloudIota(_config.Cloud), iota.Terraform, _config)
 			if err != nil {
 				err := oopsBuilder.
 					Wrapf(err, "failed to create new manager")
@@ -74,76 +74,66 @@ func Up() *cobra.Command {
 				panic(err)
 			}
 
-			err = _manager.CreateTemplate()
-			if err != nil {
-				err := oopsBuilder.
-					Wrapf(err, "failed to create template")
+			_prompt := prompt.NewPrompt(cmd.InOrStdin(), cmd.OutOrStdout())
 
-				panic(err)
+			// With a plan, the cost is confirmed together with the plan.
+			if !plan && !fromPlan {
+				confirmed, err := ConfirmCost(_prompt, _manager, "Deploy?", yes)
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to confirm cost")
+
+					panic(err)
+				}
+				if !confirmed {
+					fmt.Fprintf(cmd.OutOrStdout(), "Deployment cancelled\n")
+					return
+				}
 			}
 
-			template, err := _manager.ParseTemplate()
-			if err != nil {
+			if fromPlan && !file.IsFilePresent(_manager.Path.Terraform.UpPlan) {
 				err := oopsBuilder.
-					Wrapf(err, "failed to parse template")
+					Errorf("no saved plan at %s, save one with kumo up --plan", _manager.Path.Terraform.UpPlan)
 
 				panic(err)
 			}
-			defer _manager.DeleteTemplate()
 
-			vars, err := _manager.CreateVars()
+			err = _manager.RenderVars()
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to create vars")
+					Wrapf(err, "failed to render vars")
 
 				panic(err)
 			}
 
-			err = template.Execute(vars, _manager.Environment)
+			err = EnsureTool(cmd.OutOrStdout(), _manager)
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to execute template")
+					Wrapf(err, "failed to install %s", _manager.Tool.Name())
 
 				panic(err)
 			}
 
-			if !_manager.ToolExecutableExists() {
-				_download, err := download.NewDownload(_manager)
-				if err != nil {
-					err := oopsBuilder.
-						Wrapf(err, "failed to create new download")
-
-					panic(err)
-				}
-
-				defer _download.RemoveZip()
-
-				err = _download.DownloadAndShowProgress()
-				if err != nil {
-					err := oopsBuilder.
-						Wrapf(err, "failed to download")
-
-					panic(err)
-				}
-
-				err = _download.ExtractAndShowProgress()
-				if err != nil {
-					err := oopsBuilder.
-						Wrapf(err, "failed to extract")
-
-					panic(err)
-				}
+			terraform, err := binaries.NewTerraform(_manager)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create new terraform")
 
-				_download.ProgressShutdown()
+				panic(err)
 			}
 
-			terraform, err := binaries.NewTerraform(_manager)
+			renderer, closeEventLog, err := NewEventRenderer(cmd.OutOrStdout())
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to create new terraform")
+					Wrapf(err, "failed to create event renderer")
 
 				panic(err)
 			}
+			defer closeEventLog()
+
+			if renderer != nil {
+				terraform.OnEvent = renderer.Handle
+			}
 
 			err = _manager.GoToDirRun()
 			if err != nil {
@@ -162,21 +152,83 @@ func Up() *cobra.Command {
 				panic(err)
 			}
 
-			err = terraform.Apply()
+			err = terraform.SelectWorkspace(_config.Environment())
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to select workspace")
+
+				panic(err)
+			}
+
+			if plan || fromPlan {
+				if plan {
+					err = terraform.Plan(_manager.Path.Terraform.UpPlan, false)
+					if err != nil {
+						err := oopsBuilder.
+							Wrapf(err, "failed to plan")
+
+						panic(err)
+					}
+				}
+
+				applied, err := ApplySavedPlan(cmd.OutOrStdout(), terraform, _manager.Path.Terraform.UpPlan, func() (bool, error) {
+					return ConfirmCost(_prompt, _manager, "Apply this plan?", yes)
+				})
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to apply saved plan")
+
+					panic(err)
+				}
+				if !applied {
+					fmt.Fprintf(cmd.OutOrStdout(), "The plan is saved, apply it with kumo up --from-plan\n")
+					return
+				}
+			} else {
+				err = terraform.Apply()
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to apply")
+
+					panic(err)
+				}
+			}
+
+			outputs, err := TerraformOutputs(terraform, renderer)
 			if err != nil {
 				err := oopsBuilder.
-					Wrapf(err, "failed to apply")
+					Wrapf(err, "failed to read terraform outputs")
 
 				panic(err)
 			}
 
-			err = _manager.CreateSshConfig()
+			err = _manager.CreateSshConfig(outputs)
 			if err != nil {
 				err := oopsBuilder.
 					Wrapf(err, "failed to generate ssh config")
 
 				panic(err)
 			}
+
+			if noForward || (len(_config.Forwards.Local) == 0 && len(_config.Forwards.Remote) == 0) {
+				return
+			}
+
+			err = RunForwards(_manager, _config.Forwards.Local, _config.Forwards.Remote)
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to run forwards")
+
+				panic(err)
+			}
 		},
 	}
+
+	command.Flags().BoolVarP(&yes, "yes", "y", false, "Deploy without asking for confirmation")
+	command.Flags().BoolVar(&plan, "plan", false, "Review the changes before deploying, a declined plan is saved")
+	command.Flags().BoolVar(&fromPlan, "from-plan", false, "Deploy the plan saved by --plan")
+	command.MarkFlagsMutuallyExclusive("plan", "from-plan")
+	command.Flags().BoolVar(&noForward, "no-forward", false, "Don't start the forwards of kumo.config.yaml")
+
+	return command
 }
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/file"
+	"github.com/samber/oops"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// Stands in for the image to deploy when checking the Terraform vars before the first build.
+const placeholderImageId = "kumo-validate-placeholder"
+
+// Returns a cobra command. The validate command is used to check the config, templates and generated vars offline.
+func Validate() *cobra.Command {
+	var _config *config.Config
+
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Check your config, templates and generated vars without building or deploying",
+		Long: `Validates the kumo.config.yaml file, renders the Packer and Terraform vars into a temporary directory and
+		checks that every variable required by the Packer and Terraform configurations is assigned. Neither Packer nor
+		Terraform are downloaded or run, which makes it suitable as a pre-commit check.`,
+		Args: cobra.NoArgs,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Validate").
+				In("cmd").
+				Tags("Cobra", "PreRun")
+
+			var err error
+			_config, err = ReadConfig()
+			if err != nil {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Wrapf(err, "Error occurred while reading config file. Make sure a valid kumo.config.yaml file exists in the current working directory"),
+				)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			oopsBuilder := oops.
+				Code("Validate").
+				In("cmd").
+				Tags("Cobra", "Run").
+				With("args", args)
+
+			logger, _ := zap.NewProduction(
+				zap.AddCaller(),
+			)
+			defer logger.Sync()
+
+			defer func() {
+				if r := recover(); r != nil {
+					err := oopsBuilder.Errorf("%v", r)
+					log.Fatalf("panic: %+v", err)
+				}
+			}()
+
+			tempDir, err := os.MkdirTemp("", "kumo-validate-*")
+			if err != nil {
+				err := oopsBuilder.
+					Wrapf(err, "failed to create temporary dir")
+
+				panic(err)
+			}
+			defer os.RemoveAll(tempDir)
+
+			problemsFound := false
+			pathToPackerManifest := ""
+
+			for _, tool := range []iota.Tool{iota.Packer, iota.Terraform} {
+				toolConfig := _config
+
+				// Terraform deploys the image of the last build, which doesn't exist before the first one. Its vars
+				// are still checked, with a placeholder image.
+				if tool == iota.Terraform && _config.Up.AmiId == "" && !file.IsFilePresent(pathToPackerManifest) {
+					placeholderConfig := *_config
+					placeholderConfig.Up.AmiId = placeholderImageId
+					toolConfig = &placeholderConfig
+
+					logger.Info("No image built yet, checking the vars with a placeholder image",
+						zap.String("tool", tool.Name()),
+						zap.String("image", placeholderImageId),
+					)
+				}
+
+				_manager, err := manager.NewManager(iota.CloudIota(_config.Cloud), tool, toolConfig)
+				if err != nil {
+					problemsFound = true
+					logger.Error("Vars weren't checked",
+						zap.String("tool", tool.Name()),
+						zap.Error(err),
+					)
+
+					continue
+				}
+
+				if tool == iota.Packer {
+					pathToPackerManifest = _manager.Path.Packer.Manifest
+				}
+
+				// Render into the temporary dir so the files used by build and up are left untouched.
+				_manager.Path.Template.Merged = filepath.Join(tempDir, tool.Name()+constants.MERGED_TEMPLATE_NAME)
+				_manager.Path.Vars = filepath.Join(tempDir, tool.Name()+tool.VarsName())
+
+				err = _manager.RenderVars()
+				if err != nil {
+					err := oopsBuilder.
+						Wrapf(err, "failed to render vars")
+
+					panic(err)
+				}
+
+				problems, err := _manager.ValidateVars()
+				if err != nil {
+					problemsFound = true
+					logger.Error("Generated vars are invalid",
+						zap.String("tool", tool.Name()),
+						zap.Error(err),
+					)
+
+					continue
+				}
+
+				for _, problem := range problems {
+					problemsFound = true
+					logger.Error(problem, zap.String("tool", tool.Name()))
+				}
+
+				if len(problems) == 0 {
+					logger.Info("Vars are valid", zap.String("tool", tool.Name()))
+				}
+			}
+
+			if problemsFound {
+				log.Fatalf(
+					"%+v",
+					oopsBuilder.
+						Errorf("validation failed"),
+				)
+			}
+
+			logger.Info("Validation completed")
+		},
+	}
+}
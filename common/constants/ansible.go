@@ -0,0 +1,8 @@
+package constants
+
+const (
+	ANSIBLE_DIR           = "ansible"
+	ANSIBLE_PLAYBOOKS_DIR = "playbooks"
+	ANSIBLE_MAIN_PLAYBOOK = "main.yml"
+	ANSIBLE_ALWAYS_TAG    = "always"
+)
@@ -0,0 +1,8 @@
+package constants
+
+const (
+	KUMO_CONFIG          = "kumo.config.yaml"
+	KUMO_CONFIG_TEMPLATE = "kumo.config.tmpl"
+	CONFIG_TEMPLATE_DIR  = "config"
+	DEFAULT_ENVIRONMENT  = "default"
+)
@@ -0,0 +1,6 @@
+package constants
+
+const (
+	DEPENDENCIES_LOCK      = "kumo.lock.json"
+	HASHICORP_RELEASES_URL = "https://releases.hashicorp.com"
+)
@@ -4,6 +4,5 @@ const (
 	HOST = "kumo"
 	KEY_NAME = "kumokey"
 	SSH_PORT = 22
-	IP_FILE_NAME = "instance_ip"
 	CONFIG_NAME = "kumossh"
 )
\ No newline at end of file
@@ -5,4 +5,12 @@ const (
 	TERRAFORM_LOCK               = ".terraform.lock.hcl"
 	TERRAFORM_STATE              = "terraform.tfstate"
 	TERRAFORM_BACKUP             = "terraform.tfstate.backup"
+	TERRAFORM_WORKSPACES_DIR     = "terraform.tfstate.d"
+	TERRAFORM_UP_PLAN            = "up.tfplan"
+	TERRAFORM_DESTROY_PLAN       = "destroy.tfplan"
+	TERRAFORM_POWER_PLAN         = "power.tfplan"
+	TERRAFORM_NAME_TAG           = "kumo"
+	TERRAFORM_INSTANCE_STATE_VAR = "INSTANCE_STATE"
+	INSTANCE_STATE_RUNNING       = "running"
+	INSTANCE_STATE_STOPPED       = "stopped"
 )
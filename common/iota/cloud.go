@@ -1,63 +1,22 @@
 package iota
 
-import (
-	"log"
-
-	"github.com/samber/oops"
-)
-
-type Cloud int
-
-const (
-	Aws Cloud = iota
-)
+// A cloud as written in the Cloud field of kumo.config.yaml. The clouds kumo supports are the ones with a
+// registered provider, see provider.Clouds.
+type Cloud string
 
 func (c Cloud) Iota() Cloud {
 	return c
 }
 
 func (c Cloud) Name() string {
-	oopsBuilder := oops.
-		In("common").
-		In("iota").
-		Tags("Cloud").
-		Code("Name")
-
-	switch c {
-	case Aws:
-		return "aws"
-
-	default:
-		err := oopsBuilder.
-			Errorf("unknown cloud: %#v", c)
-
-		log.Fatalf("%+v", err)
-
-		return ""
-	}
+	return string(c)
 }
 
+// Returns the template files of the cloud, named after it.
 func (c Cloud) TemplateFiles() *TemplateFiles {
-	oopsBuilder := oops.
-		In("common").
-		In("iota").
-		Tags("Cloud").
-		Code("Template")
-
-	switch c {
-	case Aws:
-		return &TemplateFiles{
-			Cloud: "aws.tmpl",
-			Base:  "base.tmpl",
-		}
-
-	default:
-		err := oopsBuilder.
-			Errorf("unknown cloud: %#v", c)
-
-		log.Fatalf("%+v", err)
-
-		return nil
+	return &TemplateFiles{
+		Cloud: c.Name() + ".tmpl",
+		Base:  "base.tmpl",
 	}
 }
 
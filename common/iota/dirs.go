@@ -11,6 +11,7 @@ type Dirs int
 const (
 	Dependencies Dirs = iota
 	Templates
+	Pricing
 )
 
 func (d Dirs) Name() string {
@@ -27,6 +28,9 @@ func (d Dirs) Name() string {
 	case Templates:
 		return "templates"
 
+	case Pricing:
+		return "pricing"
+
 	default:
 		err := oopsBuilder.
 			Errorf("unknown dir: %#v", d)
@@ -9,7 +9,7 @@ import (
 var _ = Describe("CloudIota", func() {
 	Context("aws", func() {
 		It("should return the correct iota", func() {
-			Expect(iota.CloudIota("aws")).To(Equal(iota.Aws))
+			Expect(iota.CloudIota("aws")).To(Equal(iota.Cloud("aws")))
 		})
 	})
 })
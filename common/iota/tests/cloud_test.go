@@ -8,16 +8,18 @@ import (
 
 var _ = Describe("Cloud", func() {
 	Context("aws", func() {
+		cloud := iota.Cloud("aws")
+
 		It("should return the correct iota", func() {
-			Expect(iota.Aws.Iota()).To(Equal(iota.Aws))
+			Expect(cloud.Iota()).To(Equal(cloud))
 		})
 
 		It("should return the correct name", func() {
-			Expect(iota.Aws.Name()).To(Equal("aws"))
+			Expect(cloud.Name()).To(Equal("aws"))
 		})
 
 		It("should return the correct template files", func() {
-			Expect(iota.Aws.TemplateFiles()).To(Equal(&iota.TemplateFiles{
+			Expect(cloud.TemplateFiles()).To(Equal(&iota.TemplateFiles{
 				Cloud: "aws.tmpl",
 				Base:  "base.tmpl",
 			}))
@@ -18,4 +18,10 @@ var _ = Describe("Dirs", func() {
 			Expect(iota.Templates.Name()).To(Equal("templates"))
 		})
 	})
+
+	Context("pricing", func() {
+		It("should return the correct name", func() {
+			Expect(iota.Pricing.Name()).To(Equal("pricing"))
+		})
+	})
 })
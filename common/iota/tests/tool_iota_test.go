@@ -0,0 +1,20 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ToolIota", func() {
+	It("should return the tool of the name", func() {
+		for _, tool := range iota.Tools() {
+			Expect(iota.ToolIota(tool.Name())).To(Equal(tool))
+		}
+	})
+
+	It("should return an error for an unknown tool", func() {
+		_, err := iota.ToolIota("vagrant")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -27,6 +27,10 @@ var _ = Describe("Tool", func() {
 		It("should return the correct plugin dir", func() {
 			Expect(iota.Terraform.PluginDir()).To(Equal(".terraform"))
 		})
+
+		It("should return the correct config extension", func() {
+			Expect(iota.Terraform.ConfigExtension()).To(Equal(".tf"))
+		})
 	})
 
 	Context("packer", func() {
@@ -49,5 +53,9 @@ var _ = Describe("Tool", func() {
 		It("should return the correct plugin dir", func() {
 			Expect(iota.Packer.PluginDir()).To(Equal("plugins"))
 		})
+
+		It("should return the correct config extension", func() {
+			Expect(iota.Packer.ConfigExtension()).To(Equal(".pkr.hcl"))
+		})
 	})
 })
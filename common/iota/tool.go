@@ -65,6 +65,7 @@ func (t Tool) VarsName() string {
 	}
 }
 
+// Returns the version kumo ships with, run unless Versions in the config or the dependencies lock pick another one.
 func (t Tool) Version() string {
 	oopsBuilder := oops.
 		In("common").
@@ -136,3 +137,27 @@ func (t Tool) PluginDir() string {
 		return ""
 	}
 }
+
+func (t Tool) ConfigExtension() string {
+	oopsBuilder := oops.
+		In("common").
+		In("iota").
+		Tags("Tool").
+		Code("ConfigExtension")
+
+	switch t {
+	case Packer:
+		return ".pkr.hcl"
+
+	case Terraform:
+		return ".tf"
+
+	default:
+		err := oopsBuilder.
+			Errorf("unknown tool: %#v", t)
+
+		log.Fatalf("%+v", err)
+
+		return ""
+	}
+}
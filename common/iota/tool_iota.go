@@ -0,0 +1,30 @@
+package iota
+
+import "github.com/samber/oops"
+
+// Returns the tool of the given name, i.e from the args of kumo deps.
+//
+// Example:
+//
+//	("terraform") -> (Terraform, nil)
+func ToolIota(
+	name string,
+) (Tool, error) {
+	for _, tool := range Tools() {
+		if tool.Name() == name {
+			return tool, nil
+		}
+	}
+
+	return 0, oops.
+		In("common").
+		In("iota").
+		Tags("Tool").
+		Code("ToolIota").
+		Errorf("unknown tool %q, expected packer or terraform", name)
+}
+
+// Returns every tool kumo runs.
+func Tools() []Tool {
+	return []Tool{Packer, Terraform}
+}
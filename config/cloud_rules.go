@@ -0,0 +1,27 @@
+package config
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/samber/lo"
+)
+
+// The rules of a cloud for its values of the config, so the config package doesn't know about any cloud. Every
+// provider.Provider implements them, see Load.
+type CloudRules interface {
+	// Returns the cloud as written in the Cloud field of kumo.config.yaml.
+	Cloud() iota.Cloud
+	// Fills the optional values of the cloud that were left empty.
+	ApplyDefaults(_config *Config)
+	// Returns the problems of the values of the cloud, see Rules.
+	Validate(_config *Config) Problems
+}
+
+// Returns the rules of the cloud, nil if none of them is for it.
+func findCloudRules(
+	clouds []CloudRules,
+	cloud string,
+) CloudRules {
+	return lo.FindOrElse(clouds, nil, func(c CloudRules) bool {
+		return c.Cloud().Name() == cloud
+	})
+}
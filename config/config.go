@@ -0,0 +1,172 @@
+package config
+
+// Typed representation of the kumo.config.yaml file. Keys are matched case-insensitively.
+type Config struct {
+	Name         string       `yaml:"name"`
+	Cloud        string       `yaml:"cloud"`
+	AWS          Aws          `yaml:"aws"`
+	GCP          Gcp          `yaml:"gcp"`
+	Azure        Azure        `yaml:"azure"`
+	Hetzner      Hetzner      `yaml:"hetzner"`
+	Local        Local        `yaml:"local"`
+	AMI          Ami          `yaml:"ami"`
+	Git          Git          `yaml:"git"`
+	GitHub       GitHub       `yaml:"github"`
+	Up           Up           `yaml:"up"`
+	Forwards     Forwards     `yaml:"forwards"`
+	IdleShutdown IdleShutdown `yaml:"idleshutdown"`
+	Versions     Versions     `yaml:"versions"`
+	Executables  Executables  `yaml:"executables"`
+	Constraints  Constraints  `yaml:"constraints"`
+	// Base URL of a mirror of https://releases.hashicorp.com to download Packer and Terraform from, i.e an internal
+	// Artifactory. Empty to download them from HashiCorp.
+	Mirror string `yaml:"mirror"`
+}
+
+type Aws struct {
+	AccessKeyId     string   `yaml:"accesskeyid"`
+	SecretAccessKey string   `yaml:"secretaccesskey"`
+	IamProfile      string   `yaml:"iamprofile"`
+	UserIds         []string `yaml:"userids"`
+	Region          string   `yaml:"region"`
+	EC2             Ec2      `yaml:"ec2"`
+}
+
+type Ec2 struct {
+	Instance Instance `yaml:"instance"`
+	Volume   Volume   `yaml:"volume"`
+}
+
+type Instance struct {
+	Type string `yaml:"type"`
+}
+
+type Volume struct {
+	Type string `yaml:"type"`
+	Size int    `yaml:"size"`
+}
+
+type Gcp struct {
+	ProjectId       string   `yaml:"projectid"`
+	CredentialsFile string   `yaml:"credentialsfile"`
+	Region          string   `yaml:"region"`
+	Zone            string   `yaml:"zone"`
+	Image           GcpImage `yaml:"image"`
+	Compute         Compute  `yaml:"compute"`
+}
+
+// Image family the image is built from, i.e ubuntu-2204-lts from the ubuntu-os-cloud project.
+type GcpImage struct {
+	Family  string `yaml:"family"`
+	Project string `yaml:"project"`
+}
+
+type Compute struct {
+	Instance Instance `yaml:"instance"`
+	Disk     Volume   `yaml:"disk"`
+}
+
+// Service principal kumo runs as, created with az ad sp create-for-rbac.
+type Azure struct {
+	SubscriptionId     string     `yaml:"subscriptionid"`
+	TenantId           string     `yaml:"tenantid"`
+	ClientId           string     `yaml:"clientid"`
+	ClientSecret       string     `yaml:"clientsecret"`
+	Location           string     `yaml:"location"`
+	ImageResourceGroup string     `yaml:"imageresourcegroup"`
+	Image              AzureImage `yaml:"image"`
+	VM                 Vm         `yaml:"vm"`
+}
+
+// Marketplace image the managed image is built from.
+type AzureImage struct {
+	Publisher string `yaml:"publisher"`
+	Offer     string `yaml:"offer"`
+	Sku       string `yaml:"sku"`
+}
+
+type Vm struct {
+	Size string `yaml:"size"`
+	Disk Volume `yaml:"disk"`
+}
+
+// Hetzner Cloud project kumo runs in, reached with an API token of the project.
+type Hetzner struct {
+	Token      string `yaml:"token"`
+	Location   string `yaml:"location"`
+	ServerType string `yaml:"servertype"`
+	// Image the snapshot is built from, i.e ubuntu-22.04.
+	Image string `yaml:"image"`
+}
+
+// Docker engine the image is built and run on, reached through DOCKER_HOST like the docker CLI.
+type Local struct {
+	// Image the image is built from, i.e ubuntu:22.04.
+	Image string `yaml:"image"`
+	// Port on 127.0.0.1 the ssh port of the container is published on.
+	SshPort int `yaml:"sshport"`
+}
+
+type Ami struct {
+	Base     AmiBase  `yaml:"base"`
+	Name     string   `yaml:"name"`
+	User     string   `yaml:"user"`
+	Home     string   `yaml:"home"`
+	Password string   `yaml:"password"`
+	Tools    []string `yaml:"tools"`
+}
+
+type AmiBase struct {
+	Filter             string   `yaml:"filter"`
+	RootDeviceType     string   `yaml:"rootdevicetype"`
+	VirtualizationType string   `yaml:"virtualizationtype"`
+	Owners             []string `yaml:"owners"`
+	User               string   `yaml:"user"`
+}
+
+type Git struct {
+	Username string `yaml:"username"`
+	Email    string `yaml:"email"`
+}
+
+type GitHub struct {
+	PersonalAccessTokenClassic string `yaml:"personalaccesstokenclassic"`
+}
+
+type Up struct {
+	AmiId string `yaml:"amiid"`
+}
+
+// Port forwards in the ssh -L/-R format, [bind_address:]port:host:hostport.
+type Forwards struct {
+	Local  []string `yaml:"local"`
+	Remote []string `yaml:"remote"`
+}
+
+// Stops the instance after Minutes without ssh connections and with the CPU usage under CpuThreshold percent.
+// Disabled when Minutes is 0.
+type IdleShutdown struct {
+	Minutes      int `yaml:"minutes"`
+	CpuThreshold int `yaml:"cputhreshold"`
+}
+
+// Packer and Terraform versions to run, i.e 1.9.2. Empty to run the one in the dependencies lock, else the one
+// kumo ships with.
+type Versions struct {
+	Packer    string `yaml:"packer"`
+	Terraform string `yaml:"terraform"`
+}
+
+// Paths of the Packer and Terraform executables to run as is, i.e /usr/local/bin/terraform. Empty to run the one on
+// PATH if it satisfies the constraint, else the one kumo downloads.
+type Executables struct {
+	Packer    string `yaml:"packer"`
+	Terraform string `yaml:"terraform"`
+}
+
+// Versions the Packer and Terraform on PATH must satisfy to be run, i.e ">= 1.9.0, < 2.0.0". Empty to accept the
+// same major version as the one kumo ships with, at least as recent. A version pinned in Versions must match exactly.
+type Constraints struct {
+	Packer    string `yaml:"packer"`
+	Terraform string `yaml:"terraform"`
+}
@@ -0,0 +1,12 @@
+package config
+
+import "regexp"
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// Returns true if the name can be used as an environment name. It ends up in file names, the ssh host
+// alias, the terraform workspace and AWS resource names, so only lowercase alphanumeric characters and
+// '-' (hyphen) are allowed.
+func IsValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
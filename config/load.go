@@ -0,0 +1,118 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/samber/oops"
+	"gopkg.in/yaml.v3"
+)
+
+// Reads, decodes and validates the config file at the given path. clouds are the rules of the clouds with a
+// registered provider, the Cloud is checked against them and its values are defaulted and validated by its
+// rules. knownTools are the ansible tags AMI.Tools entries are checked against, use nil to skip either check.
+//
+// Every problem found (syntax, types, unknown keys, missing or invalid values) is reported
+// together as a Problems error, each one with its file and line position.
+//
+// Example:
+//
+//	("/home/dev/project/kumo.config.yaml", []CloudRules{aws.NewAws()}, []string{"docker", "go"}) -> (*Config, nil)
+func Load(
+	path string,
+	clouds []CloudRules,
+	knownTools []string,
+) (*Config, error) {
+	oopsBuilder := oops.
+		Code("Load").
+		In("config").
+		With("path", path).
+		With("knownTools", knownTools)
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read config file: %s", path)
+	}
+
+	fileName := filepath.Base(path)
+
+	document := &yaml.Node{}
+	err = yaml.Unmarshal(content, document)
+	if err != nil {
+		return nil, Problems{lineProblem(fileName, err.Error())}
+	}
+
+	if len(document.Content) == 0 {
+		return nil, Problems{{File: fileName, Message: "the config file is empty"}}
+	}
+
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, Problems{{
+			File:    fileName,
+			Line:    root.Line,
+			Column:  root.Column,
+			Message: "the config file must be a mapping of keys to values",
+		}}
+	}
+
+	_validator := newValidator(fileName)
+	_validator.normalize(root, configType, "", "")
+
+	_config := &Config{}
+	err = root.Decode(_config)
+	if err != nil {
+		typeError, ok := err.(*yaml.TypeError)
+		if !ok {
+			return nil, oopsBuilder.
+				Wrapf(err, "failed to decode config file: %s", path)
+		}
+
+		for _, message := range typeError.Errors {
+			_validator.problems = append(_validator.problems, lineProblem(fileName, message))
+		}
+	}
+
+	_config.applyDefaults()
+	if cloudRules := findCloudRules(clouds, _config.Cloud); cloudRules != nil {
+		cloudRules.ApplyDefaults(_config)
+	}
+
+	_validator.validate(_config, clouds, knownTools)
+	_validator.place()
+
+	if len(_validator.problems) > 0 {
+		sort.SliceStable(_validator.problems, func(i, j int) bool {
+			return _validator.problems[i].Line < _validator.problems[j].Line
+		})
+
+		return nil, _validator.problems
+	}
+
+	return _config, nil
+}
+
+var (
+	linePattern = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+)
+
+// Turns a yaml error message (i.e "line 3: cannot unmarshal !!str `abc` into int") into a problem,
+// extracting the line if present.
+func lineProblem(fileName, message string) *Problem {
+	problem := &Problem{
+		File:    fileName,
+		Message: message,
+	}
+
+	matches := linePattern.FindStringSubmatch(message)
+	if matches != nil {
+		problem.Line, _ = strconv.Atoi(matches[1])
+		problem.Message = matches[2]
+	}
+
+	return problem
+}
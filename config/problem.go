@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A single issue found in the config file. Line and Column are 0 when the issue
+// can't be tied to a position (i.e a missing top level section).
+type Problem struct {
+	File    string
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+// Formats the problem as "file:line:column: path: message".
+//
+// Example:
+//
+//	"kumo.config.yaml:12:7: AWS.EC2.Instance.Type: must not be empty"
+func (p *Problem) String() string {
+	location := p.File
+	switch {
+	case p.Line > 0 && p.Column > 0:
+		location = fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+
+	case p.Line > 0:
+		location = fmt.Sprintf("%s:%d", p.File, p.Line)
+	}
+
+	if p.Path == "" {
+		return fmt.Sprintf("%s: %s", location, p.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", location, p.Path, p.Message)
+}
+
+// All the problems found in a config file. Implements the error interface so every
+// problem is reported at once.
+type Problems []*Problem
+
+func (p Problems) Error() string {
+	lines := make([]string, 0, len(p)+1)
+	lines = append(lines, fmt.Sprintf("found %d problem(s) in the config file:", len(p)))
+
+	for _, problem := range p {
+		lines = append(lines, "  "+problem.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
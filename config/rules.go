@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Collects the problems found while checking the values of a config, each one with the path of the value, i.e
+// AWS.Region. Load places them at the line of the closest key defined in the file.
+type Rules struct {
+	problems Problems
+}
+
+// Returns rules without any problem reported.
+func NewRules() *Rules {
+	return &Rules{
+		problems: Problems{},
+	}
+}
+
+// Returns the problems reported so far.
+func (r *Rules) Problems() Problems {
+	return r.problems
+}
+
+// Adds a problem for the given path.
+func (r *Rules) Report(
+	path,
+	format string,
+	args ...any,
+) {
+	r.problems = append(r.problems, &Problem{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// Reports the value if it's blank.
+func (r *Rules) Required(path, value string) {
+	if strings.TrimSpace(value) == "" {
+		r.Report(path, "is required")
+	}
+}
+
+// Reports the list if it's empty.
+func (r *Rules) RequiredList(path string, value []string) {
+	if len(value) == 0 {
+		r.Report(path, "must contain at least one entry")
+	}
+}
+
+// Reports the message if the value is present and doesn't match the pattern.
+func (r *Rules) Match(
+	path,
+	value string,
+	pattern *regexp.Regexp,
+	message string,
+) {
+	if value != "" && !pattern.MatchString(value) {
+		r.Report(path, message)
+	}
+}
+
+// Returns the path of an entry of a list, i.e AWS.UserIds[0].
+func IndexPath(path string, index int) string {
+	return fmt.Sprintf("%s[%d]", path, index)
+}
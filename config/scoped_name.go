@@ -0,0 +1,31 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ed3899/kumo/common/constants"
+)
+
+// Returns the name scoped to the environment of the config, so that resources of different environments
+// don't collide. The default environment keeps the name as is.
+//
+// Example:
+//
+//	Name: gpu-box
+//	("kumokey") -> "kumokey-gpu-box"
+func (c *Config) ScopedName(name string) string {
+	if c.Environment() == constants.DEFAULT_ENVIRONMENT {
+		return name
+	}
+
+	return fmt.Sprintf("%s-%s", name, c.Environment())
+}
+
+// Returns the environment name, "default" when none was given.
+func (c *Config) Environment() string {
+	if c.Name == "" {
+		return constants.DEFAULT_ENVIRONMENT
+	}
+
+	return c.Name
+}
@@ -0,0 +1,32 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Default", Label("unit"), func() {
+	It("should take the AWS credentials and region from the environment", func() {
+		GinkgoT().Setenv("AWS_ACCESS_KEY_ID", "AKIAI44QH8DHBEXAMPLE")
+		GinkgoT().Setenv("AWS_SECRET_ACCESS_KEY", "je7MtGbClwBF/2Zp9Utk/h3yCo8nvbEXAMPLEKEY")
+		GinkgoT().Setenv("AWS_REGION", "eu-west-1")
+
+		_config, err := config.Default()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(_config.AWS.AccessKeyId).To(Equal("AKIAI44QH8DHBEXAMPLE"))
+		Expect(_config.AWS.SecretAccessKey).To(Equal("je7MtGbClwBF/2Zp9Utk/h3yCo8nvbEXAMPLEKEY"))
+		Expect(_config.AWS.Region).To(Equal("eu-west-1"))
+	})
+
+	It("should generate a different password every time", func() {
+		first, err := config.Default()
+		Expect(err).ToNot(HaveOccurred())
+
+		second, err := config.Default()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(first.AMI.Password).To(HaveLen(16))
+		Expect(first.AMI.Password).ToNot(Equal(second.AMI.Password))
+	})
+})
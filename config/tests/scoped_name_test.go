@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScopedName", Label("unit"), func() {
+	It("should keep the name for the default environment", func() {
+		Expect((&config.Config{}).ScopedName("kumokey")).To(Equal("kumokey"))
+		Expect((&config.Config{Name: "default"}).ScopedName("kumokey")).To(Equal("kumokey"))
+	})
+
+	It("should suffix the name with any other environment", func() {
+		Expect((&config.Config{Name: "gpu-box"}).ScopedName("kumokey")).To(Equal("kumokey-gpu-box"))
+	})
+})
+
+var _ = Describe("IsValidName", Label("unit"), func() {
+	It("should accept lowercase alphanumeric names with hyphens", func() {
+		Expect(config.IsValidName("gpu-box")).To(BeTrue())
+		Expect(config.IsValidName("box2")).To(BeTrue())
+	})
+
+	It("should reject anything else", func() {
+		Expect(config.IsValidName("")).To(BeFalse())
+		Expect(config.IsValidName("-box")).To(BeFalse())
+		Expect(config.IsValidName("GPU")).To(BeFalse())
+		Expect(config.IsValidName("gpu_box")).To(BeFalse())
+		Expect(config.IsValidName("a23456789012345678901234567890123")).To(BeFalse())
+	})
+})
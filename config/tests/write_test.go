@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/provider/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Write", Label("unit"), func() {
+	var (
+		knownClouds    = []config.CloudRules{aws.NewAws()}
+		knownTools     = []string{"docker", "minikube", "go", "github"}
+		pathToTemplate = filepath.Join("..", "..", "templates", "config", "kumo.config.tmpl")
+
+		configPath string
+	)
+
+	BeforeEach(func() {
+		configPath = filepath.Join(GinkgoT().TempDir(), "kumo.config.yaml")
+	})
+
+	It("should write the default config as a valid file", func() {
+		_config, err := config.Default()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(config.Write(pathToTemplate, configPath, _config)).To(Succeed())
+
+		loaded, err := config.Load(configPath, knownClouds, knownTools)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loaded).To(Equal(_config))
+	})
+
+	It("should only let the owner read the file, even one written before", func() {
+		if runtime.GOOS == "windows" {
+			Skip("windows doesn't have unix permissions")
+		}
+
+		_config, err := config.Default()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.WriteFile(configPath, []byte("Cloud: aws\n"), 0644)).To(Succeed())
+		Expect(config.Write(pathToTemplate, configPath, _config)).To(Succeed())
+
+		info, err := os.Stat(configPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("should keep the quotes on the user ids and write the optional sections", func() {
+		_config, err := config.Default()
+		Expect(err).ToNot(HaveOccurred())
+
+		_config.AWS.UserIds = []string{"012345678901"}
+		_config.AMI.Tools = []string{"docker", "minikube", "github"}
+		_config.GitHub.PersonalAccessTokenClassic = "ghp_token"
+		_config.Up.AmiId = "ami-0c3fd0f5d33134a76"
+		_config.Forwards.Local = []string{"8080:localhost:3000"}
+		_config.IdleShutdown.Minutes = 90
+		_config.Versions.Packer = "1.10.0"
+		_config.Executables.Terraform = "/usr/local/bin/terraform"
+		_config.Constraints.Packer = ">= 1.9.0, < 2.0.0"
+		_config.Mirror = "https://artifactory.example.com/artifactory/hashicorp-releases"
+
+		Expect(config.Write(pathToTemplate, configPath, _config)).To(Succeed())
+
+		content, err := os.ReadFile(configPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring(`- "012345678901"`))
+
+		loaded, err := config.Load(configPath, knownClouds, knownTools)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loaded).To(Equal(_config))
+	})
+
+	It("should return an error for a missing template", func() {
+		_config, err := config.Default()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(config.Write("missing.tmpl", configPath, _config)).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,166 @@
+package config
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/utils/forward_spec"
+	"github.com/hashicorp/go-version"
+	"github.com/samber/lo"
+)
+
+var (
+	amiUserPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]+$`)
+	amiHomePattern = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+	emailPattern   = regexp.MustCompile(`^\S+@\S+\.\S+$`)
+	// The versions of the Hashicorp releases, i.e 1.9.2 or 1.6.0-beta1.
+	toolVersionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+(?:-[0-9A-Za-z.]+)?$`)
+	mirrorPattern      = regexp.MustCompile(`^https?://[^\s/?#]+(?:/[^\s?#]*)?$`)
+)
+
+const (
+	maximumGitUsername  = 20
+	maximumAmiName      = 128
+	minimumPasswordSize = 8
+	maximumPasswordSize = 20
+	defaultCpuThreshold = 10
+)
+
+// Fills the optional values that were left empty, but those of the cloud, see CloudRules.
+func (c *Config) applyDefaults() {
+	if c.Name == "" {
+		c.Name = constants.DEFAULT_ENVIRONMENT
+	}
+
+	if c.IdleShutdown.CpuThreshold == 0 {
+		c.IdleShutdown.CpuThreshold = defaultCpuThreshold
+	}
+}
+
+// Runs the required, format and cross-field rules against the decoded config.
+func (v *validator) validate(
+	_config *Config,
+	clouds []CloudRules,
+	knownTools []string,
+) {
+	v.Match("Name", _config.Name, namePattern, "must be 1 to 32 lowercase alphanumeric characters or '-' (hyphen), starting with an alphanumeric character")
+
+	v.Required("Cloud", _config.Cloud)
+	if _config.Cloud != "" && clouds != nil {
+		cloudRules := findCloudRules(clouds, _config.Cloud)
+		if cloudRules == nil {
+			knownClouds := lo.Map(clouds, func(c CloudRules, _ int) string {
+				return c.Cloud().Name()
+			})
+
+			v.Report("Cloud", "unknown cloud %q, expected one of: %s", _config.Cloud, strings.Join(knownClouds, ", "))
+		} else {
+			v.Rules.problems = append(v.Rules.problems, cloudRules.Validate(_config)...)
+		}
+	}
+
+	v.validateAmi(&_config.AMI, knownTools)
+
+	v.Required("Git.Username", _config.Git.Username)
+	if len(_config.Git.Username) > maximumGitUsername {
+		v.Report("Git.Username", "must be at most %d characters long", maximumGitUsername)
+	}
+
+	v.Required("Git.Email", _config.Git.Email)
+	v.Match("Git.Email", _config.Git.Email, emailPattern, "must be a valid email address")
+
+	if lo.Contains(_config.AMI.Tools, "github") && _config.GitHub.PersonalAccessTokenClassic == "" {
+		v.Report("GitHub.PersonalAccessTokenClassic", "is required when 'github' is listed in AMI.Tools")
+	}
+
+	for i, spec := range _config.Forwards.Local {
+		v.forward(IndexPath("Forwards.Local", i), spec)
+	}
+	for i, spec := range _config.Forwards.Remote {
+		v.forward(IndexPath("Forwards.Remote", i), spec)
+	}
+
+	if _config.IdleShutdown.Minutes < 0 {
+		v.Report("IdleShutdown.Minutes", "must be greater than or equal to 0, 0 disables the idle shutdown")
+	}
+
+	if _config.IdleShutdown.CpuThreshold < 1 || _config.IdleShutdown.CpuThreshold > 100 {
+		v.Report("IdleShutdown.CpuThreshold", "must be a percentage between 1 and 100")
+	}
+
+	v.Match("Versions.Packer", _config.Versions.Packer, toolVersionPattern, "must be a release version (i.e 1.9.2)")
+	v.Match("Versions.Terraform", _config.Versions.Terraform, toolVersionPattern, "must be a release version (i.e 1.5.5)")
+	v.absolutePath("Executables.Packer", _config.Executables.Packer, "must be an absolute path (i.e /usr/local/bin/packer)")
+	v.absolutePath("Executables.Terraform", _config.Executables.Terraform, "must be an absolute path (i.e /usr/local/bin/terraform)")
+	v.constraint("Constraints.Packer", _config.Constraints.Packer)
+	v.constraint("Constraints.Terraform", _config.Constraints.Terraform)
+	v.Match("Mirror", _config.Mirror, mirrorPattern, "must be an http or https URL without query (i.e https://artifactory.example.com/artifactory/hashicorp-releases)")
+}
+
+func (v *validator) validateAmi(
+	ami *Ami,
+	knownTools []string,
+) {
+	v.Required("AMI.Name", ami.Name)
+	if len(ami.Name) > maximumAmiName {
+		v.Report("AMI.Name", "must be at most %d characters long", maximumAmiName)
+	}
+
+	v.Required("AMI.User", ami.User)
+	v.Match("AMI.User", ami.User, amiUserPattern, "must contain only alphanumeric characters and '_' (underscore)")
+
+	v.Required("AMI.Home", ami.Home)
+	v.Match("AMI.Home", ami.Home, amiHomePattern, "must contain only alphanumeric characters")
+
+	v.Required("AMI.Password", ami.Password)
+	if ami.Password != "" && (len(ami.Password) < minimumPasswordSize || len(ami.Password) > maximumPasswordSize) {
+		v.Report("AMI.Password", "must be between %d and %d characters long", minimumPasswordSize, maximumPasswordSize)
+	}
+
+	v.RequiredList("AMI.Tools", ami.Tools)
+	for i, tool := range ami.Tools {
+		if knownTools != nil && !lo.Contains(knownTools, tool) {
+			v.Report(IndexPath("AMI.Tools", i), "unknown tool %q, expected one of: %s", tool, strings.Join(knownTools, ", "))
+		}
+	}
+
+	// Minikube runs on top of docker, so the docker playbook must run first.
+	minikubeIndex := lo.IndexOf(ami.Tools, "minikube")
+	dockerIndex := lo.IndexOf(ami.Tools, "docker")
+	if minikubeIndex != -1 && (dockerIndex == -1 || dockerIndex > minikubeIndex) {
+		v.Report(IndexPath("AMI.Tools", minikubeIndex), "'minikube' requires 'docker' to be listed before it")
+	}
+}
+
+// Reports the value if it isn't a valid port forward.
+func (v *validator) forward(path, value string) {
+	_, err := forward_spec.ParseForwardSpec(value)
+	if err != nil {
+		v.Report(path, "must be [bind_address:]port:host:hostport with ports between 1 and 65535 (i.e 8080:localhost:3000)")
+	}
+}
+
+// Reports the message if the value is present and isn't an absolute path.
+func (v *validator) absolutePath(
+	path,
+	value,
+	message string,
+) {
+	if value != "" && !filepath.IsAbs(value) {
+		v.Report(path, message)
+	}
+}
+
+// Reports the value if it's present and isn't a valid version constraint.
+func (v *validator) constraint(path, value string) {
+	if value == "" {
+		return
+	}
+
+	_, err := version.NewConstraint(value)
+	if err != nil {
+		v.Report(path, "must be a version constraint (i.e >= 1.9.0, < 2.0.0)")
+	}
+}
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	configType = reflect.TypeOf(Config{})
+)
+
+// Collects problems while walking and validating a config file. The rules report the problems of the decoded
+// values, which are placed in the file once validated, see place.
+type validator struct {
+	*Rules
+	fileName  string
+	positions map[string]*yaml.Node
+	problems  Problems
+}
+
+func newValidator(fileName string) *validator {
+	return &validator{
+		Rules:     NewRules(),
+		fileName:  fileName,
+		positions: map[string]*yaml.Node{},
+		problems:  Problems{},
+	}
+}
+
+// Lowercases every mapping key so they match the struct tags, records the position of each key
+// and reports unknown or duplicated keys.
+func (v *validator) normalize(
+	node *yaml.Node,
+	_type reflect.Type,
+	keyPath,
+	displayPath string,
+) {
+	switch {
+	case node.Kind == yaml.MappingNode && _type.Kind() == reflect.Struct:
+		fields := map[string]reflect.Type{}
+		for i := 0; i < _type.NumField(); i++ {
+			fields[_type.Field(i).Tag.Get("yaml")] = _type.Field(i).Type
+		}
+
+		seen := map[string]bool{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			lowered := strings.ToLower(key.Value)
+			childKeyPath := joinPath(keyPath, lowered)
+			childDisplayPath := joinPath(displayPath, key.Value)
+
+			if seen[lowered] {
+				v.problems = append(v.problems, &Problem{
+					File:    v.fileName,
+					Path:    childDisplayPath,
+					Line:    key.Line,
+					Column:  key.Column,
+					Message: "is defined more than once",
+				})
+
+				continue
+			}
+			seen[lowered] = true
+
+			fieldType, ok := fields[lowered]
+			if !ok {
+				v.problems = append(v.problems, &Problem{
+					File:    v.fileName,
+					Path:    childDisplayPath,
+					Line:    key.Line,
+					Column:  key.Column,
+					Message: "unknown key",
+				})
+
+				continue
+			}
+
+			key.Value = lowered
+			v.positions[childKeyPath] = key
+			v.normalize(value, fieldType, childKeyPath, childDisplayPath)
+		}
+
+	case node.Kind == yaml.SequenceNode && _type.Kind() == reflect.Slice:
+		for i, item := range node.Content {
+			itemKeyPath := fmt.Sprintf("%s[%d]", keyPath, i)
+			v.positions[itemKeyPath] = item
+			v.normalize(item, _type.Elem(), itemKeyPath, fmt.Sprintf("%s[%d]", displayPath, i))
+		}
+	}
+}
+
+// Adds the problems reported by the rules. The position of the closest defined key is used, so missing
+// keys are reported at their parent section.
+func (v *validator) place() {
+	for _, problem := range v.Rules.Problems() {
+		problem.File = v.fileName
+
+		for keyPath := strings.ToLower(problem.Path); keyPath != ""; keyPath = parentPath(keyPath) {
+			if node, ok := v.positions[keyPath]; ok {
+				problem.Line = node.Line
+				problem.Column = node.Column
+				break
+			}
+		}
+
+		v.problems = append(v.problems, problem)
+	}
+}
+
+func joinPath(parent, child string) string {
+	if parent == "" {
+		return child
+	}
+
+	return parent + "." + child
+}
+
+func parentPath(path string) string {
+	index := strings.LastIndexAny(path, ".[")
+	if index == -1 {
+		return ""
+	}
+
+	return path[:index]
+}
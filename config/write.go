@@ -0,0 +1,64 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"text/template"
+
+	"github.com/samber/oops"
+)
+
+// Renders the config into a commented kumo.config.yaml file using the given template. String values
+// are double quoted so that values like the AWS user ids keep their type. The file holds the cloud
+// credentials and the AMI password, so only its owner can read it.
+//
+// Example:
+//
+//	("templates/config/kumo.config.tmpl", "kumo.config.yaml", _config) -> nil
+func Write(
+	pathToTemplate,
+	pathToConfig string,
+	_config *Config,
+) error {
+	oopsBuilder := oops.
+		Code("Write").
+		In("config").
+		With("pathToTemplate", pathToTemplate).
+		With("pathToConfig", pathToConfig)
+
+	funcs := template.FuncMap{
+		"quote": strconv.Quote,
+	}
+
+	configTemplate, err := template.
+		New(filepath.Base(pathToTemplate)).
+		Funcs(funcs).
+		ParseFiles(pathToTemplate)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to parse template")
+	}
+
+	file, err := os.OpenFile(pathToConfig, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to create config file")
+	}
+	defer file.Close()
+
+	// The mode of OpenFile only applies to new files, the config may have been written by an older kumo.
+	err = file.Chmod(0600)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to restrict the permissions of the config file")
+	}
+
+	err = configTemplate.Execute(file, _config)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to execute template")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package dependencies
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Returns -1, 0 or 1 when a is older than, the same as or newer than b. A prerelease is older than its release.
+//
+// Example:
+//
+//	("1.9.2", "1.10.0") -> -1
+//	("1.6.0-beta1", "1.6.0") -> -1
+func CompareVersions(a, b string) int {
+	aRelease, aPrerelease, _ := strings.Cut(a, "-")
+	bRelease, bPrerelease, _ := strings.Cut(b, "-")
+
+	aParts := strings.Split(aRelease, ".")
+	bParts := strings.Split(bRelease, ".")
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+
+		if aPart != bPart {
+			if aPart < bPart {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	switch {
+	case aPrerelease == bPrerelease:
+		return 0
+
+	case aPrerelease == "":
+		return 1
+
+	case bPrerelease == "":
+		return -1
+
+	default:
+		return strings.Compare(aPrerelease, bPrerelease)
+	}
+}
@@ -0,0 +1,31 @@
+package dependencies
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+)
+
+// Returns the path of the executable of the tool set in Executables of the config, empty if there is none.
+//
+// Example:
+//
+//	(iota.Terraform, &config.Config{Executables: config.Executables{Terraform: "/usr/local/bin/terraform"}}) -> "/usr/local/bin/terraform"
+func ConfiguredExecutable(
+	tool iota.Tool,
+	_config *config.Config,
+) string {
+	if _config == nil {
+		return ""
+	}
+
+	switch tool {
+	case iota.Packer:
+		return _config.Executables.Packer
+
+	case iota.Terraform:
+		return _config.Executables.Terraform
+
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,16 @@
+package dependencies
+
+import "github.com/ed3899/kumo/common/iota"
+
+// Forgets an installed version of the tool, i.e once kumo deps prune removed it.
+func (l *Lock) Forget(
+	tool iota.Tool,
+	version string,
+) {
+	lockedTool, ok := l.Tools[tool.Name()]
+	if !ok {
+		return
+	}
+
+	delete(lockedTool.Sha256, version)
+}
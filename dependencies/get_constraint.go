@@ -0,0 +1,55 @@
+package dependencies
+
+import (
+	"fmt"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/hashicorp/go-version"
+	"github.com/samber/oops"
+)
+
+// Returns the version constraint the tool on PATH must satisfy: the version pinned in Versions of the config, else
+// the one in Constraints, else the same major version as the one kumo would download, at least as recent. The
+// config may be nil, i.e when there is no kumo.config.yaml.
+//
+// Example:
+//
+//	(iota.Packer, &config.Config{Versions: config.Versions{Packer: "1.10.0"}}, lock) -> ("= 1.10.0", nil)
+//	(iota.Packer, nil, &Lock{}) -> (">= 1.9.2, < 2.0.0", nil)
+func GetConstraint(
+	tool iota.Tool,
+	_config *config.Config,
+	lock *Lock,
+) (string, error) {
+	if pinnedVersion := PinnedVersion(tool, _config); pinnedVersion != "" {
+		return fmt.Sprintf("= %s", pinnedVersion), nil
+	}
+
+	if _config != nil {
+		switch tool {
+		case iota.Packer:
+			if _config.Constraints.Packer != "" {
+				return _config.Constraints.Packer, nil
+			}
+
+		case iota.Terraform:
+			if _config.Constraints.Terraform != "" {
+				return _config.Constraints.Terraform, nil
+			}
+		}
+	}
+
+	resolvedVersion := ResolveVersion(tool, _config, lock)
+
+	parsedVersion, err := version.NewVersion(resolvedVersion)
+	if err != nil {
+		return "", oops.
+			Code("GetConstraint").
+			In("dependencies").
+			With("tool", tool.Name()).
+			Wrapf(err, "failed to parse the version %s of %s", resolvedVersion, tool.Name())
+	}
+
+	return fmt.Sprintf(">= %s, < %d.0.0", resolvedVersion, parsedVersion.Segments()[0]+1), nil
+}
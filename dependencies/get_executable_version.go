@@ -0,0 +1,47 @@
+package dependencies
+
+import (
+	"os"
+	"os/exec"
+	"regexp"
+
+	"github.com/samber/oops"
+)
+
+var (
+	// The first line of packer version and terraform version, i.e Packer v1.9.2 or Terraform v1.5.5.
+	executableVersionPattern = regexp.MustCompile(`(?m)^\w+ v(\d+\.\d+\.\d+(?:-[0-9A-Za-z.]+)?)\s*$`)
+)
+
+// Returns the version the executable reports with its version command. The update check is disabled, so it
+// doesn't reach the network.
+//
+// Example:
+//
+//	("/home/dev/kumo/dependencies/packer/1.9.2/packer") -> ("1.9.2", nil)
+func GetExecutableVersion(
+	pathToExecutable string,
+) (string, error) {
+	oopsBuilder := oops.
+		Code("GetExecutableVersion").
+		In("dependencies").
+		With("pathToExecutable", pathToExecutable)
+
+	cmd := exec.Command(pathToExecutable, "version")
+	cmd.Env = append(os.Environ(), "CHECKPOINT_DISABLE=1")
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to run %s version", pathToExecutable)
+	}
+
+	match := executableVersionPattern.FindSubmatch(output)
+	if match == nil {
+		return "", oopsBuilder.
+			With("output", string(output)).
+			Errorf("failed to find the version in the output of %s version", pathToExecutable)
+	}
+
+	return string(match[1]), nil
+}
@@ -0,0 +1,51 @@
+package dependencies
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/utils/file"
+	"github.com/samber/oops"
+)
+
+// Returns the versions of the tool with an executable under dependencies/<tool>/<version>, sorted.
+//
+// Example:
+//
+//	("/home/dev/kumo/dependencies", iota.Packer) -> ([]string{"1.9.2", "1.10.0"}, nil)
+func GetInstalledVersions(
+	pathToDependencies string,
+	tool iota.Tool,
+) ([]string, error) {
+	oopsBuilder := oops.
+		Code("GetInstalledVersions").
+		In("dependencies").
+		With("pathToDependencies", pathToDependencies).
+		With("tool", tool.Name())
+
+	entries, err := os.ReadDir(filepath.Join(pathToDependencies, tool.Name()))
+	if errors.Is(err, fs.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read the installed versions of %s", tool.Name())
+	}
+
+	versions := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && file.IsFilePresent(PathToExecutable(pathToDependencies, tool, entry.Name())) {
+			versions = append(versions, entry.Name())
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return CompareVersions(versions[i], versions[j]) < 0
+	})
+
+	return versions, nil
+}
@@ -0,0 +1,46 @@
+package dependencies
+
+import (
+	"encoding/json"
+
+	"github.com/ed3899/kumo/utils/url"
+	"github.com/samber/oops"
+)
+
+// Returns the latest version of a tool from the Hashicorp checkpoint API, the one packer and terraform check for
+// updates with.
+//
+// Example:
+//
+//	("https://checkpoint-api.hashicorp.com/v1/check/packer") -> ("1.10.0", nil)
+func GetLatestVersion(
+	checkpointUrl string,
+) (string, error) {
+	oopsBuilder := oops.
+		Code("GetLatestVersion").
+		In("dependencies").
+		With("checkpointUrl", checkpointUrl)
+
+	content, err := url.Get(checkpointUrl)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to get the latest version")
+	}
+
+	check := &struct {
+		CurrentVersion string `json:"current_version"`
+	}{}
+
+	err = json.Unmarshal(content, check)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to parse the latest version")
+	}
+
+	if check.CurrentVersion == "" {
+		return "", oopsBuilder.
+			Errorf("the checkpoint API returned no version")
+	}
+
+	return check.CurrentVersion, nil
+}
@@ -0,0 +1,26 @@
+package dependencies
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/samber/oops"
+)
+
+// Returns the path of the dependencies dir, next to the kumo executable.
+//
+// Example:
+//
+//	() -> ("/home/dev/kumo/dependencies", nil)
+func GetPathToDependencies() (string, error) {
+	currentExecutablePath, err := os.Executable()
+	if err != nil {
+		return "", oops.
+			Code("GetPathToDependencies").
+			In("dependencies").
+			Wrapf(err, "failed to get current executable path")
+	}
+
+	return filepath.Join(filepath.Dir(currentExecutablePath), iota.Dependencies.Name()), nil
+}
@@ -0,0 +1,23 @@
+package dependencies
+
+import (
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/config"
+)
+
+// Returns the base URL Packer and Terraform are downloaded from: the Mirror of the config, else HashiCorp's. The
+// config may be nil, i.e when there is no kumo.config.yaml.
+//
+// Example:
+//
+//	(&config.Config{Mirror: "https://artifactory.example.com/artifactory/hashicorp-releases"}) -> "https://artifactory.example.com/artifactory/hashicorp-releases"
+//	(nil) -> "https://releases.hashicorp.com"
+func GetReleasesUrl(
+	_config *config.Config,
+) string {
+	if _config == nil || _config.Mirror == "" {
+		return constants.HASHICORP_RELEASES_URL
+	}
+
+	return _config.Mirror
+}
@@ -0,0 +1,13 @@
+package dependencies
+
+// The Packer and Terraform versions kumo installed, kept in dependencies/kumo.lock.json by tool name.
+type Lock struct {
+	Tools map[string]*LockedTool `json:"tools"`
+}
+
+type LockedTool struct {
+	// Version kumo runs, unless Versions in the config pins another one.
+	Version string `json:"version"`
+	// Checksum of the release zip of every installed version, by version.
+	Sha256 map[string]string `json:"sha256"`
+}
@@ -0,0 +1,27 @@
+package dependencies
+
+import (
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/utils/host"
+)
+
+// Returns the path of the executable of a version of the tool, several versions are installed side by side.
+//
+// Example:
+//
+//	("/home/dev/kumo/dependencies", iota.Packer, "1.9.2") -> "/home/dev/kumo/dependencies/packer/1.9.2/packer"
+func PathToExecutable(
+	pathToDependencies string,
+	tool iota.Tool,
+	version string,
+) string {
+	return filepath.Join(
+		pathToDependencies,
+		tool.Name(),
+		version,
+		host.ExecutableName(tool.Name(), runtime.GOOS),
+	)
+}
@@ -0,0 +1,18 @@
+package dependencies
+
+import (
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/constants"
+)
+
+// Returns the path of the lock recording the installed versions.
+//
+// Example:
+//
+//	("/home/dev/kumo/dependencies") -> "/home/dev/kumo/dependencies/kumo.lock.json"
+func PathToLock(
+	pathToDependencies string,
+) string {
+	return filepath.Join(pathToDependencies, constants.DEPENDENCIES_LOCK)
+}
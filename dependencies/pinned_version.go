@@ -0,0 +1,31 @@
+package dependencies
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+)
+
+// Returns the version of the tool pinned in Versions of the config, empty if there is none.
+//
+// Example:
+//
+//	(iota.Terraform, &config.Config{Versions: config.Versions{Terraform: "1.6.2"}}) -> "1.6.2"
+func PinnedVersion(
+	tool iota.Tool,
+	_config *config.Config,
+) string {
+	if _config == nil {
+		return ""
+	}
+
+	switch tool {
+	case iota.Packer:
+		return _config.Versions.Packer
+
+	case iota.Terraform:
+		return _config.Versions.Terraform
+
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,49 @@
+package dependencies
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/samber/oops"
+)
+
+// Returns the lock at the given path, or an empty lock if nothing was installed yet.
+//
+// Example:
+//
+//	("/home/dev/kumo/dependencies/kumo.lock.json") -> (&Lock{Tools: map[string]*LockedTool{"packer": {Version: "1.9.2", ...}}}, nil)
+func ReadLock(
+	pathToLock string,
+) (*Lock, error) {
+	oopsBuilder := oops.
+		Code("ReadLock").
+		In("dependencies").
+		With("pathToLock", pathToLock)
+
+	lock := &Lock{
+		Tools: make(map[string]*LockedTool),
+	}
+
+	content, err := os.ReadFile(pathToLock)
+	if errors.Is(err, fs.ErrNotExist) {
+		return lock, nil
+	}
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read %s", pathToLock)
+	}
+
+	err = json.Unmarshal(content, lock)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to parse %s", pathToLock)
+	}
+
+	if lock.Tools == nil {
+		lock.Tools = make(map[string]*LockedTool)
+	}
+
+	return lock, nil
+}
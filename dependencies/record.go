@@ -0,0 +1,26 @@
+package dependencies
+
+import "github.com/ed3899/kumo/common/iota"
+
+// Records that the version of the tool is installed and run from now on. The checksum is kept when empty, i.e for
+// a version installed before.
+func (l *Lock) Record(
+	tool iota.Tool,
+	version,
+	sha256 string,
+) {
+	lockedTool, ok := l.Tools[tool.Name()]
+	if !ok {
+		lockedTool = &LockedTool{}
+		l.Tools[tool.Name()] = lockedTool
+	}
+
+	if lockedTool.Sha256 == nil {
+		lockedTool.Sha256 = make(map[string]string)
+	}
+
+	lockedTool.Version = version
+	if sha256 != "" {
+		lockedTool.Sha256[version] = sha256
+	}
+}
@@ -0,0 +1,21 @@
+package dependencies
+
+// Where the executable kumo runs comes from.
+type Source string
+
+const (
+	// Executables in kumo.config.yaml
+	SourceConfig Source = "config"
+	// Found on PATH, satisfying the constraint
+	SourcePath Source = "path"
+	// Downloaded by kumo under dependencies/<tool>/<version>
+	SourceDependencies Source = "dependencies"
+)
+
+// The executable of a tool kumo runs and why it was chosen.
+type Resolution struct {
+	Source  Source
+	Path    string
+	Version string
+	Reason  string
+}
@@ -0,0 +1,92 @@
+package dependencies
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/hashicorp/go-version"
+	"github.com/samber/oops"
+)
+
+// Returns the executable of the tool kumo runs, in this order:
+//   - the path set in Executables of the config
+//   - the tool on PATH, if its version satisfies the constraint, see GetConstraint
+//   - the version kumo downloads under dependencies, see ResolveVersion. It may not be installed yet.
+//
+// The config may be nil, i.e when there is no kumo.config.yaml.
+//
+// Example:
+//
+//	(iota.Terraform, nil, lock, "/home/dev/kumo/dependencies") -> (&Resolution{Source: SourcePath, Path: "/usr/bin/terraform", Version: "1.5.7", Reason: "found on PATH, 1.5.7 satisfies >= 1.5.5, < 2.0.0"}, nil)
+func ResolveExecutable(
+	tool iota.Tool,
+	_config *config.Config,
+	lock *Lock,
+	pathToDependencies string,
+) (*Resolution, error) {
+	oopsBuilder := oops.
+		Code("ResolveExecutable").
+		In("dependencies").
+		With("tool", tool.Name()).
+		With("pathToDependencies", pathToDependencies)
+
+	if configuredExecutable := ConfiguredExecutable(tool, _config); configuredExecutable != "" {
+		configuredVersion, err := GetExecutableVersion(configuredExecutable)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "failed to run the %s set in Executables of the config", tool.Name())
+		}
+
+		return &Resolution{
+			Source:  SourceConfig,
+			Path:    configuredExecutable,
+			Version: configuredVersion,
+			Reason:  "set in Executables of kumo.config.yaml",
+		}, nil
+	}
+
+	constraint, err := GetConstraint(tool, _config, lock)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the constraint of %s", tool.Name())
+	}
+
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to parse the constraint %q of %s", constraint, tool.Name())
+	}
+
+	var reason string
+
+	pathOnPath, err := exec.LookPath(tool.Name())
+	if err != nil {
+		reason = fmt.Sprintf("no %s on PATH", tool.Name())
+	} else {
+		versionOnPath, err := GetExecutableVersion(pathOnPath)
+		if err != nil {
+			// i.e the packer of cracklib on some linux distributions
+			reason = fmt.Sprintf("%s doesn't report a %s version", pathOnPath, tool.Name())
+		} else if parsedVersion, err := version.NewVersion(versionOnPath); err == nil && constraints.Check(parsedVersion) {
+			return &Resolution{
+				Source:  SourcePath,
+				Path:    pathOnPath,
+				Version: versionOnPath,
+				Reason:  fmt.Sprintf("found on PATH, %s satisfies %s", versionOnPath, constraint),
+			}, nil
+		} else {
+			reason = fmt.Sprintf("%s is %s, which doesn't satisfy %s", pathOnPath, versionOnPath, constraint)
+		}
+	}
+
+	resolvedVersion := ResolveVersion(tool, _config, lock)
+
+	return &Resolution{
+		Source:  SourceDependencies,
+		Path:    PathToExecutable(pathToDependencies, tool, resolvedVersion),
+		Version: resolvedVersion,
+		Reason:  fmt.Sprintf("%s, kumo runs its own %s", reason, resolvedVersion),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package dependencies
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+)
+
+// Returns the version of the tool kumo runs: the one pinned in Versions of the config, else the one of the lock,
+// else the one kumo ships with. The config may be nil, i.e when there is no kumo.config.yaml.
+//
+// Example:
+//
+//	(iota.Packer, &config.Config{Versions: config.Versions{Packer: "1.10.0"}}, lock) -> "1.10.0"
+//	(iota.Packer, nil, &Lock{}) -> "1.9.2"
+func ResolveVersion(
+	tool iota.Tool,
+	_config *config.Config,
+	lock *Lock,
+) string {
+	if version := PinnedVersion(tool, _config); version != "" {
+		return version
+	}
+
+	if lockedTool, ok := lock.Tools[tool.Name()]; ok && lockedTool.Version != "" {
+		return lockedTool.Version
+	}
+
+	return tool.Version()
+}
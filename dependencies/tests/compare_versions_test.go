@@ -0,0 +1,18 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/dependencies"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("CompareVersions",
+	func(a, b string, expected int) {
+		Expect(dependencies.CompareVersions(a, b)).To(Equal(expected))
+	},
+	Entry("older minor", "1.9.2", "1.10.0", -1, Label("unit")),
+	Entry("newer patch", "1.5.6", "1.5.5", 1, Label("unit")),
+	Entry("same version", "1.5.5", "1.5.5", 0, Label("unit")),
+	Entry("prerelease before its release", "1.6.0-beta1", "1.6.0", -1, Label("unit")),
+	Entry("release after its prerelease", "1.6.0", "1.6.0-rc1", 1, Label("unit")),
+)
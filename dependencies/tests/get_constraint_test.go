@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/dependencies"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetConstraint", func() {
+	var (
+		lock *dependencies.Lock
+	)
+
+	BeforeEach(func() {
+		lock = &dependencies.Lock{
+			Tools: map[string]*dependencies.LockedTool{
+				iota.Terraform.Name(): {Version: "1.6.2"},
+			},
+		}
+	})
+
+	It("should require the pinned version", Label("unit"), func() {
+		_config := &config.Config{
+			Versions:    config.Versions{Packer: "1.10.0"},
+			Constraints: config.Constraints{Packer: "~> 1.9"},
+		}
+
+		constraint, err := dependencies.GetConstraint(iota.Packer, _config, lock)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(constraint).To(Equal("= 1.10.0"))
+	})
+
+	It("should return the constraint of the config", Label("unit"), func() {
+		_config := &config.Config{Constraints: config.Constraints{Terraform: "~> 1.5"}}
+
+		constraint, err := dependencies.GetConstraint(iota.Terraform, _config, lock)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(constraint).To(Equal("~> 1.5"))
+	})
+
+	It("should accept the same major version, at least as recent as the one kumo would download", Label("unit"), func() {
+		constraint, err := dependencies.GetConstraint(iota.Terraform, nil, lock)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(constraint).To(Equal(">= 1.6.2, < 2.0.0"))
+
+		constraint, err = dependencies.GetConstraint(iota.Packer, nil, lock)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(constraint).To(Equal(">= " + iota.Packer.Version() + ", < 2.0.0"))
+	})
+})
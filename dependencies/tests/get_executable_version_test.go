@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/dependencies"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetExecutableVersion", func() {
+	var (
+		pathToExecutable string
+	)
+
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("the fake executable is a shell script")
+		}
+
+		pathToExecutable = filepath.Join(GinkgoT().TempDir(), "packer")
+	})
+
+	It("should return the version reported by the executable", Label("unit"), func() {
+		script := "#!/bin/sh\necho 'Packer v1.9.2'\necho\necho 'Your version of Packer is out of date!'\n"
+		Expect(os.WriteFile(pathToExecutable, []byte(script), 0755)).To(Succeed())
+
+		version, err := dependencies.GetExecutableVersion(pathToExecutable)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal("1.9.2"))
+	})
+
+	It("should return an error when the output has no version", Label("unit"), func() {
+		Expect(os.WriteFile(pathToExecutable, []byte("#!/bin/sh\necho 'not packer'\n"), 0755)).To(Succeed())
+
+		_, err := dependencies.GetExecutableVersion(pathToExecutable)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error when the executable doesn't run", Label("unit"), func() {
+		_, err := dependencies.GetExecutableVersion(pathToExecutable)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,43 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/dependencies"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetInstalledVersions", func() {
+	var (
+		pathToDependencies string
+	)
+
+	BeforeEach(func() {
+		pathToDependencies = GinkgoT().TempDir()
+	})
+
+	It("should return no versions when the tool was never installed", Label("unit"), func() {
+		versions, err := dependencies.GetInstalledVersions(pathToDependencies, iota.Packer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(versions).To(BeEmpty())
+	})
+
+	It("should return the versions with an executable, sorted", Label("unit"), func() {
+		for _, version := range []string{"1.10.0", "1.9.2"} {
+			pathToExecutable := dependencies.PathToExecutable(pathToDependencies, iota.Packer, version)
+			Expect(os.MkdirAll(filepath.Dir(pathToExecutable), 0755)).To(Succeed())
+			Expect(os.WriteFile(pathToExecutable, []byte{}, 0755)).To(Succeed())
+		}
+
+		// An interrupted install and a zip being downloaded
+		Expect(os.MkdirAll(filepath.Join(pathToDependencies, iota.Packer.Name(), "1.8.0"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(pathToDependencies, iota.Packer.Name(), "packer_1.11.0.zip"), []byte{}, 0644)).To(Succeed())
+
+		versions, err := dependencies.GetInstalledVersions(pathToDependencies, iota.Packer)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(versions).To(Equal([]string{"1.9.2", "1.10.0"}))
+	})
+})
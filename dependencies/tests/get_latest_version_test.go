@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/ed3899/kumo/dependencies"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetLatestVersion", func() {
+	newCheckpointServer := func(status int, body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			w.Write([]byte(body))
+		}))
+	}
+
+	It("should return the current version of the checkpoint API", Label("unit"), func() {
+		server := newCheckpointServer(http.StatusOK, `{"product":"packer","current_version":"1.10.0"}`)
+		defer server.Close()
+
+		version, err := dependencies.GetLatestVersion(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).To(Equal("1.10.0"))
+	})
+
+	It("should return an error when the checkpoint API fails", Label("unit"), func() {
+		server := newCheckpointServer(http.StatusNotFound, "")
+		defer server.Close()
+
+		_, err := dependencies.GetLatestVersion(server.URL)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error when the checkpoint API returns no version", Label("unit"), func() {
+		server := newCheckpointServer(http.StatusOK, `{}`)
+		defer server.Close()
+
+		_, err := dependencies.GetLatestVersion(server.URL)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,70 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/dependencies"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Lock", func() {
+	var (
+		pathToLock string
+	)
+
+	BeforeEach(func() {
+		pathToLock = filepath.Join(GinkgoT().TempDir(), iota.Dependencies.Name(), constants.DEPENDENCIES_LOCK)
+	})
+
+	Context("ReadLock", func() {
+		It("should return an empty lock when nothing was installed", Label("unit"), func() {
+			lock, err := dependencies.ReadLock(pathToLock)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lock.Tools).To(BeEmpty())
+		})
+
+		It("should return an error for an invalid lock", Label("unit"), func() {
+			Expect(os.MkdirAll(filepath.Dir(pathToLock), 0755)).To(Succeed())
+			Expect(os.WriteFile(pathToLock, []byte("{"), 0644)).To(Succeed())
+
+			_, err := dependencies.ReadLock(pathToLock)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Write", func() {
+		It("should read back the recorded versions", Label("unit"), func() {
+			lock, err := dependencies.ReadLock(pathToLock)
+			Expect(err).NotTo(HaveOccurred())
+
+			lock.Record(iota.Packer, "1.9.2", "abc")
+			lock.Record(iota.Packer, "1.10.0", "def")
+			lock.Record(iota.Packer, "1.9.2", "")
+			Expect(lock.Write(pathToLock)).To(Succeed())
+
+			lock, err = dependencies.ReadLock(pathToLock)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(lock.Tools).To(HaveKey(iota.Packer.Name()))
+			Expect(lock.Tools[iota.Packer.Name()].Version).To(Equal("1.9.2"))
+			Expect(lock.Tools[iota.Packer.Name()].Sha256).To(Equal(map[string]string{"1.9.2": "abc", "1.10.0": "def"}))
+
+			Expect(filepath.Join(filepath.Dir(pathToLock), constants.DEPENDENCIES_LOCK+".new")).NotTo(BeAnExistingFile())
+		})
+
+		It("should forget the pruned versions", Label("unit"), func() {
+			lock, err := dependencies.ReadLock(pathToLock)
+			Expect(err).NotTo(HaveOccurred())
+
+			lock.Record(iota.Terraform, "1.5.5", "abc")
+			lock.Record(iota.Terraform, "1.6.2", "def")
+			lock.Forget(iota.Terraform, "1.5.5")
+			lock.Forget(iota.Packer, "1.9.2")
+
+			Expect(lock.Tools[iota.Terraform.Name()].Sha256).To(Equal(map[string]string{"1.6.2": "def"}))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/dependencies"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResolveExecutable", func() {
+	var (
+		pathToDependencies string
+		pathDir            string
+		lock               *dependencies.Lock
+	)
+
+	// Writes a fake terraform reporting the version, see GetExecutableVersion
+	writeTerraform := func(dir, version string) string {
+		pathToExecutable := filepath.Join(dir, iota.Terraform.Name())
+		script := fmt.Sprintf("#!/bin/sh\necho 'Terraform v%s'\necho 'on linux_amd64'\n", version)
+		Expect(os.WriteFile(pathToExecutable, []byte(script), 0755)).To(Succeed())
+
+		return pathToExecutable
+	}
+
+	BeforeEach(func() {
+		if runtime.GOOS == "windows" {
+			Skip("the fake executables are shell scripts")
+		}
+
+		pathToDependencies = GinkgoT().TempDir()
+		pathDir = GinkgoT().TempDir()
+		lock = &dependencies.Lock{Tools: map[string]*dependencies.LockedTool{}}
+
+		GinkgoT().Setenv("PATH", pathDir)
+	})
+
+	It("should run the executable set in the config", Label("unit"), func() {
+		writeTerraform(pathDir, "1.5.7")
+		pathToConfigured := writeTerraform(GinkgoT().TempDir(), "1.4.6")
+
+		_config := &config.Config{Executables: config.Executables{Terraform: pathToConfigured}}
+
+		resolution, err := dependencies.ResolveExecutable(iota.Terraform, _config, lock, pathToDependencies)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolution.Source).To(Equal(dependencies.SourceConfig))
+		Expect(resolution.Path).To(Equal(pathToConfigured))
+		Expect(resolution.Version).To(Equal("1.4.6"))
+	})
+
+	It("should return an error when the executable set in the config doesn't run", Label("unit"), func() {
+		_config := &config.Config{Executables: config.Executables{Terraform: filepath.Join(pathDir, "missing")}}
+
+		_, err := dependencies.ResolveExecutable(iota.Terraform, _config, lock, pathToDependencies)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should run the executable on PATH satisfying the constraint", Label("unit"), func() {
+		pathOnPath := writeTerraform(pathDir, "1.5.7")
+
+		resolution, err := dependencies.ResolveExecutable(iota.Terraform, nil, lock, pathToDependencies)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolution.Source).To(Equal(dependencies.SourcePath))
+		Expect(resolution.Path).To(Equal(pathOnPath))
+		Expect(resolution.Version).To(Equal("1.5.7"))
+		Expect(resolution.Reason).To(ContainSubstring("1.5.7 satisfies"))
+	})
+
+	It("should download the version when the one on PATH doesn't satisfy the constraint", Label("unit"), func() {
+		writeTerraform(pathDir, "1.5.7")
+
+		_config := &config.Config{Versions: config.Versions{Terraform: "1.6.2"}}
+
+		resolution, err := dependencies.ResolveExecutable(iota.Terraform, _config, lock, pathToDependencies)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolution.Source).To(Equal(dependencies.SourceDependencies))
+		Expect(resolution.Path).To(Equal(dependencies.PathToExecutable(pathToDependencies, iota.Terraform, "1.6.2")))
+		Expect(resolution.Version).To(Equal("1.6.2"))
+		Expect(resolution.Reason).To(ContainSubstring("doesn't satisfy = 1.6.2"))
+	})
+
+	It("should download the version when the one on PATH isn't terraform", Label("unit"), func() {
+		Expect(os.WriteFile(filepath.Join(pathDir, iota.Terraform.Name()), []byte("#!/bin/sh\necho 'usage: terraform'\n"), 0755)).To(Succeed())
+
+		resolution, err := dependencies.ResolveExecutable(iota.Terraform, nil, lock, pathToDependencies)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolution.Source).To(Equal(dependencies.SourceDependencies))
+		Expect(resolution.Reason).To(ContainSubstring("doesn't report a terraform version"))
+	})
+
+	It("should download the version when there is none on PATH", Label("unit"), func() {
+		resolution, err := dependencies.ResolveExecutable(iota.Terraform, nil, lock, pathToDependencies)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resolution.Source).To(Equal(dependencies.SourceDependencies))
+		Expect(resolution.Version).To(Equal(iota.Terraform.Version()))
+		Expect(resolution.Reason).To(ContainSubstring("no terraform on PATH"))
+	})
+})
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/dependencies"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResolveVersion", func() {
+	var (
+		lock *dependencies.Lock
+	)
+
+	BeforeEach(func() {
+		lock = &dependencies.Lock{
+			Tools: map[string]*dependencies.LockedTool{
+				iota.Packer.Name(): {Version: "1.10.0"},
+			},
+		}
+	})
+
+	It("should prefer the version pinned in the config", Label("unit"), func() {
+		_config := &config.Config{Versions: config.Versions{Packer: "1.9.4"}}
+
+		Expect(dependencies.ResolveVersion(iota.Packer, _config, lock)).To(Equal("1.9.4"))
+	})
+
+	It("should fall back to the version of the lock", Label("unit"), func() {
+		Expect(dependencies.ResolveVersion(iota.Packer, &config.Config{}, lock)).To(Equal("1.10.0"))
+		Expect(dependencies.ResolveVersion(iota.Packer, nil, lock)).To(Equal("1.10.0"))
+	})
+
+	It("should fall back to the version kumo ships with", Label("unit"), func() {
+		Expect(dependencies.ResolveVersion(iota.Terraform, nil, lock)).To(Equal(iota.Terraform.Version()))
+	})
+})
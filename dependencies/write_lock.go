@@ -0,0 +1,50 @@
+package dependencies
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/samber/oops"
+)
+
+// Writes the lock to the given path. It's written next to it first, so an interrupted install can't leave half a
+// lock behind.
+func (l *Lock) Write(
+	pathToLock string,
+) error {
+	oopsBuilder := oops.
+		Code("Write").
+		In("dependencies").
+		Tags("Lock").
+		With("pathToLock", pathToLock)
+
+	content, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to marshal the lock")
+	}
+
+	err = os.MkdirAll(filepath.Dir(pathToLock), 0755)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to create %s", filepath.Dir(pathToLock))
+	}
+
+	pathToNewLock := fmt.Sprintf("%s.new", pathToLock)
+
+	err = os.WriteFile(pathToNewLock, append(content, '\n'), 0644)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to write %s", pathToNewLock)
+	}
+
+	err = os.Rename(pathToNewLock, pathToLock)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to replace %s", pathToLock)
+	}
+
+	return nil
+}
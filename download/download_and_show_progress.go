@@ -1,13 +1,18 @@
 package download
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+
 	"github.com/ed3899/kumo/utils/url"
 	"github.com/samber/oops"
 	"github.com/vbauerster/mpb/v8"
 	"github.com/vbauerster/mpb/v8/decor"
 )
 
-// Downloads the associated file to the URL and shows progress.
+// Downloads the associated file to the URL and shows progress. The zip is hashed while downloading, and deleted
+// when its checksum isn't the expected one. A zip left by an interrupted download is resumed.
 func (d *Download) DownloadAndShowProgress() error {
 	oopsBuilder := oops.
 		Code("DownloadAndShowProgress").
@@ -38,7 +43,9 @@ func (d *Download) DownloadAndShowProgress() error {
 			),
 		)
 
-		err := url.Download(d.Url, d.Path.Zip, downloadedBytesChan)
+		checksum := sha256.New()
+
+		err := url.Download(d.Url, d.Path.Zip, checksum, downloadedBytesChan, url.DefaultRetryPolicy())
 		if err != nil {
 			err = oopsBuilder.
 				With("path", d.Path).
@@ -48,6 +55,22 @@ func (d *Download) DownloadAndShowProgress() error {
 			return
 		}
 
+		actualSha256 := hex.EncodeToString(checksum.Sum(nil))
+		if actualSha256 != d.Sha256 {
+			err = oopsBuilder.
+				With("expectedSha256", d.Sha256).
+				With("actualSha256", actualSha256).
+				Errorf("the checksum of %v doesn't match the one of the release, it was deleted", d.Url)
+
+			if removeErr := os.Remove(d.Path.Zip); removeErr != nil {
+				err = oopsBuilder.
+					Wrapf(removeErr, "failed to remove %v after a checksum mismatch: %v", d.Path.Zip, err)
+			}
+
+			errChan <- err
+			return
+		}
+
 		doneChan <- true
 	}()
 
@@ -55,7 +78,10 @@ OuterLoop:
 	for {
 		select {
 		case downloadedBytes := <-downloadedBytesChan:
-			if downloadedBytes > 0 {
+			// Negative when the download starts over, i.e the mirror can't resume the zip left by a previous run
+			if downloadedBytes < 0 {
+				d.Bar.Downloading.SetCurrent(d.Bar.Downloading.Current() + int64(downloadedBytes))
+			} else {
 				d.Bar.Downloading.IncrBy(downloadedBytes)
 			}
 
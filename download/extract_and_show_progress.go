@@ -3,6 +3,7 @@ package download
 import (
 	"path/filepath"
 
+	"github.com/ed3899/kumo/utils/file"
 	"github.com/ed3899/kumo/utils/zip"
 	"github.com/samber/oops"
 	"github.com/vbauerster/mpb/v8"
@@ -59,6 +60,16 @@ func (d *Download) ExtractAndShowProgress() error {
 			return
 		}
 
+		err = file.MakeExecutable(d.Path.Executable)
+		if err != nil {
+			err := oopsBuilder.
+				Wrapf(err, "failed to make executable: %v", d.Path.Executable)
+
+			errChan <- err
+
+			return
+		}
+
 		doneChan <- true
 	}(zipSize)
 
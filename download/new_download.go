@@ -2,45 +2,66 @@ package download
 
 import (
 	"fmt"
-	"os"
+	"path"
 	"path/filepath"
 	"runtime"
 	"sync"
 
 	"github.com/ed3899/kumo/common/iota"
-	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/dependencies"
+	"github.com/ed3899/kumo/utils/release"
 	"github.com/ed3899/kumo/utils/url"
 	"github.com/samber/oops"
 	"github.com/vbauerster/mpb/v8"
 )
 
-// Returns a Download instance. The Download instance is used to download and extract the associated file.
+// Returns a Download instance. The Download instance is used to download and extract a version of the tool under
+// dependencies/<tool>/<version>, next to the other installed versions. The release is read from
+// https://releases.hashicorp.com or the mirror replacing it.
+// The checksum of the zip is read from the SHA256SUMS of the release, once its signature is verified against the
+// HashiCorp public key embedded in kumo.
 func NewDownload(
-	_manager *manager.Manager,
+	tool iota.Tool,
+	version,
+	releasesUrl string,
 ) (*Download, error) {
 	oopsBuilder := oops.
 		Code("NewDownload").
 		In("download").
 		Tags("Download").
-		With("manager", _manager)
+		With("tool", tool.Name()).
+		With("version", version).
+		With("releasesUrl", releasesUrl)
 
-	currentExecutablePath, err := os.Executable()
+	pathToDependencies, err := dependencies.GetPathToDependencies()
 	if err != nil {
 		err := oopsBuilder.
-			Wrapf(err, "failed to get current executable path")
+			Wrapf(err, "failed to get the path to the dependencies")
 
 		return nil, err
 	}
 
-	currentExecutableDir := filepath.Dir(currentExecutablePath)
-
 	hashicorpUrl := url.BuildHashicorpUrl(
-		_manager.Tool.Name(),
-		_manager.Tool.Version(),
+		releasesUrl,
+		tool.Name(),
+		version,
 		runtime.GOOS,
 		runtime.GOARCH,
 	)
 
+	sha256, err := release.GetSha256(
+		url.BuildHashicorpSha256SumsUrl(releasesUrl, tool.Name(), version),
+		url.BuildHashicorpSignatureUrl(releasesUrl, tool.Name(), version),
+		path.Base(hashicorpUrl),
+		release.HashicorpPublicKey,
+	)
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "failed to get the checksum of the release")
+
+		return nil, err
+	}
+
 	contentLength, err := url.GetContentLength(hashicorpUrl)
 	if err != nil {
 		err := oopsBuilder.
@@ -52,21 +73,17 @@ func NewDownload(
 	progress := mpb.New(mpb.WithWaitGroup(&sync.WaitGroup{}), mpb.WithAutoRefresh(), mpb.WithWidth(64))
 
 	return &Download{
-		Name: _manager.Tool.Name(),
+		Name: tool.Name(),
 		Path: &Path{
 			Zip: filepath.Join(
-				currentExecutableDir,
-				iota.Dependencies.Name(),
-				fmt.Sprintf("%s.zip", _manager.Tool.Name()),
-			),
-			Executable: filepath.Join(
-				currentExecutableDir,
-				iota.Dependencies.Name(),
-				_manager.Tool.Name(),
-				fmt.Sprintf("%s.exe", _manager.Tool.Name()),
+				pathToDependencies,
+				tool.Name(),
+				fmt.Sprintf("%s_%s.zip", tool.Name(), version),
 			),
+			Executable: dependencies.PathToExecutable(pathToDependencies, tool, version),
 		},
 		Url:           hashicorpUrl,
+		Sha256:        sha256,
 		ContentLength: contentLength,
 		Bar:           &Bar{},
 		Progress:      progress,
@@ -75,6 +92,7 @@ func NewDownload(
 
 type Download struct {
 	Name, Url     string
+	Sha256        string
 	ContentLength int64
 	Path          *Path
 	Progress      *mpb.Progress
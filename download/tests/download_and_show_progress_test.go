@@ -1,11 +1,21 @@
 package tests
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path"
 	"path/filepath"
+	"runtime"
 	"sync"
+	"time"
 
 	"github.com/ed3899/kumo/download"
+	"github.com/ed3899/kumo/utils/release"
+	"github.com/ed3899/kumo/utils/url"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/vbauerster/mpb/v8"
@@ -15,20 +25,27 @@ var _ = Describe("DownloadAndShowProgress", func() {
 	Context("with a valid url", func() {
 		var (
 			mockDownload *download.Download
-
-			cwd string
-			err error
+			server       *httptest.Server
 		)
 
 		BeforeEach(func() {
-			cwd, err = os.Getwd()
+			var armoredPublicKey string
+			server, armoredPublicKey = newReleaseServer("packer", "1.9.4", []byte("packer"))
+
+			checksum, err := release.GetSha256(
+				url.BuildHashicorpSha256SumsUrl(server.URL, "packer", "1.9.4"),
+				url.BuildHashicorpSignatureUrl(server.URL, "packer", "1.9.4"),
+				path.Base(url.BuildHashicorpUrl(server.URL, "packer", "1.9.4", runtime.GOOS, runtime.GOARCH)),
+				armoredPublicKey,
+			)
 			Expect(err).To(BeNil())
 
 			// The bar won't display any progress because content length was not added. We only care about the download
 			mockDownload = &download.Download{
-				Url: "https://releases.hashicorp.com/packer/1.9.4/packer_1.9.4_darwin_amd64.zip",
+				Url:    url.BuildHashicorpUrl(server.URL, "packer", "1.9.4", runtime.GOOS, runtime.GOARCH),
+				Sha256: checksum,
 				Path: &download.Path{
-					Zip: filepath.Join(cwd, "packer.zip"),
+					Zip: filepath.Join(GinkgoT().TempDir(), "packer.zip"),
 				},
 				Progress: mpb.New(mpb.WithWaitGroup(&sync.WaitGroup{}), mpb.WithAutoRefresh(), mpb.WithWidth(64)),
 				Bar:      &download.Bar{},
@@ -36,11 +53,12 @@ var _ = Describe("DownloadAndShowProgress", func() {
 		})
 
 		AfterEach(func() {
-			Expect(os.Remove(mockDownload.Path.Zip)).To(Succeed())
+			server.Close()
 		})
 
-		It("should download and show progress", Label("integration"), func() {
+		It("should download and show progress", Label("unit"), func() {
 			Expect(mockDownload.DownloadAndShowProgress()).To(Succeed())
+			Expect(mockDownload.Path.Zip).To(BeAnExistingFile())
 		})
 	})
 
@@ -75,4 +93,64 @@ var _ = Describe("DownloadAndShowProgress", func() {
 			Expect(mockDownload.DownloadAndShowProgress()).ToNot(Succeed())
 		})
 	})
+
+	Context("with a fake release", func() {
+		var (
+			mockDownload *download.Download
+			server       *httptest.Server
+
+			zipContent = []byte("packer")
+		)
+
+		BeforeEach(func() {
+			// Serves Range requests, like releases.hashicorp.com
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.ServeContent(w, r, "packer_1.9.4_linux_amd64.zip", time.Time{}, bytes.NewReader(zipContent))
+			}))
+
+			mockDownload = &download.Download{
+				Url: server.URL + "/packer_1.9.4_linux_amd64.zip",
+				Path: &download.Path{
+					Zip: filepath.Join(GinkgoT().TempDir(), "packer.zip"),
+				},
+				Progress: mpb.New(mpb.WithWaitGroup(&sync.WaitGroup{}), mpb.WithAutoRefresh(), mpb.WithWidth(64)),
+				Bar:      &download.Bar{},
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should keep a zip matching the checksum", Label("unit"), func() {
+			sum := sha256.Sum256(zipContent)
+			mockDownload.Sha256 = hex.EncodeToString(sum[:])
+
+			Expect(mockDownload.DownloadAndShowProgress()).To(Succeed())
+			Expect(mockDownload.Path.Zip).To(BeAnExistingFile())
+		})
+
+		It("should resume a zip left by an interrupted download", Label("unit"), func() {
+			sum := sha256.Sum256(zipContent)
+			mockDownload.Sha256 = hex.EncodeToString(sum[:])
+
+			Expect(os.WriteFile(mockDownload.Path.Zip, zipContent[:3], 0644)).To(Succeed())
+
+			Expect(mockDownload.DownloadAndShowProgress()).To(Succeed())
+
+			content, err := os.ReadFile(mockDownload.Path.Zip)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(content).To(Equal(zipContent))
+		})
+
+		It("should delete a zip not matching the checksum and abort", Label("unit"), func() {
+			sum := sha256.Sum256([]byte("another packer"))
+			mockDownload.Sha256 = hex.EncodeToString(sum[:])
+
+			err := mockDownload.DownloadAndShowProgress()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("checksum"))
+			Expect(mockDownload.Path.Zip).ToNot(BeAnExistingFile())
+		})
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sync"
 
 	"github.com/ed3899/kumo/download"
@@ -70,6 +71,13 @@ var _ = Describe("ExtractAndShowProgress", func() {
 		It("should successfully extract and show progress", func() {
 			err := _download.ExtractAndShowProgress()
 			Expect(err).ToNot(HaveOccurred())
+
+			// The entry has no unix permissions, like the zips built on windows.
+			if runtime.GOOS != "windows" {
+				info, err := os.Stat(exePath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Mode().Perm() & 0111).ToNot(BeZero())
+			}
 		})
 	})
 
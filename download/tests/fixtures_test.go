@@ -0,0 +1,27 @@
+package tests
+
+import (
+	"net/http/httptest"
+	"path"
+	"runtime"
+
+	"github.com/ed3899/kumo/utils/test_helpers"
+	"github.com/ed3899/kumo/utils/url"
+)
+
+// Serves a release of the tool for this host signed by a new key, laid out like releases.hashicorp.com. Returns
+// the server and the armored public key of the signing key.
+func newReleaseServer(tool, version string, zipContent []byte) (*httptest.Server, string) {
+	key, armoredPublicKey := test_helpers.NewSigningKey()
+
+	zipPath := url.BuildHashicorpUrl("", tool, version, runtime.GOOS, runtime.GOARCH)
+	sha256Sums := test_helpers.NewSha256Sums(path.Base(zipPath), zipContent)
+
+	server := test_helpers.NewFileServer(map[string][]byte{
+		zipPath: zipContent,
+		url.BuildHashicorpSha256SumsUrl("", tool, version): sha256Sums,
+		url.BuildHashicorpSignatureUrl("", tool, version):  test_helpers.Sign(key, sha256Sums),
+	})
+
+	return server, armoredPublicKey
+}
@@ -4,18 +4,19 @@ import (
 	"fmt"
 	"net/url"
 	"path/filepath"
+	"runtime"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/ed3899/kumo/download"
 	"github.com/ed3899/kumo/common/iota"
-	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/utils/host"
+	"github.com/ed3899/kumo/utils/release"
 )
 
 var _ = Describe("NewDownload", func() {
 	var (
-		mockManager      *manager.Manager
 		zipPathSubstring string
 		exePathSubstring string
 		urlSubstring     string
@@ -23,19 +24,17 @@ var _ = Describe("NewDownload", func() {
 	)
 
 	BeforeEach(func() {
-		mockManager = &manager.Manager{
-			Tool: iota.Packer,
-		}
-
 		zipPathSubstring = filepath.Join(
 			iota.Dependencies.Name(),
-			fmt.Sprintf("%s.zip", iota.Packer.Name()),
+			iota.Packer.Name(),
+			fmt.Sprintf("%s_%s.zip", iota.Packer.Name(), iota.Packer.Version()),
 		)
 
 		exePathSubstring = filepath.Join(
 			iota.Dependencies.Name(),
 			iota.Packer.Name(),
-			fmt.Sprintf("%s.exe", iota.Packer.Name()),
+			iota.Packer.Version(),
+			host.ExecutableName(iota.Packer.Name(), runtime.GOOS),
 		)
 
 		urlSubstring, err = url.JoinPath(
@@ -45,10 +44,17 @@ var _ = Describe("NewDownload", func() {
 		Expect(err).To(BeNil())
 	})
 
-	It("should create a new download struct", Label("integration"), func() {
-		_download, err := download.NewDownload(mockManager)
+	It("should create a new download struct", Label("unit"), func() {
+		server, armoredPublicKey := newReleaseServer(iota.Packer.Name(), iota.Packer.Version(), []byte("packer"))
+		defer server.Close()
+
+		hashicorpPublicKey := release.HashicorpPublicKey
+		release.HashicorpPublicKey = armoredPublicKey
+		defer func() { release.HashicorpPublicKey = hashicorpPublicKey }()
+
+		_download, err := download.NewDownload(iota.Packer, iota.Packer.Version(), server.URL)
 		Expect(err).To(BeNil())
-		Expect(_download.Name).To(Equal(mockManager.Tool.Name()))
+		Expect(_download.Name).To(Equal(iota.Packer.Name()))
 		Expect(_download.Path.Zip).To(ContainSubstring(zipPathSubstring))
 		Expect(_download.Path.Executable).To(ContainSubstring(exePathSubstring))
 		Expect(_download.Url).To(ContainSubstring(urlSubstring))
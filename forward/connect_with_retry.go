@@ -0,0 +1,38 @@
+package forward
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Returns a connected client, retrying with exponential backoff. Returns nil once the context is done.
+func (f *Forwarder) connectWithRetry(
+	ctx context.Context,
+) Client {
+	retryInterval := f.MinRetryInterval
+
+	for {
+		client, err := f.Connect()
+		if err == nil {
+			return client
+		}
+
+		f.Logger.Warn("Failed to connect to the instance",
+			zap.Duration("retryIn", retryInterval),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(retryInterval):
+		}
+
+		retryInterval *= 2
+		if retryInterval > f.MaxRetryInterval {
+			retryInterval = f.MaxRetryInterval
+		}
+	}
+}
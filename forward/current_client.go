@@ -0,0 +1,16 @@
+package forward
+
+// Returns the connected client, nil while reconnecting.
+func (f *Forwarder) currentClient() Client {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.client
+}
+
+func (f *Forwarder) setClient(client Client) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.client = client
+}
@@ -0,0 +1,28 @@
+package forward
+
+import (
+	"time"
+)
+
+// Sends keepalive requests until the connection drops. Half-open connections, i.e after a network change,
+// are closed so Wait returns and Run reconnects.
+func (f *Forwarder) keepAlive(
+	client Client,
+	dropped <-chan struct{},
+) {
+	ticker := time.NewTicker(f.KeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-dropped:
+			return
+		case <-ticker.C:
+			_, _, err := client.SendRequest("keepalive@openssh.com", true, nil)
+			if err != nil {
+				client.Close()
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package forward
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ed3899/kumo/utils/forward_spec"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultKeepAliveInterval = 15 * time.Second
+	defaultMinRetryInterval  = time.Second
+	defaultMaxRetryInterval  = 30 * time.Second
+	targetDialTimeout        = 10 * time.Second
+)
+
+// Returns a Forwarder instance. The Forwarder keeps the local and remote port forwards open over the
+// connection returned by connect, reconnecting whenever it drops.
+func NewForwarder(
+	connect func() (Client, error),
+	local,
+	remote []*forward_spec.ForwardSpec,
+	logger *zap.Logger,
+) *Forwarder {
+	return &Forwarder{
+		Connect:           connect,
+		Local:             local,
+		Remote:            remote,
+		Logger:            logger,
+		KeepAliveInterval: defaultKeepAliveInterval,
+		MinRetryInterval:  defaultMinRetryInterval,
+		MaxRetryInterval:  defaultMaxRetryInterval,
+	}
+}
+
+type Forwarder struct {
+	Connect           func() (Client, error)
+	Local             []*forward_spec.ForwardSpec
+	Remote            []*forward_spec.ForwardSpec
+	Logger            *zap.Logger
+	KeepAliveInterval time.Duration
+	MinRetryInterval  time.Duration
+	MaxRetryInterval  time.Duration
+
+	mutex  sync.RWMutex
+	client Client
+}
+
+// The subset of *ssh.Client used to forward ports.
+type Client interface {
+	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
+	SendRequest(name string, wantReply bool, payload []byte) (bool, []byte, error)
+	Wait() error
+	Close() error
+}
@@ -0,0 +1,36 @@
+package forward
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// Copies data both ways until either side is done, then closes both.
+func pipe(
+	a,
+	b net.Conn,
+) {
+	var once sync.Once
+	closeBoth := func() {
+		a.Close()
+		b.Close()
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		io.Copy(a, b)
+		once.Do(closeBoth)
+	}()
+
+	go func() {
+		defer wg.Done()
+		io.Copy(b, a)
+		once.Do(closeBoth)
+	}()
+
+	wg.Wait()
+}
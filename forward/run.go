@@ -0,0 +1,90 @@
+package forward
+
+import (
+	"context"
+	"net"
+
+	"github.com/samber/oops"
+	"go.uber.org/zap"
+)
+
+// Opens the forwards and keeps them open until the context is done. Local listeners are opened once and
+// fail fast if a port is taken. Remote listeners are opened again after every reconnect.
+func (f *Forwarder) Run(
+	ctx context.Context,
+) error {
+	oopsBuilder := oops.
+		Code("Run").
+		In("forward").
+		Tags("Forwarder")
+
+	localListeners := []net.Listener{}
+	defer func() {
+		for _, listener := range localListeners {
+			listener.Close()
+		}
+	}()
+
+	for _, spec := range f.Local {
+		listener, err := net.Listen("tcp", spec.ListenAddress())
+		if err != nil {
+			return oopsBuilder.
+				Wrapf(err, "failed to listen on %s", spec.ListenAddress())
+		}
+		localListeners = append(localListeners, listener)
+
+		f.Logger.Info("Forwarding local port",
+			zap.String("listen", spec.ListenAddress()),
+			zap.String("target", spec.TargetAddress()),
+		)
+
+		go f.serveLocal(listener, spec)
+	}
+
+	for {
+		client := f.connectWithRetry(ctx)
+		if client == nil {
+			return nil
+		}
+		f.setClient(client)
+
+		for _, spec := range f.Remote {
+			listener, err := client.Listen("tcp", spec.ListenAddress())
+			if err != nil {
+				f.Logger.Error("Failed to listen on the instance",
+					zap.String("listen", spec.ListenAddress()),
+					zap.Error(err),
+				)
+
+				continue
+			}
+
+			f.Logger.Info("Forwarding remote port",
+				zap.String("listen", spec.ListenAddress()),
+				zap.String("target", spec.TargetAddress()),
+			)
+
+			go f.serveRemote(listener, spec)
+		}
+
+		dropped := make(chan struct{})
+		go func() {
+			client.Wait()
+			close(dropped)
+		}()
+		go f.keepAlive(client, dropped)
+
+		select {
+		case <-ctx.Done():
+			f.setClient(nil)
+			client.Close()
+
+			return nil
+		case <-dropped:
+			f.setClient(nil)
+			client.Close()
+
+			f.Logger.Warn("Connection to the instance lost, reconnecting")
+		}
+	}
+}
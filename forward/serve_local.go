@@ -0,0 +1,44 @@
+package forward
+
+import (
+	"net"
+
+	"github.com/ed3899/kumo/utils/forward_spec"
+	"go.uber.org/zap"
+)
+
+// Accepts local connections and forwards them through the current client until the listener is closed.
+func (f *Forwarder) serveLocal(
+	listener net.Listener,
+	spec *forward_spec.ForwardSpec,
+) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			client := f.currentClient()
+			if client == nil {
+				f.Logger.Warn("Not connected to the instance, dropping connection", zap.String("listen", spec.ListenAddress()))
+				conn.Close()
+
+				return
+			}
+
+			target, err := client.Dial("tcp", spec.TargetAddress())
+			if err != nil {
+				f.Logger.Warn("Failed to reach the target from the instance",
+					zap.String("target", spec.TargetAddress()),
+					zap.Error(err),
+				)
+				conn.Close()
+
+				return
+			}
+
+			pipe(conn, target)
+		}()
+	}
+}
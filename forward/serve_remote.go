@@ -0,0 +1,37 @@
+package forward
+
+import (
+	"net"
+
+	"github.com/ed3899/kumo/utils/forward_spec"
+	"go.uber.org/zap"
+)
+
+// Accepts connections on the instance and forwards them to the local target until the listener is closed,
+// which happens when the connection drops.
+func (f *Forwarder) serveRemote(
+	listener net.Listener,
+	spec *forward_spec.ForwardSpec,
+) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			target, err := net.DialTimeout("tcp", spec.TargetAddress(), targetDialTimeout)
+			if err != nil {
+				f.Logger.Warn("Failed to reach the local target",
+					zap.String("target", spec.TargetAddress()),
+					zap.Error(err),
+				)
+				conn.Close()
+
+				return
+			}
+
+			pipe(conn, target)
+		}()
+	}
+}
@@ -0,0 +1,126 @@
+package tests
+
+import (
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Stands in for an ssh client, dialing and listening on the local machine. Closing it closes its listeners,
+// same as when an ssh connection drops.
+type fakeClient struct {
+	mutex     sync.Mutex
+	listeners []net.Listener
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		closed: make(chan struct{}),
+	}
+}
+
+func (c *fakeClient) Dial(network, address string) (net.Conn, error) {
+	return net.Dial(network, address)
+}
+
+func (c *fakeClient) Listen(network, address string) (net.Listener, error) {
+	listener, err := net.Listen(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.listeners = append(c.listeners, listener)
+
+	return listener, nil
+}
+
+func (c *fakeClient) SendRequest(name string, wantReply bool, payload []byte) (bool, []byte, error) {
+	select {
+	case <-c.closed:
+		return false, nil, errors.New("closed")
+	default:
+		return true, nil, nil
+	}
+}
+
+func (c *fakeClient) Wait() error {
+	<-c.closed
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	c.closeOnce.Do(func() {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		for _, listener := range c.listeners {
+			listener.Close()
+		}
+		close(c.closed)
+	})
+
+	return nil
+}
+
+// Returns a port nothing listens on.
+func freePort() int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	defer listener.Close()
+
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// Starts a server echoing back whatever it receives and returns its port.
+func startEchoServer() int {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(listener.Close)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	return listener.Addr().(*net.TCPAddr).Port
+}
+
+// Returns what the server at the port echoes back, or an error if it can't be reached.
+func roundTrip(port int) (string, error) {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(port)), time.Second)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	conn.SetDeadline(time.Now().Add(time.Second))
+
+	_, err = conn.Write([]byte("ping"))
+	if err != nil {
+		return "", err
+	}
+
+	reply := make([]byte, 4)
+	_, err = io.ReadFull(conn, reply)
+
+	return string(reply), err
+}
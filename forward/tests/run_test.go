@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ed3899/kumo/forward"
+	"github.com/ed3899/kumo/utils/forward_spec"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Run", Label("unit"), func() {
+	var (
+		echoPort   int
+		localPort  int
+		remotePort int
+		clients    []*fakeClient
+		failures   int
+		mutex      sync.Mutex
+		forwarder  *forward.Forwarder
+	)
+
+	spec := func(port, hostPort int) *forward_spec.ForwardSpec {
+		_spec, err := forward_spec.ParseForwardSpec("127.0.0.1:" + strconv.Itoa(port) + ":127.0.0.1:" + strconv.Itoa(hostPort))
+		Expect(err).ToNot(HaveOccurred())
+
+		return _spec
+	}
+
+	connections := func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		return len(clients)
+	}
+
+	run := func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+
+		go func() {
+			done <- forwarder.Run(ctx)
+		}()
+
+		DeferCleanup(func() {
+			cancel()
+			Eventually(done).Should(Receive(BeNil()))
+		})
+	}
+
+	BeforeEach(func() {
+		echoPort = startEchoServer()
+		localPort = freePort()
+		remotePort = freePort()
+		clients = nil
+		failures = 0
+
+		forwarder = forward.NewForwarder(
+			func() (forward.Client, error) {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if failures > 0 {
+					failures--
+					return nil, errors.New("connection refused")
+				}
+
+				client := newFakeClient()
+				clients = append(clients, client)
+
+				return client, nil
+			},
+			[]*forward_spec.ForwardSpec{spec(localPort, echoPort)},
+			[]*forward_spec.ForwardSpec{spec(remotePort, echoPort)},
+			zap.NewNop(),
+		)
+		forwarder.MinRetryInterval = 10 * time.Millisecond
+	})
+
+	It("should forward local and remote ports", func() {
+		run()
+
+		Eventually(func() (string, error) {
+			return roundTrip(localPort)
+		}).Should(Equal("ping"))
+		Eventually(func() (string, error) {
+			return roundTrip(remotePort)
+		}).Should(Equal("ping"))
+	})
+
+	It("should reconnect and reopen the remote forwards when the connection drops", func() {
+		run()
+		Eventually(connections).Should(Equal(1))
+		Eventually(func() (string, error) {
+			return roundTrip(remotePort)
+		}).Should(Equal("ping"))
+
+		mutex.Lock()
+		clients[0].Close()
+		mutex.Unlock()
+
+		Eventually(connections).Should(Equal(2))
+		Eventually(func() (string, error) {
+			return roundTrip(remotePort)
+		}).Should(Equal("ping"))
+		Eventually(func() (string, error) {
+			return roundTrip(localPort)
+		}).Should(Equal("ping"))
+	})
+
+	It("should retry until it connects", func() {
+		failures = 3
+
+		run()
+
+		Eventually(connections).Should(Equal(1))
+		Eventually(func() (string, error) {
+			return roundTrip(localPort)
+		}).Should(Equal("ping"))
+	})
+
+	It("should return an error if a local port is taken", func() {
+		listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(localPort)))
+		Expect(err).ToNot(HaveOccurred())
+		defer listener.Close()
+
+		Expect(forwarder.Run(context.Background())).ToNot(Succeed())
+	})
+})
@@ -5,6 +5,12 @@ import (
 	"runtime"
 
 	"github.com/ed3899/kumo/cmd"
+	// The clouds kumo supports, each provider registers itself when imported.
+	_ "github.com/ed3899/kumo/provider/aws"
+	_ "github.com/ed3899/kumo/provider/azure"
+	_ "github.com/ed3899/kumo/provider/gcp"
+	_ "github.com/ed3899/kumo/provider/hetzner"
+	_ "github.com/ed3899/kumo/provider/local"
 	"github.com/ed3899/kumo/utils/host"
 	"github.com/samber/oops"
 )
@@ -1,8 +0,0 @@
-package manager
-
-var (
-	awsCredentials = map[string]string{
-		"AWS_ACCESS_KEY_ID":     "AWS.AccessKeyId",
-		"AWS_SECRET_ACCESS_KEY": "AWS.SecretAccessKey",
-	}
-)
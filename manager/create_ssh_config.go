@@ -3,16 +3,17 @@ package manager
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/ed3899/kumo/common/constants"
-	"github.com/ed3899/kumo/utils/ip"
+	"github.com/ed3899/kumo/utils/terraform_state"
 	"github.com/samber/oops"
-	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
 
-// Generates a ssh config file at the current working directory
-func (m *Manager) CreateSshConfig() error {
+// Generates a ssh config file at the current working directory, connecting to the address in the terraform
+// outputs. The address may be an IPv6, ssh takes it unbracketed as HostName.
+func (m *Manager) CreateSshConfig(outputs *terraform_state.TerraformOutputs) error {
 	logger, _ := zap.NewProduction(
 		zap.AddCaller(),
 	)
@@ -23,9 +24,10 @@ func (m *Manager) CreateSshConfig() error {
 		Tags("Manager").
 		Code("GenerateSshConfig")
 
-	ip, err := ip.ReadIpFromFile(m.Path.Terraform.IpFile)
-	if err != nil {
-		return oopsBuilder.Wrapf(err, "failed to read ip from file")
+	address := outputs.Address()
+	if address == "" {
+		return oopsBuilder.
+			Errorf("the instance has no public address")
 	}
 
 	content := fmt.Sprintf(`Host %s
@@ -37,18 +39,19 @@ func (m *Manager) CreateSshConfig() error {
 	PasswordAuthentication %s
 	IdentitiesOnly %s
 	LogLevel %s`,
-		constants.HOST,
-		ip,
+		m.Config.ScopedName(constants.HOST),
+		address,
 		m.Path.Terraform.IdentityFile,
-		viper.GetString("AMI.User"),
-		constants.SSH_PORT,
+		m.Provider.SshUser(m.Config),
+		outputs.Port(),
 		"no",
 		"no",
 		"yes",
 		"error",
 	)
 
-	file, err := os.Create(m.Path.Terraform.SshConfig)
+	// ssh refuses a config writable by others, like a 0664 file created under the 002 umask of many Linux distros.
+	file, err := os.OpenFile(m.Path.Terraform.SshConfig, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
 	if err != nil {
 		err := oopsBuilder.
 			Wrapf(err, "Error occurred while creating file %s", m.Path.Terraform.SshConfig)
@@ -56,6 +59,14 @@ func (m *Manager) CreateSshConfig() error {
 	}
 	defer file.Close()
 
+	// The mode of OpenFile only applies to new files, the config may have been written by an older kumo.
+	err = file.Chmod(0600)
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "Error occurred while restricting the permissions of file %s", m.Path.Terraform.SshConfig)
+		return err
+	}
+
 	_, err = file.WriteString(content)
 	if err != nil {
 		err := oopsBuilder.
@@ -66,7 +77,7 @@ func (m *Manager) CreateSshConfig() error {
 	logger.Info("Successfully generated ssh config file",
 		zap.String("path", m.Path.Terraform.SshConfig),
 	)
-	logger.Info("Run `ssh -F ./kumossh kumo` to SSH into your instances")
+	logger.Info(fmt.Sprintf("Run `ssh -F ./%s %s` to SSH into your instances", filepath.Base(m.Path.Terraform.SshConfig), m.Config.ScopedName(constants.HOST)))
 
 	return nil
 }
@@ -2,6 +2,7 @@ package environment
 
 import (
 	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
 	"github.com/samber/oops"
 )
 
@@ -11,6 +12,7 @@ func NewEnvironment(
 	tool iota.Tool,
 	cloud iota.Cloud,
 	pathToPackerManifest string,
+	_config *config.Config,
 ) (*Environment[any], error) {
 	oopsBuilder := oops.
 		Code("NewEnvironment").
@@ -23,7 +25,7 @@ func NewEnvironment(
 
 	switch tool {
 	case iota.Packer:
-		packerEnvironment, err := NewPackerEnvironment(cloud)
+		packerEnvironment, err := NewPackerEnvironment(cloud, _config)
 		if err != nil {
 			return nil, oopsBuilder.
 				With("cloud", cloud).
@@ -36,7 +38,7 @@ func NewEnvironment(
 		}, nil
 
 	case iota.Terraform:
-		terraformEnvironment, err := NewTerraformEnvironment(pathToPackerManifest, cloud)
+		terraformEnvironment, err := NewTerraformEnvironment(pathToPackerManifest, cloud, _config)
 		if err != nil {
 			return nil, oopsBuilder.
 				With("cloud", cloud).
@@ -1,6 +1,6 @@
 package environment
 
-import "github.com/spf13/viper"
+import "github.com/ed3899/kumo/config"
 
 type PackerBaseRequired struct {
 	GIT_USERNAME string
@@ -18,15 +18,17 @@ type PackerBaseEnvironment struct {
 }
 
 // Returns a new PackerBaseEnvironment.
-func NewPackerBaseEnvironment() *PackerBaseEnvironment {
+func NewPackerBaseEnvironment(
+	_config *config.Config,
+) *PackerBaseEnvironment {
 	return &PackerBaseEnvironment{
 		Required: &PackerBaseRequired{
-			GIT_USERNAME: viper.GetString("Git.Username"),
-			GIT_EMAIL:    viper.GetString("Git.Email"),
-			ANSIBLE_TAGS: viper.GetStringSlice("AMI.Tools"),
+			GIT_USERNAME: _config.Git.Username,
+			GIT_EMAIL:    _config.Git.Email,
+			ANSIBLE_TAGS: _config.AMI.Tools,
 		},
 		Optional: &PackerBaseOptional{
-			GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC: viper.GetString("GitHub.PersonalAccessTokenClassic"),
+			GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC: _config.GitHub.PersonalAccessTokenClassic,
 		},
 	}
 }
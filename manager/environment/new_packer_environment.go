@@ -2,12 +2,15 @@ package environment
 
 import (
 	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/provider"
 	"github.com/samber/oops"
 )
 
-// Creates a new Packer environment for the given cloud.
+// Creates a new Packer environment for the given cloud, with the cloud data from its provider.
 func NewPackerEnvironment(
 	cloud iota.Cloud,
+	_config *config.Config,
 ) (*Environment[*PackerBaseEnvironment], error) {
 	oopsBuilder := oops.
 		Code("NewPackerEnvironment").
@@ -15,21 +18,16 @@ func NewPackerEnvironment(
 		In("environment").
 		With("cloud", cloud)
 
-	base := NewPackerBaseEnvironment()
+	base := NewPackerBaseEnvironment(_config)
 
-	switch cloud {
-	case iota.Aws:
-		aws := NewPackerAwsEnvironment()
-
-		return &Environment[*PackerBaseEnvironment]{
-			Cloud: aws,
-			Base:  base,
-		}, nil
-
-	default:
-		err := oopsBuilder.
-			Errorf("unknown cloud: %#v", cloud)
-
-		return nil, err
+	_provider, err := provider.Get(cloud)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the provider")
 	}
+
+	return &Environment[*PackerBaseEnvironment]{
+		Cloud: _provider.PackerEnvironment(_config),
+		Base:  base,
+	}, nil
 }
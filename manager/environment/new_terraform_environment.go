@@ -2,13 +2,16 @@ package environment
 
 import (
 	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/provider"
 	"github.com/samber/oops"
 )
 
-// Creates a new Terraform environment for the given cloud.
+// Creates a new Terraform environment for the given cloud, with the cloud data from its provider.
 func NewTerraformEnvironment(
 	pathToPackerManifest string,
 	cloud iota.Cloud,
+	_config *config.Config,
 ) (*Environment[*TerraformBaseEnvironment], error) {
 	oopsBuilder := oops.
 		Code("NewTerraformEnvironment").
@@ -19,21 +22,20 @@ func NewTerraformEnvironment(
 
 	base := NewTerraformBaseEnvironment()
 
-	switch cloud {
-	case iota.Aws:
-		aws, err := NewTerraformAwsEnvironment(pathToPackerManifest)
-		if err != nil {
-			return nil, oopsBuilder.
-				Wrapf(err, "failed to create aws environment")
-		}
-
-		return &Environment[*TerraformBaseEnvironment]{
-			Base:  base,
-			Cloud: aws,
-		}, nil
+	_provider, err := provider.Get(cloud)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the provider")
+	}
 
-	default:
+	cloudEnvironment, err := _provider.TerraformEnvironment(pathToPackerManifest, _config)
+	if err != nil {
 		return nil, oopsBuilder.
-			Errorf("unknown cloud: %v", cloud)
+			Wrapf(err, "failed to create %s environment", cloud.Name())
 	}
+
+	return &Environment[*TerraformBaseEnvironment]{
+		Base:  base,
+		Cloud: cloudEnvironment,
+	}, nil
 }
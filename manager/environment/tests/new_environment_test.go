@@ -1,8 +1,9 @@
 package tests
 
 import (
-	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager/environment"
+	"github.com/ed3899/kumo/provider/aws"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -12,8 +13,9 @@ var _ = Describe("NewEnvironment", func() {
 		It("returns an error", func() {
 			_, err := environment.NewEnvironment(
 				-1,
-				iota.Aws,
+				aws.Cloud,
 				"",
+				&config.Config{},
 			)
 			Expect(err).To(HaveOccurred())
 		})
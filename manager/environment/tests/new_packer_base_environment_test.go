@@ -1,26 +1,34 @@
 package tests
 
 import (
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager/environment"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 )
 
 var _ = Describe("NewPackerBaseEnvironment", func() {
-	BeforeEach(func() {
-		viper.Set("Git.Username", "git_username")
-		viper.Set("Git.Email", "git_email")
-		viper.Set("AMI.Tools", []string{"ansible_tag_1", "ansible_tag_2"})
-		viper.Set("GitHub.PersonalAccessTokenClassic", "git_hub_personal_access_token_classic")
-	})
+	var (
+		_config *config.Config
+	)
 
-	AfterEach(func() {
-		viper.Reset()
+	BeforeEach(func() {
+		_config = &config.Config{
+			Git: config.Git{
+				Username: "git_username",
+				Email:    "git_email",
+			},
+			AMI: config.Ami{
+				Tools: []string{"ansible_tag_1", "ansible_tag_2"},
+			},
+			GitHub: config.GitHub{
+				PersonalAccessTokenClassic: "git_hub_personal_access_token_classic",
+			},
+		}
 	})
 
 	It("should return a new PackerBaseEnvironment", func() {
-		_environment := environment.NewPackerBaseEnvironment()
+		_environment := environment.NewPackerBaseEnvironment(_config)
 		Expect(_environment).ToNot(BeNil())
 		Expect(_environment.Required.GIT_USERNAME).To(Equal("git_username"))
 		Expect(_environment.Required.GIT_EMAIL).To(Equal("git_email"))
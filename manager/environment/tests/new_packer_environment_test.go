@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager/environment"
+	"github.com/ed3899/kumo/provider/aws"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -10,9 +12,21 @@ var _ = Describe("NewPackerEnvironment", func() {
 	Context("when the cloud is unknown", func() {
 		It("returns an error", func() {
 			_, err := environment.NewPackerEnvironment(
-				-1,
+				"mars",
+				&config.Config{},
 			)
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("when the cloud has a registered provider", func() {
+		It("returns the packer environment of the provider", func() {
+			_environment, err := environment.NewPackerEnvironment(
+				aws.Cloud,
+				&config.Config{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(_environment.Cloud).To(BeAssignableToTypeOf(&aws.PackerAwsEnvironment{}))
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager/environment"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,7 +12,8 @@ var _ = Describe("NewTerraformEnvironment", func() {
 		It("returns an error", func() {
 			_, err := environment.NewTerraformEnvironment(
 				"",
-				-1,
+				"mars",
+				&config.Config{},
 			)
 			Expect(err).To(HaveOccurred())
 		})
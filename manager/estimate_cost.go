@@ -0,0 +1,34 @@
+package manager
+
+import (
+	"github.com/ed3899/kumo/utils/price_table"
+	"github.com/samber/oops"
+)
+
+// Returns the on-demand cost of what the tool is about to run, from the price table shipped with kumo, see
+// provider.Provider.EstimateCost.
+//
+// Example:
+//
+//	() -> (&price_table.Estimate{Region: "us-west-2", InstanceType: "t2.micro", InstanceHourly: 0.0116, ...}, nil)
+func (m *Manager) EstimateCost() (*price_table.Estimate, error) {
+	oopsBuilder := oops.
+		In("manager").
+		Tags("Manager").
+		Code("EstimateCost")
+
+	priceTable, err := price_table.ReadPriceTable(m.Path.PriceTable)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read the price table")
+	}
+
+	estimate, err := m.Provider.EstimateCost(priceTable, m.Tool, m.Config)
+	if err != nil {
+		return nil, oopsBuilder.
+			With("cloudName", m.Cloud.Name()).
+			Wrapf(err, "failed to estimate the cost")
+	}
+
+	return estimate, nil
+}
@@ -0,0 +1,33 @@
+package manager
+
+import (
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/samber/oops"
+)
+
+// Returns the address to connect to the deployed instance, read from the outputs in the Terraform state. It's
+// read on every call, so it follows the instance if its address changes. The address may be an IPv6.
+//
+// Example:
+//
+//	() -> ("3.91.10.20", nil)
+func (m *Manager) GetInstanceAddress() (string, error) {
+	oopsBuilder := oops.
+		In("manager").
+		Tags("Manager").
+		Code("GetInstanceAddress")
+
+	outputs, err := terraform_state.GetTerraformOutputsFromTerraformState(m.Path.Terraform.State)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to read the terraform outputs, make sure the environment is deployed with kumo up")
+	}
+
+	address := outputs.Address()
+	if address == "" {
+		return "", oopsBuilder.
+			Errorf("the instance has no public address, make sure it's deployed with kumo up and running, see kumo start")
+	}
+
+	return address, nil
+}
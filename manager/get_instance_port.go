@@ -0,0 +1,27 @@
+package manager
+
+import (
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/samber/oops"
+)
+
+// Returns the port to connect to the deployed instance over ssh, read from the outputs in the Terraform state,
+// see terraform_state.TerraformOutputs.Port.
+//
+// Example:
+//
+//	() -> (22, nil)
+func (m *Manager) GetInstancePort() (int, error) {
+	oopsBuilder := oops.
+		In("manager").
+		Tags("Manager").
+		Code("GetInstancePort")
+
+	outputs, err := terraform_state.GetTerraformOutputsFromTerraformState(m.Path.Terraform.State)
+	if err != nil {
+		return 0, oopsBuilder.
+			Wrapf(err, "failed to read the terraform outputs, make sure the environment is deployed with kumo up")
+	}
+
+	return outputs.Port(), nil
+}
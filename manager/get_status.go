@@ -0,0 +1,190 @@
+package manager
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/utils/file"
+	"github.com/ed3899/kumo/utils/packer_manifest"
+	"github.com/ed3899/kumo/utils/ssh_config"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/samber/oops"
+)
+
+// Returns what kumo knows about the environment from the local files: the Terraform state and its outputs, the
+// Packer manifest and the ssh config. Nothing is requested from the cloud. The idle shutdown only holds the
+// configured policy, see SetIdleSince.
+func (m *Manager) GetStatus() (*Status, error) {
+	oopsBuilder := oops.
+		In("manager").
+		Tags("Manager").
+		Code("GetStatus")
+
+	status := &Status{
+		Environment: m.Config.Environment(),
+		Cloud:       m.Cloud.Name(),
+		SshConfig: &LocalFileStatus{
+			Path:    m.Path.Terraform.SshConfig,
+			Present: file.IsFilePresent(m.Path.Terraform.SshConfig),
+		},
+	}
+
+	if m.Config.IdleShutdown.Minutes > 0 {
+		status.IdleShutdown = &IdleShutdownStatus{
+			Minutes:      m.Config.IdleShutdown.Minutes,
+			CpuThreshold: m.Config.IdleShutdown.CpuThreshold,
+		}
+	}
+
+	if file.IsFilePresent(m.Path.Terraform.State) {
+		instance, err := m.Provider.Instance(m.Path.Terraform.State)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "failed to read the instance from the terraform state")
+		}
+
+		if instance != nil {
+			status.Instance = &InstanceStatus{
+				Id:               instance.Id,
+				Type:             instance.Type,
+				State:            instance.State,
+				PublicIp:         instance.PublicIp,
+				PublicDns:        instance.PublicDns,
+				AmiId:            instance.ImageId,
+				AvailabilityZone: instance.AvailabilityZone,
+			}
+		}
+
+		outputs, err := terraform_state.GetTerraformOutputsFromTerraformState(m.Path.Terraform.State)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "failed to read the outputs from the terraform state")
+		}
+
+		if status.Instance != nil {
+			// Deployments from before the outputs were added only record them on the next kumo up.
+			if outputs.InstanceId == status.Instance.Id {
+				status.Instance.PublicIp = outputs.PublicIp
+				status.Instance.PublicIpv6 = outputs.PublicIpv6
+				status.Instance.PublicDns = outputs.PublicDns
+				status.Instance.AvailabilityZone = outputs.AvailabilityZone
+			}
+
+			status.Instance.Address = (&terraform_state.TerraformOutputs{
+				PublicIp:   status.Instance.PublicIp,
+				PublicIpv6: status.Instance.PublicIpv6,
+				PublicDns:  status.Instance.PublicDns,
+			}).Address()
+		}
+	}
+
+	if file.IsFilePresent(m.Path.Packer.Manifest) {
+		lastBuild, err := packer_manifest.GetLastBuildFromPackerManifest(m.Path.Packer.Manifest)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "failed to read the last build from the packer manifest")
+		}
+
+		amiId, err := m.Provider.ImageId(lastBuild.ArtifactId)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "failed to read the image id of the last build")
+		}
+
+		status.LastBuild = &BuildStatus{
+			AmiId:   amiId,
+			RunUuid: lastBuild.PackerRunUUID,
+			BuiltAt: time.Unix(lastBuild.BuildTime, 0).UTC(),
+		}
+	}
+
+	if status.SshConfig.Present {
+		sshConfig, err := ssh_config.ReadSshConfig(m.Path.Terraform.SshConfig)
+		status.SshConfig.InSync, status.SshConfig.Reason = compareWithInstance(status.Instance, func(instance *InstanceStatus) string {
+			switch {
+			case instance.Address == "":
+				return fmt.Sprintf("the instance has no public address while %s", instance.State)
+
+			case err != nil:
+				return "the file can't be read"
+
+			case sshConfig.Host != m.Config.ScopedName(constants.HOST):
+				return fmt.Sprintf("host %s doesn't match %s", sshConfig.Host, m.Config.ScopedName(constants.HOST))
+
+			case sshConfig.HostName != instance.Address:
+				return fmt.Sprintf("HostName %s doesn't match the instance address %s", sshConfig.HostName, instance.Address)
+
+			case sshConfig.IdentityFile != m.Path.Terraform.IdentityFile:
+				return fmt.Sprintf("IdentityFile %s doesn't match %s", sshConfig.IdentityFile, m.Path.Terraform.IdentityFile)
+
+			case sshConfig.User != m.Provider.SshUser(m.Config):
+				return fmt.Sprintf("User %s doesn't match %s", sshConfig.User, m.Provider.SshUser(m.Config))
+
+			default:
+				return ""
+			}
+		})
+	}
+
+	return status, nil
+}
+
+// Returns whether a local file is in sync with the instance and why not. A file can't be in sync
+// when there is no instance.
+func compareWithInstance(
+	instance *InstanceStatus,
+	mismatch func(*InstanceStatus) string,
+) (bool, string) {
+	if instance == nil {
+		return false, "no instance is deployed"
+	}
+
+	reason := mismatch(instance)
+
+	return reason == "", reason
+}
+
+type Status struct {
+	Environment  string              `json:"environment"`
+	Cloud        string              `json:"cloud"`
+	Instance     *InstanceStatus     `json:"instance"`
+	LastBuild    *BuildStatus        `json:"last_build"`
+	SshConfig    *LocalFileStatus    `json:"ssh_config"`
+	IdleShutdown *IdleShutdownStatus `json:"idle_shutdown"`
+}
+
+type InstanceStatus struct {
+	Id         string `json:"id"`
+	Type       string `json:"type"`
+	State      string `json:"state"`
+	PublicIp   string `json:"public_ip"`
+	PublicIpv6 string `json:"public_ipv6"`
+	PublicDns  string `json:"public_dns"`
+	// The public IP, IPv6 or DNS name kumo connects to, see terraform_state.TerraformOutputs.Address.
+	Address          string `json:"address"`
+	AmiId            string `json:"ami_id"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+type BuildStatus struct {
+	AmiId   string    `json:"ami_id"`
+	RunUuid string    `json:"run_uuid"`
+	BuiltAt time.Time `json:"built_at"`
+}
+
+type LocalFileStatus struct {
+	Path    string `json:"path"`
+	Present bool   `json:"present"`
+	InSync  bool   `json:"in_sync"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// The configured idle shutdown policy and, once known, since when the instance is idle.
+type IdleShutdownStatus struct {
+	Minutes      int        `json:"minutes"`
+	CpuThreshold int        `json:"cpu_threshold"`
+	IdleSince    *time.Time `json:"idle_since,omitempty"`
+	ShutdownAt   *time.Time `json:"shutdown_at,omitempty"`
+	Error        string     `json:"error,omitempty"`
+}
@@ -7,14 +7,19 @@ import (
 
 	"github.com/ed3899/kumo/common/constants"
 	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/dependencies"
 	"github.com/ed3899/kumo/manager/environment"
+	"github.com/ed3899/kumo/provider"
 	"github.com/samber/oops"
 )
 
-// Creates a new manager. Used for cmd workflows
+// Creates a new manager. Used for cmd workflows. Nothing is read or run to find the tool executable, it defaults to
+// the version kumo ships with until EnsureTool of cmd resolves it, see dependencies.ResolveExecutable.
 func NewManager(
 	cloud iota.Cloud,
 	tool iota.Tool,
+	_config *config.Config,
 ) (*Manager, error) {
 	oopsBuilder := oops.
 		Code("NewManager").
@@ -32,6 +37,9 @@ func NewManager(
 	}
 	currentExecutableDir := filepath.Dir(currentExecutablePath)
 
+	pathToDependencies := filepath.Join(currentExecutableDir, iota.Dependencies.Name())
+	pathToDependenciesLock := dependencies.PathToLock(pathToDependencies)
+
 	currentWorkingDir, err := os.Getwd()
 	if err != nil {
 		err := oopsBuilder.
@@ -56,10 +64,19 @@ func NewManager(
 		constants.PACKER_MANIFEST,
 	)
 
+	_provider, err := provider.Get(cloud)
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "failed to get the provider")
+
+		return nil, err
+	}
+
 	_environment, err := environment.NewEnvironment(
 		tool,
 		cloud,
 		pathToPackerManifest,
+		_config,
 	)
 	if err != nil {
 		err := oopsBuilder.
@@ -77,16 +94,25 @@ func NewManager(
 		)
 	}
 
+	// Terraform keeps the state of workspaces other than the default one under terraform.tfstate.d/<workspace>.
+	terraformStatePath := func(fileName string) string {
+		if _config.Environment() == constants.DEFAULT_ENVIRONMENT {
+			return terraformPath(fileName)
+		}
+
+		return terraformPath(filepath.Join(constants.TERRAFORM_WORKSPACES_DIR, _config.Environment(), fileName))
+	}
+
 	return &Manager{
-		Cloud: cloud.Iota(),
-		Tool:  tool.Iota(),
+		Cloud:       cloud.Iota(),
+		Tool:        tool.Iota(),
+		ToolVersion: tool.Version(),
+		ToolSource:  dependencies.SourceDependencies,
+		Provider:    _provider,
 		Path: &Path{
-			Executable: filepath.Join(
-				currentExecutableDir,
-				iota.Dependencies.Name(),
-				tool.Name(),
-				fmt.Sprintf("%s.exe", tool.Name()),
-			),
+			Executable:       dependencies.PathToExecutable(pathToDependencies, tool, tool.Version()),
+			Dependencies:     pathToDependencies,
+			DependenciesLock: pathToDependenciesLock,
 			Template: &Template{
 				Merged: templatePath(constants.MERGED_TEMPLATE_NAME),
 				Cloud:  templatePath(cloud.TemplateFiles().Cloud),
@@ -98,15 +124,25 @@ func NewManager(
 				cloud.Name(),
 				tool.VarsName(),
 			),
+			PriceTable: filepath.Join(
+				currentExecutableDir,
+				iota.Pricing.Name(),
+				fmt.Sprintf("%s.json", cloud.Name()),
+			),
+			Packer: &Packer{
+				Manifest: pathToPackerManifest,
+			},
 			Terraform: &Terraform{
 				Lock:         terraformPath(constants.TERRAFORM_LOCK),
-				State:        terraformPath(constants.TERRAFORM_STATE),
-				Backup:       terraformPath(constants.TERRAFORM_BACKUP),
-				IpFile:       terraformPath(constants.IP_FILE_NAME),
-				IdentityFile: terraformPath(constants.KEY_NAME),
+				State:        terraformStatePath(constants.TERRAFORM_STATE),
+				Backup:       terraformStatePath(constants.TERRAFORM_BACKUP),
+				UpPlan:       terraformStatePath(constants.TERRAFORM_UP_PLAN),
+				DestroyPlan:  terraformStatePath(constants.TERRAFORM_DESTROY_PLAN),
+				PowerPlan:    terraformStatePath(constants.TERRAFORM_POWER_PLAN),
+				IdentityFile: terraformPath(_config.ScopedName(constants.KEY_NAME)),
 				SshConfig: filepath.Join(
 					currentWorkingDir,
-					constants.CONFIG_NAME,
+					_config.ScopedName(constants.CONFIG_NAME),
 				),
 			},
 			Dir: &Dir{
@@ -125,30 +161,46 @@ func NewManager(
 			},
 		},
 		Environment: _environment,
+		Config:      _config,
 	}, nil
 }
 
 type Manager struct {
 	Cloud       iota.Cloud
 	Tool        iota.Tool
+	ToolVersion string
+	ToolSource  dependencies.Source
+	Provider    provider.Provider
 	Path        *Path
 	Environment any
+	Config      *config.Config
 }
 
 type Path struct {
-	Executable string
+	Executable       string
+	Dependencies     string
+	DependenciesLock string
+	// Shared by the environments of the cloud, so every command running the tool renders it first, see RenderVars.
 	Vars       string
+	PriceTable string
+	Packer     *Packer
 	Terraform  *Terraform
 	Template   *Template
 	Dir        *Dir
 }
 
+type Packer struct {
+	Manifest string
+}
+
 type Terraform struct {
 	Lock         string
 	State        string
 	Backup       string
+	UpPlan       string
+	DestroyPlan  string
+	PowerPlan    string
 	SshConfig    string
-	IpFile       string
 	IdentityFile string
 }
 
@@ -0,0 +1,38 @@
+package manager
+
+import (
+	"github.com/samber/oops"
+)
+
+// Renders the vars file of the environment from the merged template. The vars file is shared by every
+// environment of the cloud, so every command running the tool renders it first.
+func (m *Manager) RenderVars() error {
+	oopsBuilder := oops.
+		In("manager").
+		Tags("Manager").
+		Code("RenderVars")
+
+	err := m.CreateTemplate()
+	if err != nil {
+		return oopsBuilder.Wrapf(err, "failed to create template")
+	}
+	defer m.DeleteTemplate()
+
+	template, err := m.ParseTemplate()
+	if err != nil {
+		return oopsBuilder.Wrapf(err, "failed to parse template")
+	}
+
+	vars, err := m.CreateVars()
+	if err != nil {
+		return oopsBuilder.Wrapf(err, "failed to create vars")
+	}
+	defer vars.Close()
+
+	err = template.Execute(vars, m.Environment)
+	if err != nil {
+		return oopsBuilder.Wrapf(err, "failed to execute template")
+	}
+
+	return nil
+}
@@ -3,32 +3,29 @@ package manager
 import (
 	"os"
 
-	"github.com/ed3899/kumo/common/iota"
 	"github.com/samber/oops"
-	"github.com/spf13/viper"
 )
 
-// Sets the cloud credentials environment variables.
+// Sets the cloud credentials environment variables, see provider.Provider.Credentials.
 func (m *Manager) SetCloudCredentials() error {
 	oopsBuilder := oops.
 		In("manager").
 		Tags("Manager").
 		Code("SetCloudCredentials")
 
-	switch m.Cloud {
-	case iota.Aws:
-		for key, value := range awsCredentials {
-			if err := os.Setenv(key, viper.GetString(value)); err != nil {
-				return oopsBuilder.
-					With("cloudName", m.Cloud.Name).
-					Wrapf(err, "failed to set environment variable %s to %s", key, value)
-			}
-		}
-
-	default:
+	credentials, err := m.Provider.Credentials(m.Config)
+	if err != nil {
 		return oopsBuilder.
-			With("cloudName", m.Cloud.Name).
-			Errorf("unknown cloud: %#v", m.Cloud)
+			With("cloudName", m.Cloud.Name()).
+			Wrapf(err, "failed to get the cloud credentials")
+	}
+
+	for key, value := range credentials {
+		if err := os.Setenv(key, value); err != nil {
+			return oopsBuilder.
+				With("cloudName", m.Cloud.Name()).
+				Wrapf(err, "failed to set environment variable %s", key)
+		}
 	}
 
 	return nil
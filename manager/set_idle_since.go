@@ -0,0 +1,23 @@
+package manager
+
+import (
+	"time"
+)
+
+// Records since when the instance is idle, as reported by the idle shutdown watchdog, and when it will stop.
+// A nil idleSince means the instance is in use, so it won't stop before the full policy minutes of idleness.
+//
+// Example:
+//
+//	(10:00) -> IdleShutdownStatus{Minutes: 60, IdleSince: 10:00, ShutdownAt: 11:00}
+func (s *IdleShutdownStatus) SetIdleSince(
+	idleSince *time.Time,
+) {
+	s.IdleSince = idleSince
+	s.ShutdownAt = nil
+
+	if idleSince != nil {
+		shutdownAt := idleSince.Add(time.Duration(s.Minutes) * time.Minute)
+		s.ShutdownAt = &shutdownAt
+	}
+}
@@ -0,0 +1,49 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/samber/oops"
+)
+
+// Returns the arguments for the system ssh client to connect to the deployed instance, with the same
+// options as the generated ssh config. The command, if any, is run on the instance instead of a shell.
+//
+// Example:
+//
+//	([]string{"uptime"}) -> ([]string{"-i", "/path/to/kumokey", "-p", "22", ..., "dev@3.91.10.20", "--", "uptime"}, nil)
+func (m *Manager) SshArgs(command []string) ([]string, error) {
+	oopsBuilder := oops.
+		In("manager").
+		Tags("Manager").
+		Code("SshArgs")
+
+	address, err := m.GetInstanceAddress()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the instance address")
+	}
+
+	port, err := m.GetInstancePort()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the instance port")
+	}
+
+	args := []string{
+		"-i", m.Path.Terraform.IdentityFile,
+		"-p", fmt.Sprint(port),
+		"-o", "StrictHostKeyChecking=no",
+		"-o", "PasswordAuthentication=no",
+		"-o", "IdentitiesOnly=yes",
+		"-o", "LogLevel=error",
+		fmt.Sprintf("%s@%s", m.Provider.SshUser(m.Config), address),
+	}
+
+	if len(command) > 0 {
+		args = append(args, "--")
+		args = append(args, command...)
+	}
+
+	return args, nil
+}
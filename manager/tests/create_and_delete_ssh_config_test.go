@@ -3,31 +3,27 @@ package tests
 import (
 	"os"
 	"path/filepath"
+	"runtime"
 
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/provider/aws"
+	"github.com/ed3899/kumo/utils/ssh_config"
+	"github.com/ed3899/kumo/utils/terraform_state"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
-	Context("with a valid ip file", func() {
+	Context("with a public address", func() {
 		var (
-			ipFilePath string
+			outputs *terraform_state.TerraformOutputs
 		)
 
 		BeforeEach(func() {
-			cwd, err := os.Getwd()
-			Expect(err).ToNot(HaveOccurred())
-
-			ipFilePath = filepath.Join(cwd, "ipfile")
-
-			err = os.WriteFile(ipFilePath, []byte("127.0.0.1"), 0644)
-			Expect(err).ToNot(HaveOccurred())
-		})
-
-		AfterEach(func() {
-			err := os.Remove(ipFilePath)
-			Expect(err).ToNot(HaveOccurred())
+			outputs = &terraform_state.TerraformOutputs{
+				PublicIp: "127.0.0.1",
+			}
 		})
 
 		Context("with a valid ssh config path", Label("unit"), func() {
@@ -43,9 +39,10 @@ var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
 				sshConfigPath = filepath.Join(cwd, "sshconfig")
 
 				_manager = &manager.Manager{
+					Provider: aws.NewAws(),
+					Config:   &config.Config{},
 					Path: &manager.Path{
 						Terraform: &manager.Terraform{
-							IpFile:    ipFilePath,
 							SshConfig: sshConfigPath,
 						},
 					},
@@ -53,11 +50,14 @@ var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
 			})
 
 			It("should generate a ssh config file", func() {
-				err := _manager.CreateSshConfig()
+				err := _manager.CreateSshConfig(outputs)
 				Expect(err).ToNot(HaveOccurred())
 
-				_, err = os.Stat(sshConfigPath)
+				info, err := os.Stat(sshConfigPath)
 				Expect(err).ToNot(HaveOccurred())
+				if runtime.GOOS != "windows" {
+					Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+				}
 			})
 
 			It("should delete the ssh config file", func() {
@@ -76,9 +76,10 @@ var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
 
 			BeforeEach(func() {
 				_manager = &manager.Manager{
+					Provider: aws.NewAws(),
+					Config:   &config.Config{},
 					Path: &manager.Path{
 						Terraform: &manager.Terraform{
-							IpFile:    ipFilePath,
 							SshConfig: "",
 						},
 					},
@@ -86,13 +87,21 @@ var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
 			})
 
 			It("should return an error", func() {
-				err := _manager.CreateSshConfig()
+				err := _manager.CreateSshConfig(outputs)
 				Expect(err).To(HaveOccurred())
 			})
 		})
 	})
 
-	Context("with an invalid ip file", func() {
+	Context("without a public address", func() {
+		var (
+			outputs *terraform_state.TerraformOutputs
+		)
+
+		BeforeEach(func() {
+			outputs = &terraform_state.TerraformOutputs{}
+		})
+
 		Context("with a valid ssh config path", Label("unit"), func() {
 			var (
 				_manager      *manager.Manager
@@ -106,9 +115,10 @@ var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
 				sshConfigPath = filepath.Join(cwd, "sshconfig")
 
 				_manager = &manager.Manager{
+					Provider: aws.NewAws(),
+					Config:   &config.Config{},
 					Path: &manager.Path{
 						Terraform: &manager.Terraform{
-							IpFile:    "invalid-ip-file",
 							SshConfig: sshConfigPath,
 						},
 					},
@@ -116,7 +126,7 @@ var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
 			})
 
 			It("should return an error when generating a sshconfig", func() {
-				err := _manager.CreateSshConfig()
+				err := _manager.CreateSshConfig(outputs)
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -128,9 +138,10 @@ var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
 
 			BeforeEach(func() {
 				_manager = &manager.Manager{
+					Provider: aws.NewAws(),
+					Config:   &config.Config{},
 					Path: &manager.Path{
 						Terraform: &manager.Terraform{
-							IpFile:    "invalid-ip-file",
 							SshConfig: "",
 						},
 					},
@@ -138,9 +149,54 @@ var _ = Describe("CreateAndDeleteSSHConfig", Ordered, func() {
 			})
 
 			It("should return an error when generating a sshconfig", func() {
-				err := _manager.CreateSshConfig()
+				err := _manager.CreateSshConfig(outputs)
 				Expect(err).To(HaveOccurred())
 			})
 		})
 	})
 })
+
+var _ = Describe("CreateSSHConfig with a named environment", Label("unit"), func() {
+	It("should use the environment host alias", func() {
+		dir := GinkgoT().TempDir()
+		sshConfigPath := filepath.Join(dir, "sshconfig")
+
+		_manager := &manager.Manager{
+			Provider: aws.NewAws(),
+			Config:   &config.Config{Name: "gpu-box"},
+			Path: &manager.Path{
+				Terraform: &manager.Terraform{
+					SshConfig: sshConfigPath,
+				},
+			},
+		}
+
+		Expect(_manager.CreateSshConfig(&terraform_state.TerraformOutputs{PublicIp: "127.0.0.1"})).To(Succeed())
+
+		content, err := os.ReadFile(sshConfigPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(HavePrefix("Host kumo-gpu-box\n"))
+	})
+})
+
+var _ = Describe("CreateSSHConfig with an IPv6 only instance", Label("unit"), func() {
+	It("should use the IPv6 as HostName", func() {
+		sshConfigPath := filepath.Join(GinkgoT().TempDir(), "sshconfig")
+
+		_manager := &manager.Manager{
+			Provider: aws.NewAws(),
+			Config:   &config.Config{},
+			Path: &manager.Path{
+				Terraform: &manager.Terraform{
+					SshConfig: sshConfigPath,
+				},
+			},
+		}
+
+		Expect(_manager.CreateSshConfig(&terraform_state.TerraformOutputs{PublicIpv6: "2600:1f18:4a3:6a00::1"})).To(Succeed())
+
+		sshConfig, err := ssh_config.ReadSshConfig(sshConfigPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sshConfig.HostName).To(Equal("2600:1f18:4a3:6a00::1"))
+	})
+})
@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/provider/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EstimateCost", func() {
+	var _config *config.Config
+
+	newManager := func(tool iota.Tool) *manager.Manager {
+		// A terraform manager needs a packer manifest, only the tool matters here.
+		_manager, err := manager.NewManager(aws.Cloud, iota.Packer, _config)
+		Expect(err).ToNot(HaveOccurred())
+
+		_manager.Tool = tool
+
+		_manager.Path.PriceTable = filepath.Join(GinkgoT().TempDir(), "aws.json")
+		Expect(os.WriteFile(_manager.Path.PriceTable, []byte(`{"currency": "USD", "regions": {
+			"us-west-2": {"instances": {"t2.micro": 0.0116}, "volumes": {"gp2": 0.1}}}}`), 0644)).To(Succeed())
+
+		return _manager
+	}
+
+	BeforeEach(func() {
+		_config = &config.Config{
+			AWS: config.Aws{
+				Region: "us-west-2",
+				EC2: config.Ec2{
+					Instance: config.Instance{Type: "t2.micro"},
+					Volume:   config.Volume{Type: "gp2", Size: 30},
+				},
+			},
+		}
+	})
+
+	Context("for terraform", Label("unit"), func() {
+		It("should include the volume", func() {
+			estimate, err := newManager(iota.Terraform).EstimateCost()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(estimate.InstanceHourly).To(Equal(0.0116))
+			Expect(estimate.VolumeSize).To(Equal(30))
+			Expect(estimate.VolumeMonthly).To(BeNumerically("~", 3.0))
+		})
+	})
+
+	Context("for packer", Label("unit"), func() {
+		It("should only include the build instance", func() {
+			estimate, err := newManager(iota.Packer).EstimateCost()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(estimate.InstanceHourly).To(Equal(0.0116))
+			Expect(estimate.VolumeType).To(BeEmpty())
+			Expect(estimate.VolumeMonthly).To(BeZero())
+		})
+	})
+
+	Context("with an unknown instance type", Label("unit"), func() {
+		It("should return an error", func() {
+			_config.AWS.EC2.Instance.Type = "p4d.24xlarge"
+
+			_, err := newManager(iota.Packer).EstimateCost()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/provider/aws"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetStatus", func() {
+	var (
+		_manager *manager.Manager
+		dir      string
+	)
+
+	BeforeEach(func() {
+		var err error
+		_manager, err = manager.NewManager(aws.Cloud, iota.Packer, &config.Config{
+			Name: "gpu-box",
+			AMI: config.Ami{
+				User: "dev",
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		dir = GinkgoT().TempDir()
+		_manager.Path.Packer.Manifest = filepath.Join(dir, "manifest.json")
+		_manager.Path.Terraform.State = filepath.Join(dir, "terraform.tfstate")
+		_manager.Path.Terraform.IdentityFile = filepath.Join(dir, "kumokey-gpu-box")
+		_manager.Path.Terraform.SshConfig = filepath.Join(dir, "kumossh-gpu-box")
+	})
+
+	writeDeployment := func(publicIp string, outputs string) {
+		state := fmt.Sprintf(`{"outputs": %s, "resources": [{"mode": "managed", "type": "aws_instance", "name": "kumo-ec2-instance", "instances": [{"attributes": {
+			"id": "i-0a1b2c3d4e5f67890", "ami": "ami-0c3fd0f5d33134a76", "instance_type": "t2.micro", "instance_state": "running",
+			"public_ip": "%s", "availability_zone": "us-east-1a"}}]}]}`, outputs, publicIp)
+		manifest := `{"builds": [{"build_time": 1690000000, "packer_run_uuid": "run-1", "artifact_id": "us-east-1:ami-0c3fd0f5d33134a76"}], "last_run_uuid": "run-1"}`
+
+		Expect(os.WriteFile(_manager.Path.Terraform.State, []byte(state), 0644)).To(Succeed())
+		Expect(os.WriteFile(_manager.Path.Packer.Manifest, []byte(manifest), 0644)).To(Succeed())
+		Expect(_manager.CreateSshConfig(&terraform_state.TerraformOutputs{PublicIp: "3.91.10.20"})).To(Succeed())
+	}
+
+	Context("with nothing built or deployed", Label("unit"), func() {
+		It("should report no instance nor build", func() {
+			status, err := _manager.GetStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Environment).To(Equal("gpu-box"))
+			Expect(status.Cloud).To(Equal("aws"))
+			Expect(status.Instance).To(BeNil())
+			Expect(status.LastBuild).To(BeNil())
+			Expect(status.SshConfig.Present).To(BeFalse())
+			Expect(status.IdleShutdown).To(BeNil())
+		})
+	})
+
+	Context("with idle shutdown enabled", Label("unit"), func() {
+		BeforeEach(func() {
+			_manager.Config.IdleShutdown = config.IdleShutdown{
+				Minutes:      60,
+				CpuThreshold: 10,
+			}
+		})
+
+		It("should report the policy", func() {
+			status, err := _manager.GetStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.IdleShutdown).To(Equal(&manager.IdleShutdownStatus{
+				Minutes:      60,
+				CpuThreshold: 10,
+			}))
+		})
+
+		It("should compute when an idle instance stops", func() {
+			status, err := _manager.GetStatus()
+			Expect(err).ToNot(HaveOccurred())
+
+			idleSince := time.Date(2023, 10, 18, 10, 0, 0, 0, time.UTC)
+			status.IdleShutdown.SetIdleSince(&idleSince)
+			Expect(*status.IdleShutdown.ShutdownAt).To(Equal(time.Date(2023, 10, 18, 11, 0, 0, 0, time.UTC)))
+
+			status.IdleShutdown.SetIdleSince(nil)
+			Expect(status.IdleShutdown.IdleSince).To(BeNil())
+			Expect(status.IdleShutdown.ShutdownAt).To(BeNil())
+		})
+	})
+
+	Context("with a deployment", Label("unit"), func() {
+		It("should report the instance, the last build and the local files in sync", func() {
+			writeDeployment("3.91.10.20", "{}")
+
+			status, err := _manager.GetStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Instance).To(Equal(&manager.InstanceStatus{
+				Id:               "i-0a1b2c3d4e5f67890",
+				Type:             "t2.micro",
+				State:            "running",
+				PublicIp:         "3.91.10.20",
+				Address:          "3.91.10.20",
+				AmiId:            "ami-0c3fd0f5d33134a76",
+				AvailabilityZone: "us-east-1a",
+			}))
+			Expect(status.LastBuild).To(Equal(&manager.BuildStatus{
+				AmiId:   "ami-0c3fd0f5d33134a76",
+				RunUuid: "run-1",
+				BuiltAt: time.Unix(1690000000, 0).UTC(),
+			}))
+			Expect(status.SshConfig.InSync).To(BeTrue())
+			Expect(status.SshConfig.Reason).To(BeEmpty())
+		})
+
+		It("should report stale local files", func() {
+			writeDeployment("3.91.10.21", "{}")
+
+			status, err := _manager.GetStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.SshConfig.InSync).To(BeFalse())
+			Expect(status.SshConfig.Reason).To(Equal("HostName 3.91.10.20 doesn't match the instance address 3.91.10.21"))
+		})
+
+		It("should report the local files of a stopped instance as stale", func() {
+			writeDeployment("", "{}")
+
+			status, err := _manager.GetStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.SshConfig.InSync).To(BeFalse())
+			Expect(status.SshConfig.Reason).To(Equal("the instance has no public address while running"))
+		})
+
+		It("should prefer the terraform outputs of the instance", func() {
+			writeDeployment("3.91.10.20", `{
+				"instance_id": {"value": "i-0a1b2c3d4e5f67890"},
+				"public_ip": {"value": ""},
+				"public_ipv6": {"value": "2600:1f18:4a3:6a00::1"},
+				"public_dns": {"value": ""},
+				"availability_zone": {"value": "us-east-1b"}
+			}`)
+
+			status, err := _manager.GetStatus()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Instance.PublicIp).To(BeEmpty())
+			Expect(status.Instance.PublicIpv6).To(Equal("2600:1f18:4a3:6a00::1"))
+			Expect(status.Instance.AvailabilityZone).To(Equal("us-east-1b"))
+			Expect(status.Instance.Address).To(Equal("2600:1f18:4a3:6a00::1"))
+			Expect(status.SshConfig.Reason).To(Equal("HostName 3.91.10.20 doesn't match the instance address 2600:1f18:4a3:6a00::1"))
+		})
+	})
+})
@@ -2,28 +2,34 @@ package tests
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
+	"runtime"
 
-	"github.com/ed3899/kumo/manager"
 	"github.com/ed3899/kumo/common/constants"
 	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/dependencies"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/provider/aws"
+	"github.com/ed3899/kumo/utils/host"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("Manager", func() {
 	var (
-		_manager                      *manager.Manager
+		_manager                     *manager.Manager
 		err                          error
 		pathExeSubstring             string
 		pathTemplateMergedSubstring  string
 		pathTemplateCloudSubstring   string
 		pathTemplateBaseSubstring    string
 		pathVarsSubtring             string
+		pathPriceTableSubstring      string
 		pathTerraformLockSubstring   string
 		pathTerraformStateSubstring  string
 		pathTerraformBackupSubstring string
-		pathTerraformIpFile          string
 		pathTerraformIdentityFile    string
 		pathSshConfigSubstring       string
 		pathDirPlugins               string
@@ -31,14 +37,15 @@ var _ = Describe("Manager", func() {
 	)
 
 	BeforeEach(func() {
-		_manager, err = manager.NewManager(iota.Aws, iota.Packer)
+		_manager, err = manager.NewManager(aws.Cloud, iota.Packer, &config.Config{})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(_manager).ToNot(BeNil())
 
 		pathExeSubstring = filepath.Join(
 			iota.Dependencies.Name(),
 			iota.Packer.Name(),
-			fmt.Sprintf("%s.exe", iota.Packer.Name()),
+			iota.Packer.Version(),
+			host.ExecutableName(iota.Packer.Name(), runtime.GOOS),
 		)
 
 		templateSubstring := func(templateName string) string {
@@ -49,18 +56,23 @@ var _ = Describe("Manager", func() {
 			)
 		}
 		pathTemplateMergedSubstring = templateSubstring(constants.MERGED_TEMPLATE_NAME)
-		pathTemplateCloudSubstring = templateSubstring(iota.Aws.TemplateFiles().Cloud)
-		pathTemplateBaseSubstring = templateSubstring(iota.Aws.TemplateFiles().Base)
+		pathTemplateCloudSubstring = templateSubstring(aws.Cloud.TemplateFiles().Cloud)
+		pathTemplateBaseSubstring = templateSubstring(aws.Cloud.TemplateFiles().Base)
 		pathVarsSubtring = filepath.Join(
 			iota.Packer.Name(),
-			iota.Aws.Name(),
+			aws.Cloud.Name(),
 			iota.Packer.VarsName(),
 		)
 
+		pathPriceTableSubstring = filepath.Join(
+			iota.Pricing.Name(),
+			"aws.json",
+		)
+
 		terraformPathSubstring := func(fileName string) string {
 			return filepath.Join(
 				iota.Terraform.Name(),
-				iota.Aws.Name(),
+				aws.Cloud.Name(),
 				fileName,
 			)
 		}
@@ -68,34 +80,36 @@ var _ = Describe("Manager", func() {
 		pathTerraformLockSubstring = terraformPathSubstring(constants.TERRAFORM_LOCK)
 		pathTerraformStateSubstring = terraformPathSubstring(constants.TERRAFORM_STATE)
 		pathTerraformBackupSubstring = terraformPathSubstring(constants.TERRAFORM_BACKUP)
-		pathTerraformIpFile = terraformPathSubstring(constants.IP_FILE_NAME)
 		pathTerraformIdentityFile = terraformPathSubstring(constants.KEY_NAME)
 		pathSshConfigSubstring = constants.CONFIG_NAME
 
 		pathDirPlugins = filepath.Join(
 			iota.Packer.Name(),
-			iota.Aws.Name(),
+			aws.Cloud.Name(),
 			iota.Packer.PluginDir(),
 		)
 		pathDirRun = filepath.Join(
 			iota.Packer.Name(),
-			iota.Aws.Name(),
+			aws.Cloud.Name(),
 		)
 
 	})
 
 	It("should create a new manager instance", Label("unit"), func() {
-		Expect(_manager.Cloud).To(Equal(iota.Aws))
+		Expect(_manager.Cloud).To(Equal(aws.Cloud))
 		Expect(_manager.Tool).To(Equal(iota.Packer))
+		Expect(_manager.ToolVersion).To(Equal(iota.Packer.Version()))
+		Expect(_manager.ToolSource).To(Equal(dependencies.SourceDependencies))
 		Expect(_manager.Path.Executable).To(ContainSubstring(pathExeSubstring))
+		Expect(_manager.Path.DependenciesLock).To(ContainSubstring(filepath.Join(iota.Dependencies.Name(), constants.DEPENDENCIES_LOCK)))
 		Expect(_manager.Path.Template.Merged).To(ContainSubstring(pathTemplateMergedSubstring))
 		Expect(_manager.Path.Template.Cloud).To(ContainSubstring(pathTemplateCloudSubstring))
 		Expect(_manager.Path.Template.Base).To(ContainSubstring(pathTemplateBaseSubstring))
 		Expect(_manager.Path.Vars).To(ContainSubstring(pathVarsSubtring))
+		Expect(_manager.Path.PriceTable).To(ContainSubstring(pathPriceTableSubstring))
 		Expect(_manager.Path.Terraform.Lock).To(ContainSubstring(pathTerraformLockSubstring))
 		Expect(_manager.Path.Terraform.State).To(ContainSubstring(pathTerraformStateSubstring))
 		Expect(_manager.Path.Terraform.Backup).To(ContainSubstring(pathTerraformBackupSubstring))
-		Expect(_manager.Path.Terraform.IpFile).To(ContainSubstring(pathTerraformIpFile))
 		Expect(_manager.Path.Terraform.IdentityFile).To(ContainSubstring(pathTerraformIdentityFile))
 		Expect(_manager.Path.Terraform.SshConfig).To(ContainSubstring(pathSshConfigSubstring))
 		Expect(_manager.Path.Dir.Plugins).To(ContainSubstring(pathDirPlugins))
@@ -104,3 +118,44 @@ var _ = Describe("Manager", func() {
 		Expect(_manager.Environment).ToNot(BeNil())
 	})
 })
+
+var _ = Describe("Manager with a tool on PATH", func() {
+	It("should not run it", Label("unit"), func() {
+		if runtime.GOOS == "windows" {
+			Skip("the fake packer is a shell script")
+		}
+
+		pathDir := GinkgoT().TempDir()
+		ranMarker := filepath.Join(pathDir, "ran")
+		script := fmt.Sprintf("#!/bin/sh\ntouch %s\necho 'Packer v%s'\n", ranMarker, iota.Packer.Version())
+		Expect(os.WriteFile(filepath.Join(pathDir, iota.Packer.Name()), []byte(script), 0755)).To(Succeed())
+		GinkgoT().Setenv("PATH", pathDir)
+
+		_manager, err := manager.NewManager(aws.Cloud, iota.Packer, &config.Config{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(_manager.ToolSource).To(Equal(dependencies.SourceDependencies))
+		Expect(ranMarker).ToNot(BeAnExistingFile())
+	})
+})
+
+var _ = Describe("Manager with a named environment", func() {
+	It("should scope the terraform state, plans, key and ssh config to the environment", Label("unit"), func() {
+		_manager, err := manager.NewManager(aws.Cloud, iota.Packer, &config.Config{Name: "gpu-box"})
+		Expect(err).ToNot(HaveOccurred())
+
+		workspaceSubstring := filepath.Join(
+			iota.Terraform.Name(),
+			aws.Cloud.Name(),
+			constants.TERRAFORM_WORKSPACES_DIR,
+			"gpu-box",
+		)
+
+		Expect(_manager.Path.Terraform.State).To(HaveSuffix(filepath.Join(workspaceSubstring, constants.TERRAFORM_STATE)))
+		Expect(_manager.Path.Terraform.Backup).To(HaveSuffix(filepath.Join(workspaceSubstring, constants.TERRAFORM_BACKUP)))
+		Expect(_manager.Path.Terraform.UpPlan).To(HaveSuffix(filepath.Join(workspaceSubstring, constants.TERRAFORM_UP_PLAN)))
+		Expect(_manager.Path.Terraform.DestroyPlan).To(HaveSuffix(filepath.Join(workspaceSubstring, constants.TERRAFORM_DESTROY_PLAN)))
+		Expect(_manager.Path.Terraform.PowerPlan).To(HaveSuffix(filepath.Join(workspaceSubstring, constants.TERRAFORM_POWER_PLAN)))
+		Expect(_manager.Path.Terraform.IdentityFile).To(HaveSuffix(fmt.Sprintf("%s-gpu-box", constants.KEY_NAME)))
+		Expect(_manager.Path.Terraform.SshConfig).To(HaveSuffix(fmt.Sprintf("%s-gpu-box", constants.CONFIG_NAME)))
+	})
+})
@@ -3,11 +3,11 @@ package tests
 import (
 	"os"
 
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/manager"
-	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider/aws"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 )
 
 var _ = Describe("SetAndUnsetCloudCredentials", Ordered, func() {
@@ -21,15 +21,15 @@ var _ = Describe("SetAndUnsetCloudCredentials", Ordered, func() {
 	Context("when the cloud is AWS", func() {
 		BeforeEach(func() {
 			_manager = &manager.Manager{
-				Cloud: iota.Aws,
+				Cloud:    aws.Cloud,
+				Provider: aws.NewAws(),
+				Config: &config.Config{
+					AWS: config.Aws{
+						AccessKeyId:     mockAccessKeyId,
+						SecretAccessKey: mockSecretAccessKey,
+					},
+				},
 			}
-
-			viper.Set("AWS.AccessKeyId", mockAccessKeyId)
-			viper.Set("AWS.SecretAccessKey", mockSecretAccessKey)
-		})
-
-		AfterEach(func() {
-			viper.Reset()
 		})
 
 		It("sets the the right environment variables", func() {
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/provider/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SshArgs", Label("unit"), func() {
+	var (
+		_manager *manager.Manager
+		dir      string
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+
+		_manager = &manager.Manager{
+			Provider: aws.NewAws(),
+			Config: &config.Config{
+				AMI: config.Ami{
+					User: "dev",
+				},
+			},
+			Path: &manager.Path{
+				Terraform: &manager.Terraform{
+					State:        filepath.Join(dir, "terraform.tfstate"),
+					IdentityFile: filepath.Join(dir, "kumokey"),
+				},
+			},
+		}
+	})
+
+	Context("with a deployed instance", func() {
+		BeforeEach(func() {
+			state := `{"outputs": {"instance_id": {"value": "i-0a1b2c3d4e5f67890"}, "public_ip": {"value": "3.91.10.20"}}}`
+			Expect(os.WriteFile(_manager.Path.Terraform.State, []byte(state), 0644)).To(Succeed())
+		})
+
+		It("should return the arguments for an interactive session", func() {
+			args, err := _manager.SshArgs(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args).To(HaveExactElements(
+				"-i", _manager.Path.Terraform.IdentityFile,
+				"-p", "22",
+				"-o", "StrictHostKeyChecking=no",
+				"-o", "PasswordAuthentication=no",
+				"-o", "IdentitiesOnly=yes",
+				"-o", "LogLevel=error",
+				"dev@3.91.10.20",
+			))
+		})
+
+		It("should append the remote command", func() {
+			args, err := _manager.SshArgs([]string{"ls", "-la"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args[len(args)-4:]).To(Equal([]string{"dev@3.91.10.20", "--", "ls", "-la"}))
+		})
+	})
+
+	Context("with an IPv6 only instance", func() {
+		It("should connect to the IPv6", func() {
+			state := `{"outputs": {"public_ip": {"value": ""}, "public_ipv6": {"value": "2600:1f18:4a3:6a00::1"}}}`
+			Expect(os.WriteFile(_manager.Path.Terraform.State, []byte(state), 0644)).To(Succeed())
+
+			args, err := _manager.SshArgs(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args[len(args)-1]).To(Equal("dev@2600:1f18:4a3:6a00::1"))
+		})
+	})
+
+	Context("with an instance reached on another port", func() {
+		It("should connect to the ssh_port output", func() {
+			state := `{"outputs": {"public_ip": {"value": "127.0.0.1"}, "ssh_port": {"value": 2222}}}`
+			Expect(os.WriteFile(_manager.Path.Terraform.State, []byte(state), 0644)).To(Succeed())
+
+			args, err := _manager.SshArgs(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(args[2:4]).To(Equal([]string{"-p", "2222"}))
+			Expect(args[len(args)-1]).To(Equal("dev@127.0.0.1"))
+		})
+	})
+
+	Context("with a stopped instance", func() {
+		It("should return an error", func() {
+			state := `{"outputs": {"public_ip": {"value": ""}, "public_ipv6": {"value": ""}, "public_dns": {"value": ""}}}`
+			Expect(os.WriteFile(_manager.Path.Terraform.State, []byte(state), 0644)).To(Succeed())
+
+			_, err := _manager.SshArgs(nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("without a deployed instance", func() {
+		It("should return an error", func() {
+			_, err := _manager.SshArgs(nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
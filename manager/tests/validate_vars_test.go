@@ -0,0 +1,63 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager"
+	"github.com/ed3899/kumo/provider/aws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateVars", func() {
+	var (
+		_manager *manager.Manager
+		err      error
+	)
+
+	BeforeEach(func() {
+		_manager, err = manager.NewManager(aws.Cloud, iota.Packer, &config.Config{})
+		Expect(err).ToNot(HaveOccurred())
+
+		dir := GinkgoT().TempDir()
+		_manager.Path.Dir.Run = dir
+		_manager.Path.Vars = filepath.Join(dir, iota.Packer.VarsName())
+
+		variables := `variable "REQUIRED" {
+  type = string
+}
+
+variable "OPTIONAL" {
+  type    = string
+  default = "optional"
+}
+`
+		Expect(os.WriteFile(filepath.Join(dir, "variables.pkr.hcl"), []byte(variables), 0644)).To(Succeed())
+	})
+
+	Context("with every required variable assigned", Label("unit"), func() {
+		It("should return no problems", func() {
+			Expect(os.WriteFile(_manager.Path.Vars, []byte(`REQUIRED = "required"`), 0644)).To(Succeed())
+
+			problems, err := _manager.ValidateVars()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problems).To(BeEmpty())
+		})
+	})
+
+	Context("with missing and undeclared variables", Label("unit"), func() {
+		It("should return a problem for each", func() {
+			Expect(os.WriteFile(_manager.Path.Vars, []byte(`UNDECLARED = "undeclared"`), 0644)).To(Succeed())
+
+			problems, err := _manager.ValidateVars()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(problems).To(Equal([]string{
+				"variable REQUIRED is declared without a default but is not assigned",
+				"variable UNDECLARED is assigned but not declared",
+			}))
+		})
+	})
+})
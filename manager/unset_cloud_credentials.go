@@ -3,7 +3,6 @@ package manager
 import (
 	"os"
 
-	"github.com/ed3899/kumo/common/iota"
 	"github.com/samber/oops"
 )
 
@@ -14,20 +13,19 @@ func (m *Manager) UnsetCloudCredentials() error {
 		Tags("Manager").
 		Code("UnsetCloudCredentials")
 
-	switch m.Cloud {
-	case iota.Aws:
-		for key := range awsCredentials {
-			if err := os.Unsetenv(key); err != nil {
-				return oopsBuilder.
-					With("cloudName", m.Cloud.Name()).
-					Wrapf(err, "failed to unset environment variable %s", key)
-			}
-		}
-
-	default:
+	credentials, err := m.Provider.Credentials(m.Config)
+	if err != nil {
 		return oopsBuilder.
 			With("cloudName", m.Cloud.Name()).
-			Errorf("unknown cloud: %#v", m.Cloud)
+			Wrapf(err, "failed to get the cloud credentials")
+	}
+
+	for key := range credentials {
+		if err := os.Unsetenv(key); err != nil {
+			return oopsBuilder.
+				With("cloudName", m.Cloud.Name()).
+				Wrapf(err, "failed to unset environment variable %s", key)
+		}
 	}
 
 	return nil
@@ -0,0 +1,53 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/ed3899/kumo/utils/hcl"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// Checks the generated vars file against the variables declared by the tool configuration in the run dir.
+// Returns a description for every required variable that is not assigned and for every assigned
+// variable that is not declared. Variables with a non null default are not required, the configurations declare
+// some that kumo leaves to their default on purpose, i.e the *_INTERNAL packer directories, or sets on the command
+// line, i.e INSTANCE_STATE for kumo stop and kumo start.
+func (m *Manager) ValidateVars() ([]string, error) {
+	oopsBuilder := oops.
+		In("manager").
+		Tags("Manager").
+		Code("ValidateVars")
+
+	declared, err := hcl.GetDeclaredVariables(m.Path.Dir.Run, m.Tool.ConfigExtension())
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get declared variables")
+	}
+
+	assigned, err := hcl.GetAssignedVariables(m.Path.Vars)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get assigned variables")
+	}
+
+	problems := []string{}
+
+	for _, variable := range declared {
+		if variable.Required && !lo.Contains(assigned, variable.Name) {
+			problems = append(problems, fmt.Sprintf("variable %s is declared without a default but is not assigned", variable.Name))
+		}
+	}
+
+	declaredNames := lo.Map(declared, func(v *hcl.Variable, _ int) string {
+		return v.Name
+	})
+
+	for _, name := range assigned {
+		if !lo.Contains(declaredNames, name) {
+			problems = append(problems, fmt.Sprintf("variable %s is assigned but not declared", name))
+		}
+	}
+
+	return problems, nil
+}
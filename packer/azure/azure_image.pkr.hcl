@@ -0,0 +1,150 @@
+locals {
+  AZURE_SUBSCRIPTION_ID            = trimspace(var.AZURE_SUBSCRIPTION_ID)
+  AZURE_TENANT_ID                  = trimspace(var.AZURE_TENANT_ID)
+  AZURE_CLIENT_ID                  = trimspace(var.AZURE_CLIENT_ID)
+  AZURE_CLIENT_SECRET              = trimspace(var.AZURE_CLIENT_SECRET)
+  AZURE_LOCATION                   = trimspace(var.AZURE_LOCATION)
+  AZURE_VM_SIZE                    = trimspace(var.AZURE_VM_SIZE)
+  AZURE_IMAGE_NAME                 = trimspace(regex_replace(var.AZURE_IMAGE_NAME, "\\s+", "-"))
+  AZURE_IMAGE_RESOURCE_GROUP       = trimspace(var.AZURE_IMAGE_RESOURCE_GROUP)
+  AZURE_IMAGE_PUBLISHER            = trimspace(var.AZURE_IMAGE_PUBLISHER)
+  AZURE_IMAGE_OFFER                = trimspace(var.AZURE_IMAGE_OFFER)
+  AZURE_IMAGE_SKU                  = trimspace(var.AZURE_IMAGE_SKU)
+  AZURE_SSH_USERNAME               = trimspace(var.AZURE_SSH_USERNAME)
+  AZURE_INSTANCE_USERNAME          = lower(trimspace(regex_replace(var.AZURE_INSTANCE_USERNAME, "\\s+", "-")))
+  AZURE_INSTANCE_USERNAME_PASSWORD = trimspace(var.AZURE_INSTANCE_USERNAME_PASSWORD)
+  AZURE_INSTANCE_USERNAME_HOME     = trimspace(var.AZURE_INSTANCE_USERNAME_HOME)
+
+  AZURE_ANSIBLE_STAGING_DIRECTORY_INTERNAL = trimspace(var.AZURE_ANSIBLE_STAGING_DIRECTORY_INTERNAL)
+  AZURE_PUBLIC_DIRECTORY_INTERNAL          = trimspace(var.AZURE_PUBLIC_DIRECTORY_INTERNAL)
+
+  GIT_USERNAME = lower(trimspace(regex_replace(var.GIT_USERNAME, "\\s+", "-")))
+  GIT_EMAIL    = trimspace(var.GIT_EMAIL)
+
+  ANSIBLE_TAGS                          = join(",", distinct(var.ANSIBLE_TAGS))
+  GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC = trimspace(var.GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC)
+
+  # Managed image names are unique within a resource group.
+  timestamp = formatdate("YYYYMMDDhhmmss", timestamp())
+}
+
+packer {
+  required_plugins {
+    azure = {
+      version = ">= 2.0.0"
+      source  = "github.com/hashicorp/azure"
+    }
+  }
+  required_version = ">= 1.2.0, < 2.0.0"
+}
+
+# The image resource group must exist beforehand, Packer builds in a temporary resource group of its own.
+source "azure-arm" "ubuntu" {
+  subscription_id = local.AZURE_SUBSCRIPTION_ID
+  tenant_id       = local.AZURE_TENANT_ID
+  client_id       = local.AZURE_CLIENT_ID
+  client_secret   = local.AZURE_CLIENT_SECRET
+
+  location = local.AZURE_LOCATION
+  vm_size  = local.AZURE_VM_SIZE
+
+  os_type         = "Linux"
+  image_publisher = local.AZURE_IMAGE_PUBLISHER
+  image_offer     = local.AZURE_IMAGE_OFFER
+  image_sku       = local.AZURE_IMAGE_SKU
+  ssh_username    = local.AZURE_SSH_USERNAME
+
+  managed_image_name                = "${local.AZURE_IMAGE_NAME}-${local.timestamp}"
+  managed_image_resource_group_name = local.AZURE_IMAGE_RESOURCE_GROUP
+
+  azure_tags = {
+    environment     = "development"
+    builder         = "packer"
+    tools_installed = local.ANSIBLE_TAGS
+    image_user      = local.AZURE_INSTANCE_USERNAME
+  }
+}
+
+build {
+  name = local.AZURE_IMAGE_NAME
+
+  sources = [
+    "source.azure-arm.ubuntu"
+  ]
+
+  # The scripts and playbooks are shared with the aws build, hence the AWS_EC2_ names.
+  provisioner "shell" {
+    env = {
+      AWS_EC2_PUBLIC_DIRECTORY_INTERNAL : local.AZURE_PUBLIC_DIRECTORY_INTERNAL,
+      AWS_EC2_SSH_USERNAME : local.AZURE_SSH_USERNAME,
+    }
+    scripts = [
+      "../scripts/create_public_directory.sh",
+      "../scripts/update_and_upgrade.sh",
+      "../scripts/install_ansible.sh"
+    ]
+  }
+
+  provisioner "ansible-local" {
+    playbook_dir            = "../ansible"
+    staging_directory       = local.AZURE_ANSIBLE_STAGING_DIRECTORY_INTERNAL
+    clean_staging_directory = true
+    playbook_file           = "../ansible/playbooks/main.yml"
+    extra_arguments = [
+      "--tags",
+      "${local.ANSIBLE_TAGS}",
+      "--extra-vars",
+      "AWS_EC2_ANSIBLE_STAGING_DIRECTORY_INTERNAL=${local.AZURE_ANSIBLE_STAGING_DIRECTORY_INTERNAL}",
+      "--extra-vars",
+      "AWS_EC2_PUBLIC_DIRECTORY_INTERNAL=${local.AZURE_PUBLIC_DIRECTORY_INTERNAL}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME=${local.AZURE_INSTANCE_USERNAME}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME_HOME=${local.AZURE_INSTANCE_USERNAME_HOME}",
+      "--extra-vars",
+      "AWS_EC2_SSH_USERNAME=${local.AZURE_SSH_USERNAME}",
+      "--extra-vars",
+      "GIT_USERNAME=${local.GIT_USERNAME}",
+      "--extra-vars",
+      "GIT_EMAIL=${local.GIT_EMAIL}",
+      "--extra-vars",
+      "AWS_ACCESS_KEY=",
+      "--extra-vars",
+      "AWS_SECRET_KEY=",
+      "--extra-vars",
+      "AWS_REGION=",
+      "--extra-vars",
+      "GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC=${local.GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME_PASSWORD=${local.AZURE_INSTANCE_USERNAME_PASSWORD}"
+    ]
+  }
+
+  provisioner "shell" {
+    env = {
+      AWS_EC2_PUBLIC_DIRECTORY_INTERNAL : local.AZURE_PUBLIC_DIRECTORY_INTERNAL,
+    }
+    scripts = ["../scripts/remove_public_directory.sh"]
+  }
+
+  # Generalize the VM so the image can be deployed, removing the provisioning user but not the instance one.
+  provisioner "shell" {
+    execute_command = "chmod +x {{ .Path }}; {{ .Vars }} sudo -E sh '{{ .Path }}'"
+    inline = [
+      "/usr/sbin/waagent -force -deprovision+user && export HISTSIZE=0 && sync"
+    ]
+    inline_shebang = "/bin/sh -x"
+  }
+
+  post-processor "manifest" {
+    output     = "manifest.json"
+    strip_path = true
+    custom_data = {
+      Environment   = "development"
+      Builder       = "packer"
+      BuildLocation = local.AZURE_LOCATION
+      Image_Name    = local.AZURE_IMAGE_NAME
+      ResourceGroup = local.AZURE_IMAGE_RESOURCE_GROUP
+    }
+  }
+}
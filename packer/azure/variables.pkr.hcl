@@ -0,0 +1,238 @@
+variable "AZURE_SUBSCRIPTION_ID" {
+  type        = string
+  default     = null
+  description = "The Azure subscription the image is built and stored in."
+
+  validation {
+    condition     = can(regex("^[0-9a-fA-F]{8}-([0-9a-fA-F]{4}-){3}[0-9a-fA-F]{12}$", var.AZURE_SUBSCRIPTION_ID))
+    error_message = "The subscription id must be a GUID."
+  }
+}
+
+variable "AZURE_TENANT_ID" {
+  type        = string
+  default     = null
+  description = "The Microsoft Entra tenant of the service principal."
+
+  validation {
+    condition     = can(regex("^[0-9a-fA-F]{8}-([0-9a-fA-F]{4}-){3}[0-9a-fA-F]{12}$", var.AZURE_TENANT_ID))
+    error_message = "The tenant id must be a GUID."
+  }
+}
+
+variable "AZURE_CLIENT_ID" {
+  type        = string
+  default     = null
+  description = "The application (client) id of the service principal."
+
+  validation {
+    condition     = can(regex("^[0-9a-fA-F]{8}-([0-9a-fA-F]{4}-){3}[0-9a-fA-F]{12}$", var.AZURE_CLIENT_ID))
+    error_message = "The client id must be a GUID."
+  }
+}
+
+variable "AZURE_CLIENT_SECRET" {
+  type        = string
+  default     = null
+  description = "The client secret of the service principal."
+  sensitive   = true
+
+  validation {
+    condition     = length(var.AZURE_CLIENT_SECRET) > 0
+    error_message = "The client secret must not be empty."
+  }
+}
+
+variable "AZURE_LOCATION" {
+  type        = string
+  default     = "eastus"
+  description = "The location the temporary VM is built in and the image is stored in."
+
+  validation {
+    condition     = can(regex("^[a-z0-9]+$", var.AZURE_LOCATION))
+    error_message = "Please provide a valid location (i.e 'eastus')."
+  }
+}
+
+variable "AZURE_VM_SIZE" {
+  type        = string
+  default     = "Standard_B1s"
+  description = "The size of the temporary VM Packer will create."
+
+  validation {
+    condition     = length(var.AZURE_VM_SIZE) > 0
+    error_message = "Please provide a valid VM size (i.e 'Standard_B1s')."
+  }
+}
+
+variable "AZURE_IMAGE_NAME" {
+  type        = string
+  default     = "kumo"
+  description = "The prefix of the managed image name, each image is named after it followed by a timestamp."
+
+  validation {
+    condition     = length(var.AZURE_IMAGE_NAME) > 0 && length(var.AZURE_IMAGE_NAME) < 66
+    error_message = "The image name must be between 1 and 65 characters long."
+  }
+}
+
+variable "AZURE_IMAGE_RESOURCE_GROUP" {
+  type        = string
+  default     = "kumo-images"
+  description = "The existing resource group the managed images are stored in."
+
+  validation {
+    condition     = length(var.AZURE_IMAGE_RESOURCE_GROUP) > 0
+    error_message = "The image resource group must not be empty."
+  }
+}
+
+variable "AZURE_IMAGE_PUBLISHER" {
+  type        = string
+  default     = "Canonical"
+  description = "The publisher of the marketplace image the image is built on top of."
+
+  validation {
+    condition     = length(var.AZURE_IMAGE_PUBLISHER) > 0
+    error_message = "The image publisher must not be empty."
+  }
+}
+
+variable "AZURE_IMAGE_OFFER" {
+  type        = string
+  default     = "0001-com-ubuntu-server-jammy"
+  description = "The offer of the marketplace image the image is built on top of."
+
+  validation {
+    condition     = length(var.AZURE_IMAGE_OFFER) > 0
+    error_message = "The image offer must not be empty."
+  }
+}
+
+variable "AZURE_IMAGE_SKU" {
+  type        = string
+  default     = "22_04-lts-gen2"
+  description = "The SKU of the marketplace image the image is built on top of."
+
+  validation {
+    condition     = length(var.AZURE_IMAGE_SKU) > 0
+    error_message = "The image SKU must not be empty."
+  }
+}
+
+variable "AZURE_SSH_USERNAME" {
+  type        = string
+  default     = null
+  description = "The SSH username used to initially log into the machine and provision it. It's removed when the VM is generalized."
+
+  validation {
+    condition     = length(var.AZURE_SSH_USERNAME) > 0
+    error_message = "The SSH username must not be empty."
+  }
+}
+
+variable "AZURE_INSTANCE_USERNAME" {
+  type        = string
+  default     = "dev"
+  description = "The username for the instance you will use to ssh into the machine."
+
+  validation {
+    condition     = length(var.AZURE_INSTANCE_USERNAME) > 0
+    error_message = "Please provide a username for the instance."
+  }
+
+  validation {
+    condition     = length(regexall("^[a-zA-Z_][a-zA-Z0-9_]+$", var.AZURE_INSTANCE_USERNAME)) > 0
+    error_message = "The username must contain only alphanumeric characters and '_' (underscore)."
+  }
+}
+
+variable "AZURE_INSTANCE_USERNAME_PASSWORD" {
+  type        = string
+  default     = "test123"
+  description = "The password for the instance user."
+  sensitive   = true
+
+  validation {
+    condition     = length(var.AZURE_INSTANCE_USERNAME_PASSWORD) >= 8 && length(var.AZURE_INSTANCE_USERNAME_PASSWORD) <= 20
+    error_message = "The password must be between 8 and 20 characters long without spaces around."
+  }
+}
+
+variable "AZURE_INSTANCE_USERNAME_HOME" {
+  type        = string
+  default     = "home"
+  description = "The home directory of the instance user"
+
+  validation {
+    condition     = length(var.AZURE_INSTANCE_USERNAME_HOME) > 0
+    error_message = "The home directory cannot be an empty string."
+  }
+
+  validation {
+    condition     = can(regex("^([a-zA-Z0-9]+)$", var.AZURE_INSTANCE_USERNAME_HOME))
+    error_message = "The home directory can only contain alphanumeric characters."
+  }
+}
+
+variable "AZURE_ANSIBLE_STAGING_DIRECTORY_INTERNAL" {
+  type        = string
+  default     = "/tmp/ansible"
+  description = "The directory where ansible files will be uploaded. Packer requires write permissions in this directory."
+
+  validation {
+    condition     = can(regex("^/.*", var.AZURE_ANSIBLE_STAGING_DIRECTORY_INTERNAL))
+    error_message = "The ansible staging directory must contain an absolute path starting with '/'."
+  }
+}
+
+variable "AZURE_PUBLIC_DIRECTORY_INTERNAL" {
+  type        = string
+  default     = "/public"
+  description = "The directory where temporary tools are downloaded"
+
+  validation {
+    condition     = substr(var.AZURE_PUBLIC_DIRECTORY_INTERNAL, 0, 1) == "/"
+    error_message = "The public directory must start with a forward slash (/)."
+  }
+}
+
+variable "GIT_USERNAME" {
+  type        = string
+  default     = null
+  description = "The git username that will be associated with your commits."
+
+  validation {
+    condition     = length(var.GIT_USERNAME) <= 20
+    error_message = "Git username must be less than or equal to 20 characters long."
+  }
+}
+
+variable "GIT_EMAIL" {
+  type        = string
+  default     = null
+  description = "The git email that will be associated with your commits."
+
+  validation {
+    condition     = can(regex("^\\S+@\\S+\\.\\S+$", var.GIT_EMAIL))
+    error_message = "Git email must be a valid email address."
+  }
+}
+
+variable "ANSIBLE_TAGS" {
+  type        = list(string)
+  default     = null
+  description = "The ansible tags that will be used to install playbooks."
+
+  validation {
+    condition     = length(var.ANSIBLE_TAGS) > 0
+    error_message = "The variable must contain at least one tag."
+  }
+}
+
+variable "GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC" {
+  type        = string
+  default     = null
+  sensitive   = true
+  description = "The github personal access token use to setup ssh access on your instance"
+}
\ No newline at end of file
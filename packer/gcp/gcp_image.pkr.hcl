@@ -0,0 +1,130 @@
+locals {
+  GCP_PROJECT_ID                 = trimspace(var.GCP_PROJECT_ID)
+  GCP_ZONE                       = trimspace(var.GCP_ZONE)
+  GCP_MACHINE_TYPE               = trimspace(var.GCP_MACHINE_TYPE)
+  GCP_IMAGE_NAME                 = lower(trimspace(regex_replace(var.GCP_IMAGE_NAME, "\\s+", "-")))
+  GCP_SOURCE_IMAGE_FAMILY        = trimspace(var.GCP_SOURCE_IMAGE_FAMILY)
+  GCP_SOURCE_IMAGE_PROJECT_ID    = trimspace(var.GCP_SOURCE_IMAGE_PROJECT_ID)
+  GCP_SSH_USERNAME               = trimspace(var.GCP_SSH_USERNAME)
+  GCP_INSTANCE_USERNAME          = lower(trimspace(regex_replace(var.GCP_INSTANCE_USERNAME, "\\s+", "-")))
+  GCP_INSTANCE_USERNAME_PASSWORD = trimspace(var.GCP_INSTANCE_USERNAME_PASSWORD)
+  GCP_INSTANCE_USERNAME_HOME     = trimspace(var.GCP_INSTANCE_USERNAME_HOME)
+
+  GCP_ANSIBLE_STAGING_DIRECTORY_INTERNAL = trimspace(var.GCP_ANSIBLE_STAGING_DIRECTORY_INTERNAL)
+  GCP_PUBLIC_DIRECTORY_INTERNAL          = trimspace(var.GCP_PUBLIC_DIRECTORY_INTERNAL)
+
+  GIT_USERNAME = lower(trimspace(regex_replace(var.GIT_USERNAME, "\\s+", "-")))
+  GIT_EMAIL    = trimspace(var.GIT_EMAIL)
+
+  ANSIBLE_TAGS                          = join(",", distinct(var.ANSIBLE_TAGS))
+  GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC = trimspace(var.GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC)
+
+  # Image names are unique within a project, the image family always points to the last one built.
+  timestamp = formatdate("YYYYMMDDhhmmss", timestamp())
+}
+
+packer {
+  required_plugins {
+    googlecompute = {
+      version = ">= 1.1.1"
+      source  = "github.com/hashicorp/googlecompute"
+    }
+  }
+  required_version = ">= 1.2.0, < 2.0.0"
+}
+
+# The service account key is read from GOOGLE_APPLICATION_CREDENTIALS, set by kumo.
+source "googlecompute" "ubuntu" {
+  project_id              = local.GCP_PROJECT_ID
+  zone                    = local.GCP_ZONE
+  machine_type            = local.GCP_MACHINE_TYPE
+  source_image_family     = local.GCP_SOURCE_IMAGE_FAMILY
+  source_image_project_id = [local.GCP_SOURCE_IMAGE_PROJECT_ID]
+  ssh_username            = local.GCP_SSH_USERNAME
+
+  image_name        = "${local.GCP_IMAGE_NAME}-${local.timestamp}"
+  image_family      = local.GCP_IMAGE_NAME
+  image_description = "Built by kumo with ${local.ANSIBLE_TAGS}"
+
+  image_labels = {
+    environment     = "development"
+    builder         = "packer"
+    tools_installed = lower(replace(local.ANSIBLE_TAGS, ",", "_"))
+    image_user      = local.GCP_INSTANCE_USERNAME
+  }
+}
+
+build {
+  name = local.GCP_IMAGE_NAME
+
+  sources = [
+    "source.googlecompute.ubuntu"
+  ]
+
+  # The scripts and playbooks are shared with the aws build, hence the AWS_EC2_ names.
+  provisioner "shell" {
+    env = {
+      AWS_EC2_PUBLIC_DIRECTORY_INTERNAL : local.GCP_PUBLIC_DIRECTORY_INTERNAL,
+      AWS_EC2_SSH_USERNAME : local.GCP_SSH_USERNAME,
+    }
+    scripts = [
+      "../scripts/create_public_directory.sh",
+      "../scripts/update_and_upgrade.sh",
+      "../scripts/install_ansible.sh"
+    ]
+  }
+
+  provisioner "ansible-local" {
+    playbook_dir            = "../ansible"
+    staging_directory       = local.GCP_ANSIBLE_STAGING_DIRECTORY_INTERNAL
+    clean_staging_directory = true
+    playbook_file           = "../ansible/playbooks/main.yml"
+    extra_arguments = [
+      "--tags",
+      "${local.ANSIBLE_TAGS}",
+      "--extra-vars",
+      "AWS_EC2_ANSIBLE_STAGING_DIRECTORY_INTERNAL=${local.GCP_ANSIBLE_STAGING_DIRECTORY_INTERNAL}",
+      "--extra-vars",
+      "AWS_EC2_PUBLIC_DIRECTORY_INTERNAL=${local.GCP_PUBLIC_DIRECTORY_INTERNAL}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME=${local.GCP_INSTANCE_USERNAME}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME_HOME=${local.GCP_INSTANCE_USERNAME_HOME}",
+      "--extra-vars",
+      "AWS_EC2_SSH_USERNAME=${local.GCP_SSH_USERNAME}",
+      "--extra-vars",
+      "GIT_USERNAME=${local.GIT_USERNAME}",
+      "--extra-vars",
+      "GIT_EMAIL=${local.GIT_EMAIL}",
+      "--extra-vars",
+      "AWS_ACCESS_KEY=",
+      "--extra-vars",
+      "AWS_SECRET_KEY=",
+      "--extra-vars",
+      "AWS_REGION=",
+      "--extra-vars",
+      "GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC=${local.GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME_PASSWORD=${local.GCP_INSTANCE_USERNAME_PASSWORD}"
+    ]
+  }
+
+  provisioner "shell" {
+    env = {
+      AWS_EC2_PUBLIC_DIRECTORY_INTERNAL : local.GCP_PUBLIC_DIRECTORY_INTERNAL,
+    }
+    scripts = ["../scripts/remove_public_directory.sh"]
+  }
+
+  post-processor "manifest" {
+    output     = "manifest.json"
+    strip_path = true
+    custom_data = {
+      Environment  = "development"
+      Builder      = "packer"
+      BuildZone    = local.GCP_ZONE
+      Image_Family = local.GCP_IMAGE_NAME
+      Project      = local.GCP_PROJECT_ID
+    }
+  }
+}
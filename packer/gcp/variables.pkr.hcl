@@ -0,0 +1,182 @@
+variable "GCP_PROJECT_ID" {
+  type        = string
+  default     = null
+  description = "The Google Cloud project the image is built and stored in."
+
+  validation {
+    condition     = can(regex("^[a-z][a-z0-9-]{4,28}[a-z0-9]$", var.GCP_PROJECT_ID))
+    error_message = "The project id must be 6 to 30 lowercase letters, digits or hyphens, starting with a letter."
+  }
+}
+
+variable "GCP_ZONE" {
+  type        = string
+  default     = "us-central1-a"
+  description = "The zone of the temporary instance Packer builds the image on."
+
+  validation {
+    condition     = can(regex("^[a-z]+-[a-z]+[0-9]+-[a-z]$", var.GCP_ZONE))
+    error_message = "Please provide a valid zone (i.e 'us-central1-a')."
+  }
+}
+
+variable "GCP_MACHINE_TYPE" {
+  type        = string
+  default     = "e2-micro"
+  description = "The machine type of the temporary instance Packer will create."
+
+  validation {
+    condition     = var.GCP_MACHINE_TYPE != null
+    error_message = "Please provide a valid machine type (i.e 'e2-micro')."
+  }
+}
+
+variable "GCP_IMAGE_NAME" {
+  type        = string
+  default     = "kumo"
+  description = "The name of the image family, each image is named after it followed by a timestamp."
+
+  validation {
+    condition     = length(var.GCP_IMAGE_NAME) > 0 && length(var.GCP_IMAGE_NAME) < 49
+    error_message = "The image name must be between 1 and 48 characters long."
+  }
+}
+
+variable "GCP_SOURCE_IMAGE_FAMILY" {
+  type        = string
+  default     = "ubuntu-2204-lts"
+  description = "The image family the image is built on top of (i.e ubuntu-2204-lts)."
+
+  validation {
+    condition     = length(var.GCP_SOURCE_IMAGE_FAMILY) > 0
+    error_message = "The source image family must not be empty."
+  }
+}
+
+variable "GCP_SOURCE_IMAGE_PROJECT_ID" {
+  type        = string
+  default     = "ubuntu-os-cloud"
+  description = "The project hosting the source image family (i.e ubuntu-os-cloud)."
+
+  validation {
+    condition     = length(var.GCP_SOURCE_IMAGE_PROJECT_ID) > 0
+    error_message = "The source image project must not be empty."
+  }
+}
+
+variable "GCP_SSH_USERNAME" {
+  type        = string
+  default     = null
+  description = "The SSH username used to initially log into the machine and provision it. Compute Engine creates it from the key Packer adds to the instance metadata."
+
+  validation {
+    condition     = length(var.GCP_SSH_USERNAME) > 0
+    error_message = "The SSH username must not be empty."
+  }
+}
+
+variable "GCP_INSTANCE_USERNAME" {
+  type        = string
+  default     = "dev"
+  description = "The username for the instance you will use to ssh into the machine."
+
+  validation {
+    condition     = length(var.GCP_INSTANCE_USERNAME) > 0
+    error_message = "Please provide a username for the instance."
+  }
+
+  validation {
+    condition     = length(regexall("^[a-zA-Z_][a-zA-Z0-9_]+$", var.GCP_INSTANCE_USERNAME)) > 0
+    error_message = "The username must contain only alphanumeric characters and '_' (underscore)."
+  }
+}
+
+variable "GCP_INSTANCE_USERNAME_PASSWORD" {
+  type        = string
+  default     = "test123"
+  description = "The password for the instance user."
+  sensitive   = true
+
+  validation {
+    condition     = length(var.GCP_INSTANCE_USERNAME_PASSWORD) >= 8 && length(var.GCP_INSTANCE_USERNAME_PASSWORD) <= 20
+    error_message = "The password must be between 8 and 20 characters long without spaces around."
+  }
+}
+
+variable "GCP_INSTANCE_USERNAME_HOME" {
+  type        = string
+  default     = "home"
+  description = "The home directory of the instance user"
+
+  validation {
+    condition     = length(var.GCP_INSTANCE_USERNAME_HOME) > 0
+    error_message = "The home directory cannot be an empty string."
+  }
+
+  validation {
+    condition     = can(regex("^([a-zA-Z0-9]+)$", var.GCP_INSTANCE_USERNAME_HOME))
+    error_message = "The home directory can only contain alphanumeric characters."
+  }
+}
+
+variable "GCP_ANSIBLE_STAGING_DIRECTORY_INTERNAL" {
+  type        = string
+  default     = "/tmp/ansible"
+  description = "The directory where ansible files will be uploaded. Packer requires write permissions in this directory."
+
+  validation {
+    condition     = can(regex("^/.*", var.GCP_ANSIBLE_STAGING_DIRECTORY_INTERNAL))
+    error_message = "The ansible staging directory must contain an absolute path starting with '/'."
+  }
+}
+
+variable "GCP_PUBLIC_DIRECTORY_INTERNAL" {
+  type        = string
+  default     = "/public"
+  description = "The directory where temporary tools are downloaded"
+
+  validation {
+    condition     = substr(var.GCP_PUBLIC_DIRECTORY_INTERNAL, 0, 1) == "/"
+    error_message = "The public directory must start with a forward slash (/)."
+  }
+}
+
+variable "GIT_USERNAME" {
+  type        = string
+  default     = null
+  description = "The git username that will be associated with your commits."
+
+  validation {
+    condition     = length(var.GIT_USERNAME) <= 20
+    error_message = "Git username must be less than or equal to 20 characters long."
+  }
+}
+
+variable "GIT_EMAIL" {
+  type        = string
+  default     = null
+  description = "The git email that will be associated with your commits."
+
+  validation {
+    condition     = can(regex("^\\S+@\\S+\\.\\S+$", var.GIT_EMAIL))
+    error_message = "Git email must be a valid email address."
+  }
+}
+
+variable "ANSIBLE_TAGS" {
+  type        = list(string)
+  default     = null
+  description = "The ansible tags that will be used to install playbooks."
+
+  validation {
+    condition     = length(var.ANSIBLE_TAGS) > 0
+    error_message = "The variable must contain at least one tag."
+  }
+}
+
+variable "GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC" {
+  type        = string
+  default     = null
+  sensitive   = true
+  description = "The github personal access token use to setup ssh access on your instance"
+}
\ No newline at end of file
@@ -0,0 +1,125 @@
+locals {
+  HCLOUD_TOKEN                       = trimspace(var.HCLOUD_TOKEN)
+  HETZNER_LOCATION                   = trimspace(var.HETZNER_LOCATION)
+  HETZNER_SERVER_TYPE                = trimspace(var.HETZNER_SERVER_TYPE)
+  HETZNER_BASE_IMAGE                 = trimspace(var.HETZNER_BASE_IMAGE)
+  HETZNER_SNAPSHOT_NAME              = lower(trimspace(regex_replace(var.HETZNER_SNAPSHOT_NAME, "\\s+", "-")))
+  HETZNER_SSH_USERNAME               = trimspace(var.HETZNER_SSH_USERNAME)
+  HETZNER_INSTANCE_USERNAME          = lower(trimspace(regex_replace(var.HETZNER_INSTANCE_USERNAME, "\\s+", "-")))
+  HETZNER_INSTANCE_USERNAME_PASSWORD = trimspace(var.HETZNER_INSTANCE_USERNAME_PASSWORD)
+  HETZNER_INSTANCE_USERNAME_HOME     = trimspace(var.HETZNER_INSTANCE_USERNAME_HOME)
+
+  HETZNER_ANSIBLE_STAGING_DIRECTORY_INTERNAL = trimspace(var.HETZNER_ANSIBLE_STAGING_DIRECTORY_INTERNAL)
+  HETZNER_PUBLIC_DIRECTORY_INTERNAL          = trimspace(var.HETZNER_PUBLIC_DIRECTORY_INTERNAL)
+
+  GIT_USERNAME = lower(trimspace(regex_replace(var.GIT_USERNAME, "\\s+", "-")))
+  GIT_EMAIL    = trimspace(var.GIT_EMAIL)
+
+  ANSIBLE_TAGS                          = join(",", distinct(var.ANSIBLE_TAGS))
+  GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC = trimspace(var.GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC)
+
+  # Snapshot names aren't unique, the kumo-image label groups the snapshots of an image.
+  timestamp = formatdate("YYYYMMDDhhmmss", timestamp())
+}
+
+packer {
+  required_plugins {
+    hcloud = {
+      version = ">= 1.2.0"
+      source  = "github.com/hetznercloud/hcloud"
+    }
+  }
+  required_version = ">= 1.2.0, < 2.0.0"
+}
+
+source "hcloud" "ubuntu" {
+  token        = local.HCLOUD_TOKEN
+  location     = local.HETZNER_LOCATION
+  server_type  = local.HETZNER_SERVER_TYPE
+  image        = local.HETZNER_BASE_IMAGE
+  ssh_username = local.HETZNER_SSH_USERNAME
+
+  snapshot_name = "${local.HETZNER_SNAPSHOT_NAME}-${local.timestamp}"
+
+  snapshot_labels = {
+    environment     = "development"
+    builder         = "packer"
+    kumo-image      = local.HETZNER_SNAPSHOT_NAME
+    tools_installed = lower(replace(local.ANSIBLE_TAGS, ",", "_"))
+    image_user      = local.HETZNER_INSTANCE_USERNAME
+  }
+}
+
+build {
+  name = local.HETZNER_SNAPSHOT_NAME
+
+  sources = [
+    "source.hcloud.ubuntu"
+  ]
+
+  # The scripts and playbooks are shared with the aws build, hence the AWS_EC2_ names.
+  provisioner "shell" {
+    env = {
+      AWS_EC2_PUBLIC_DIRECTORY_INTERNAL : local.HETZNER_PUBLIC_DIRECTORY_INTERNAL,
+      AWS_EC2_SSH_USERNAME : local.HETZNER_SSH_USERNAME,
+    }
+    scripts = [
+      "../scripts/create_public_directory.sh",
+      "../scripts/update_and_upgrade.sh",
+      "../scripts/install_ansible.sh"
+    ]
+  }
+
+  provisioner "ansible-local" {
+    playbook_dir            = "../ansible"
+    staging_directory       = local.HETZNER_ANSIBLE_STAGING_DIRECTORY_INTERNAL
+    clean_staging_directory = true
+    playbook_file           = "../ansible/playbooks/main.yml"
+    extra_arguments = [
+      "--tags",
+      "${local.ANSIBLE_TAGS}",
+      "--extra-vars",
+      "AWS_EC2_ANSIBLE_STAGING_DIRECTORY_INTERNAL=${local.HETZNER_ANSIBLE_STAGING_DIRECTORY_INTERNAL}",
+      "--extra-vars",
+      "AWS_EC2_PUBLIC_DIRECTORY_INTERNAL=${local.HETZNER_PUBLIC_DIRECTORY_INTERNAL}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME=${local.HETZNER_INSTANCE_USERNAME}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME_HOME=${local.HETZNER_INSTANCE_USERNAME_HOME}",
+      "--extra-vars",
+      "AWS_EC2_SSH_USERNAME=${local.HETZNER_SSH_USERNAME}",
+      "--extra-vars",
+      "GIT_USERNAME=${local.GIT_USERNAME}",
+      "--extra-vars",
+      "GIT_EMAIL=${local.GIT_EMAIL}",
+      "--extra-vars",
+      "AWS_ACCESS_KEY=",
+      "--extra-vars",
+      "AWS_SECRET_KEY=",
+      "--extra-vars",
+      "AWS_REGION=",
+      "--extra-vars",
+      "GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC=${local.GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME_PASSWORD=${local.HETZNER_INSTANCE_USERNAME_PASSWORD}"
+    ]
+  }
+
+  provisioner "shell" {
+    env = {
+      AWS_EC2_PUBLIC_DIRECTORY_INTERNAL : local.HETZNER_PUBLIC_DIRECTORY_INTERNAL,
+    }
+    scripts = ["../scripts/remove_public_directory.sh"]
+  }
+
+  post-processor "manifest" {
+    output     = "manifest.json"
+    strip_path = true
+    custom_data = {
+      Environment = "development"
+      Builder     = "packer"
+      Location    = local.HETZNER_LOCATION
+      Snapshot    = local.HETZNER_SNAPSHOT_NAME
+    }
+  }
+}
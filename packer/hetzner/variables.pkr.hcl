@@ -0,0 +1,172 @@
+variable "HCLOUD_TOKEN" {
+  type        = string
+  default     = null
+  sensitive   = true
+  description = "The Hetzner Cloud API token of the project the snapshot is stored in."
+
+  validation {
+    condition     = can(regex("^[a-zA-Z0-9]{64}$", var.HCLOUD_TOKEN))
+    error_message = "The API token must be 64 alphanumeric characters."
+  }
+}
+
+variable "HETZNER_LOCATION" {
+  type        = string
+  default     = "fsn1"
+  description = "The location of the temporary server Packer builds the snapshot on."
+
+  validation {
+    condition     = can(regex("^[a-z]+[0-9]*$", var.HETZNER_LOCATION))
+    error_message = "Please provide a valid location (i.e 'fsn1')."
+  }
+}
+
+variable "HETZNER_SERVER_TYPE" {
+  type        = string
+  default     = "cx22"
+  description = "The server type of the temporary server Packer will create."
+
+  validation {
+    condition     = var.HETZNER_SERVER_TYPE != null
+    error_message = "Please provide a valid server type (i.e 'cx22')."
+  }
+}
+
+variable "HETZNER_BASE_IMAGE" {
+  type        = string
+  default     = "ubuntu-22.04"
+  description = "The image the snapshot is built on top of (i.e ubuntu-22.04)."
+
+  validation {
+    condition     = length(var.HETZNER_BASE_IMAGE) > 0
+    error_message = "The base image must not be empty."
+  }
+}
+
+variable "HETZNER_SNAPSHOT_NAME" {
+  type        = string
+  default     = "kumo"
+  description = "The name of the snapshot, followed by a timestamp. It's also the value of its kumo-image label."
+
+  validation {
+    condition     = length(var.HETZNER_SNAPSHOT_NAME) > 0 && length(var.HETZNER_SNAPSHOT_NAME) < 49
+    error_message = "The snapshot name must be between 1 and 48 characters long."
+  }
+}
+
+variable "HETZNER_SSH_USERNAME" {
+  type        = string
+  default     = "root"
+  description = "The SSH username used to initially log into the machine and provision it. Hetzner Cloud images only have root."
+
+  validation {
+    condition     = length(var.HETZNER_SSH_USERNAME) > 0
+    error_message = "The SSH username must not be empty."
+  }
+}
+
+variable "HETZNER_INSTANCE_USERNAME" {
+  type        = string
+  default     = "dev"
+  description = "The username for the instance you will use to ssh into the machine."
+
+  validation {
+    condition     = length(var.HETZNER_INSTANCE_USERNAME) > 0
+    error_message = "Please provide a username for the instance."
+  }
+
+  validation {
+    condition     = length(regexall("^[a-zA-Z_][a-zA-Z0-9_]+$", var.HETZNER_INSTANCE_USERNAME)) > 0
+    error_message = "The username must contain only alphanumeric characters and '_' (underscore)."
+  }
+}
+
+variable "HETZNER_INSTANCE_USERNAME_PASSWORD" {
+  type        = string
+  default     = "test123"
+  description = "The password for the instance user."
+  sensitive   = true
+
+  validation {
+    condition     = length(var.HETZNER_INSTANCE_USERNAME_PASSWORD) >= 8 && length(var.HETZNER_INSTANCE_USERNAME_PASSWORD) <= 20
+    error_message = "The password must be between 8 and 20 characters long without spaces around."
+  }
+}
+
+variable "HETZNER_INSTANCE_USERNAME_HOME" {
+  type        = string
+  default     = "home"
+  description = "The home directory of the instance user"
+
+  validation {
+    condition     = length(var.HETZNER_INSTANCE_USERNAME_HOME) > 0
+    error_message = "The home directory cannot be an empty string."
+  }
+
+  validation {
+    condition     = can(regex("^([a-zA-Z0-9]+)$", var.HETZNER_INSTANCE_USERNAME_HOME))
+    error_message = "The home directory can only contain alphanumeric characters."
+  }
+}
+
+variable "HETZNER_ANSIBLE_STAGING_DIRECTORY_INTERNAL" {
+  type        = string
+  default     = "/tmp/ansible"
+  description = "The directory where ansible files will be uploaded. Packer requires write permissions in this directory."
+
+  validation {
+    condition     = can(regex("^/.*", var.HETZNER_ANSIBLE_STAGING_DIRECTORY_INTERNAL))
+    error_message = "The ansible staging directory must contain an absolute path starting with '/'."
+  }
+}
+
+variable "HETZNER_PUBLIC_DIRECTORY_INTERNAL" {
+  type        = string
+  default     = "/public"
+  description = "The directory where temporary tools are downloaded"
+
+  validation {
+    condition     = substr(var.HETZNER_PUBLIC_DIRECTORY_INTERNAL, 0, 1) == "/"
+    error_message = "The public directory must start with a forward slash (/)."
+  }
+}
+
+variable "GIT_USERNAME" {
+  type        = string
+  default     = null
+  description = "The git username that will be associated with your commits."
+
+  validation {
+    condition     = length(var.GIT_USERNAME) <= 20
+    error_message = "Git username must be less than or equal to 20 characters long."
+  }
+}
+
+variable "GIT_EMAIL" {
+  type        = string
+  default     = null
+  description = "The git email that will be associated with your commits."
+
+  validation {
+    condition     = can(regex("^\\S+@\\S+\\.\\S+$", var.GIT_EMAIL))
+    error_message = "Git email must be a valid email address."
+  }
+}
+
+variable "ANSIBLE_TAGS" {
+  type        = list(string)
+  default     = null
+  description = "The ansible tags that will be used to install playbooks."
+
+  validation {
+    condition     = length(var.ANSIBLE_TAGS) > 0
+    error_message = "The variable must contain at least one tag."
+  }
+}
+
+variable "GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC" {
+  type        = string
+  default     = null
+  sensitive   = true
+  description = "The github personal access token use to setup ssh access on your instance"
+}
\ No newline at end of file
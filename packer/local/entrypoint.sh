@@ -0,0 +1,17 @@
+#!/bin/bash
+# Installed by kumo as the entrypoint of the image. Authorizes KUMO_AUTHORIZED_KEY for KUMO_USER, both set by
+# terraform/local, and runs the ssh server in the foreground.
+set -e
+
+if [ -n "$KUMO_USER" ] && [ -n "$KUMO_AUTHORIZED_KEY" ]; then
+  home=$(getent passwd "$KUMO_USER" | cut -d: -f6)
+  mkdir -p "$home/.ssh"
+  echo "$KUMO_AUTHORIZED_KEY" > "$home/.ssh/authorized_keys"
+  chown -R "$KUMO_USER":"$KUMO_USER" "$home/.ssh"
+  chmod 700 "$home/.ssh"
+  chmod 600 "$home/.ssh/authorized_keys"
+fi
+
+ssh-keygen -A
+mkdir -p /run/sshd
+exec /usr/sbin/sshd -D -e
@@ -0,0 +1,144 @@
+locals {
+  LOCAL_BASE_IMAGE                 = trimspace(var.LOCAL_BASE_IMAGE)
+  LOCAL_IMAGE_NAME                 = lower(trimspace(regex_replace(var.LOCAL_IMAGE_NAME, "\\s+", "-")))
+  LOCAL_INSTANCE_USERNAME          = lower(trimspace(regex_replace(var.LOCAL_INSTANCE_USERNAME, "\\s+", "-")))
+  LOCAL_INSTANCE_USERNAME_PASSWORD = trimspace(var.LOCAL_INSTANCE_USERNAME_PASSWORD)
+  LOCAL_INSTANCE_USERNAME_HOME     = trimspace(var.LOCAL_INSTANCE_USERNAME_HOME)
+
+  LOCAL_ANSIBLE_STAGING_DIRECTORY_INTERNAL = trimspace(var.LOCAL_ANSIBLE_STAGING_DIRECTORY_INTERNAL)
+  LOCAL_PUBLIC_DIRECTORY_INTERNAL          = trimspace(var.LOCAL_PUBLIC_DIRECTORY_INTERNAL)
+
+  GIT_USERNAME = lower(trimspace(regex_replace(var.GIT_USERNAME, "\\s+", "-")))
+  GIT_EMAIL    = trimspace(var.GIT_EMAIL)
+
+  ANSIBLE_TAGS                          = join(",", distinct(var.ANSIBLE_TAGS))
+  GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC = trimspace(var.GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC)
+
+  # The provisioners run as root through docker exec.
+  LOCAL_SSH_USERNAME = "root"
+
+  # Each build is tagged with a timestamp, the manifest records the image ID.
+  timestamp = formatdate("YYYYMMDDhhmmss", timestamp())
+}
+
+packer {
+  required_plugins {
+    docker = {
+      version = ">= 1.0.8"
+      source  = "github.com/hashicorp/docker"
+    }
+  }
+  required_version = ">= 1.2.0, < 2.0.0"
+}
+
+# The docker engine is reached through DOCKER_HOST, like the docker CLI.
+source "docker" "ubuntu" {
+  image  = local.LOCAL_BASE_IMAGE
+  commit = true
+
+  # The container is deployed with the user and the public key to authorize, see terraform/local.
+  changes = [
+    "EXPOSE 22",
+    "ENTRYPOINT [\"/usr/local/bin/kumo-entrypoint\"]",
+    "LABEL builder=packer tools_installed=${local.ANSIBLE_TAGS} image_user=${local.LOCAL_INSTANCE_USERNAME}"
+  ]
+}
+
+build {
+  name = local.LOCAL_IMAGE_NAME
+
+  sources = [
+    "source.docker.ubuntu"
+  ]
+
+  # Base images come without sudo or an ssh server, both needed by the shared scripts and kumo.
+  provisioner "shell" {
+    inline = [
+      "apt-get update -qq",
+      "DEBIAN_FRONTEND=noninteractive apt-get install -y -qq sudo openssh-server ca-certificates curl",
+      "mkdir -p /run/sshd"
+    ]
+  }
+
+  # The scripts and playbooks are shared with the aws build, hence the AWS_EC2_ names.
+  provisioner "shell" {
+    env = {
+      AWS_EC2_PUBLIC_DIRECTORY_INTERNAL : local.LOCAL_PUBLIC_DIRECTORY_INTERNAL,
+      AWS_EC2_SSH_USERNAME : local.LOCAL_SSH_USERNAME,
+      DEBIAN_FRONTEND : "noninteractive",
+    }
+    scripts = [
+      "../scripts/create_public_directory.sh",
+      "../scripts/update_and_upgrade.sh",
+      "../scripts/install_ansible.sh"
+    ]
+  }
+
+  provisioner "ansible-local" {
+    playbook_dir            = "../ansible"
+    staging_directory       = local.LOCAL_ANSIBLE_STAGING_DIRECTORY_INTERNAL
+    clean_staging_directory = true
+    playbook_file           = "../ansible/playbooks/main.yml"
+    extra_arguments = [
+      "--tags",
+      "${local.ANSIBLE_TAGS}",
+      "--extra-vars",
+      "AWS_EC2_ANSIBLE_STAGING_DIRECTORY_INTERNAL=${local.LOCAL_ANSIBLE_STAGING_DIRECTORY_INTERNAL}",
+      "--extra-vars",
+      "AWS_EC2_PUBLIC_DIRECTORY_INTERNAL=${local.LOCAL_PUBLIC_DIRECTORY_INTERNAL}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME=${local.LOCAL_INSTANCE_USERNAME}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME_HOME=${local.LOCAL_INSTANCE_USERNAME_HOME}",
+      "--extra-vars",
+      "AWS_EC2_SSH_USERNAME=${local.LOCAL_SSH_USERNAME}",
+      "--extra-vars",
+      "GIT_USERNAME=${local.GIT_USERNAME}",
+      "--extra-vars",
+      "GIT_EMAIL=${local.GIT_EMAIL}",
+      "--extra-vars",
+      "AWS_ACCESS_KEY=",
+      "--extra-vars",
+      "AWS_SECRET_KEY=",
+      "--extra-vars",
+      "AWS_REGION=",
+      "--extra-vars",
+      "GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC=${local.GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC}",
+      "--extra-vars",
+      "AWS_EC2_INSTANCE_USERNAME_PASSWORD=${local.LOCAL_INSTANCE_USERNAME_PASSWORD}"
+    ]
+  }
+
+  provisioner "shell" {
+    env = {
+      AWS_EC2_PUBLIC_DIRECTORY_INTERNAL : local.LOCAL_PUBLIC_DIRECTORY_INTERNAL,
+    }
+    scripts = ["../scripts/remove_public_directory.sh"]
+  }
+
+  provisioner "file" {
+    source      = "entrypoint.sh"
+    destination = "/usr/local/bin/kumo-entrypoint"
+  }
+
+  provisioner "shell" {
+    inline = ["chmod 755 /usr/local/bin/kumo-entrypoint"]
+  }
+
+  post-processor "docker-tag" {
+    repository          = local.LOCAL_IMAGE_NAME
+    tags                = [local.timestamp]
+    keep_input_artifact = true
+  }
+
+  post-processor "manifest" {
+    output     = "manifest.json"
+    strip_path = true
+    custom_data = {
+      Environment = "development"
+      Builder     = "packer"
+      BaseImage   = local.LOCAL_BASE_IMAGE
+      Image_Name  = local.LOCAL_IMAGE_NAME
+    }
+  }
+}
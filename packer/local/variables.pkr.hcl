@@ -0,0 +1,127 @@
+variable "LOCAL_BASE_IMAGE" {
+  type        = string
+  default     = "ubuntu:22.04"
+  description = "The docker image the image is built on top of (i.e ubuntu:22.04). It must be Debian based."
+
+  validation {
+    condition     = length(var.LOCAL_BASE_IMAGE) > 0
+    error_message = "The base image must not be empty."
+  }
+}
+
+variable "LOCAL_IMAGE_NAME" {
+  type        = string
+  default     = "kumo"
+  description = "The repository the image is tagged in, each image is tagged with a timestamp."
+
+  validation {
+    condition     = can(regex("^[a-z0-9]+(?:[._-][a-z0-9]+)*$", var.LOCAL_IMAGE_NAME))
+    error_message = "The image name must be lowercase alphanumeric characters separated by '.', '_' or '-'."
+  }
+}
+
+variable "LOCAL_INSTANCE_USERNAME" {
+  type        = string
+  default     = "dev"
+  description = "The username for the instance you will use to ssh into the machine."
+
+  validation {
+    condition     = length(var.LOCAL_INSTANCE_USERNAME) > 0
+    error_message = "Please provide a username for the instance."
+  }
+
+  validation {
+    condition     = length(regexall("^[a-zA-Z_][a-zA-Z0-9_]+$", var.LOCAL_INSTANCE_USERNAME)) > 0
+    error_message = "The username must contain only alphanumeric characters and '_' (underscore)."
+  }
+}
+
+variable "LOCAL_INSTANCE_USERNAME_PASSWORD" {
+  type        = string
+  default     = "test123"
+  description = "The password for the instance user."
+  sensitive   = true
+
+  validation {
+    condition     = length(var.LOCAL_INSTANCE_USERNAME_PASSWORD) >= 8 && length(var.LOCAL_INSTANCE_USERNAME_PASSWORD) <= 20
+    error_message = "The password must be between 8 and 20 characters long without spaces around."
+  }
+}
+
+variable "LOCAL_INSTANCE_USERNAME_HOME" {
+  type        = string
+  default     = "home"
+  description = "The home directory of the instance user"
+
+  validation {
+    condition     = length(var.LOCAL_INSTANCE_USERNAME_HOME) > 0
+    error_message = "The home directory cannot be an empty string."
+  }
+
+  validation {
+    condition     = can(regex("^([a-zA-Z0-9]+)$", var.LOCAL_INSTANCE_USERNAME_HOME))
+    error_message = "The home directory can only contain alphanumeric characters."
+  }
+}
+
+variable "LOCAL_ANSIBLE_STAGING_DIRECTORY_INTERNAL" {
+  type        = string
+  default     = "/tmp/ansible"
+  description = "The directory where ansible files will be uploaded. Packer requires write permissions in this directory."
+
+  validation {
+    condition     = can(regex("^/.*", var.LOCAL_ANSIBLE_STAGING_DIRECTORY_INTERNAL))
+    error_message = "The ansible staging directory must contain an absolute path starting with '/'."
+  }
+}
+
+variable "LOCAL_PUBLIC_DIRECTORY_INTERNAL" {
+  type        = string
+  default     = "/public"
+  description = "The directory where temporary tools are downloaded"
+
+  validation {
+    condition     = substr(var.LOCAL_PUBLIC_DIRECTORY_INTERNAL, 0, 1) == "/"
+    error_message = "The public directory must start with a forward slash (/)."
+  }
+}
+
+variable "GIT_USERNAME" {
+  type        = string
+  default     = null
+  description = "The git username that will be associated with your commits."
+
+  validation {
+    condition     = length(var.GIT_USERNAME) <= 20
+    error_message = "Git username must be less than or equal to 20 characters long."
+  }
+}
+
+variable "GIT_EMAIL" {
+  type        = string
+  default     = null
+  description = "The git email that will be associated with your commits."
+
+  validation {
+    condition     = can(regex("^\\S+@\\S+\\.\\S+$", var.GIT_EMAIL))
+    error_message = "Git email must be a valid email address."
+  }
+}
+
+variable "ANSIBLE_TAGS" {
+  type        = list(string)
+  default     = null
+  description = "The ansible tags that will be used to install playbooks."
+
+  validation {
+    condition     = length(var.ANSIBLE_TAGS) > 0
+    error_message = "The variable must contain at least one tag."
+  }
+}
+
+variable "GIT_HUB_PERSONAL_ACCESS_TOKEN_CLASSIC" {
+  type        = string
+  default     = null
+  sensitive   = true
+  description = "The github personal access token use to setup ssh access on your instance"
+}
\ No newline at end of file
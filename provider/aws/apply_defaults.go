@@ -0,0 +1,19 @@
+package aws
+
+import "github.com/ed3899/kumo/config"
+
+const (
+	defaultVolumeType = "gp2"
+	minimumVolumeSize = 8
+)
+
+// Fills the EC2 volume when left empty.
+func (a *Aws) ApplyDefaults(_config *config.Config) {
+	if _config.AWS.EC2.Volume.Type == "" {
+		_config.AWS.EC2.Volume.Type = defaultVolumeType
+	}
+
+	if _config.AWS.EC2.Volume.Size == 0 {
+		_config.AWS.EC2.Volume.Size = minimumVolumeSize
+	}
+}
@@ -0,0 +1,15 @@
+package aws
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the access key of the config as the environment variables read by Packer and Terraform.
+//
+// Example:
+//
+//	(&config.Config{AWS: config.Aws{AccessKeyId: "AKIA...", ...}}) -> (map[string]string{"AWS_ACCESS_KEY_ID": "AKIA...", "AWS_SECRET_ACCESS_KEY": "..."}, nil)
+func (a *Aws) Credentials(_config *config.Config) (map[string]string, error) {
+	return map[string]string{
+		"AWS_ACCESS_KEY_ID":     _config.AWS.AccessKeyId,
+		"AWS_SECRET_ACCESS_KEY": _config.AWS.SecretAccessKey,
+	}, nil
+}
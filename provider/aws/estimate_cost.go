@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/price_table"
+	"github.com/samber/oops"
+)
+
+// Returns the on-demand cost of what the tool is about to run. Packer only runs a temporary instance while
+// building, so its volume is left out. Terraform runs the instance with the configured volume.
+//
+// Example:
+//
+//	(priceTable, iota.Terraform, _config) -> (&price_table.Estimate{Region: "us-west-2", InstanceType: "t2.micro", VolumeType: "gp2", ...}, nil)
+func (a *Aws) EstimateCost(
+	priceTable *price_table.PriceTable,
+	tool iota.Tool,
+	_config *config.Config,
+) (*price_table.Estimate, error) {
+	oopsBuilder := oops.
+		Code("EstimateCost").
+		In("provider").
+		In("aws").
+		With("tool", tool)
+
+	volumeType, volumeSize := "", 0
+	if tool == iota.Terraform {
+		volumeType, volumeSize = _config.AWS.EC2.Volume.Type, _config.AWS.EC2.Volume.Size
+	}
+
+	estimate, err := priceTable.Estimate(
+		_config.AWS.Region,
+		_config.AWS.EC2.Instance.Type,
+		volumeType,
+		volumeSize,
+	)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to estimate the cost")
+	}
+
+	return estimate, nil
+}
@@ -0,0 +1,28 @@
+package aws
+
+import (
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+// Returns the AMI id from the artifact id of an amazon-ebs build, which is prefixed with the region.
+//
+// Example:
+//
+//	("us-east-1:ami-0c3fd0f5d33134a76") -> ("ami-0c3fd0f5d33134a76", nil)
+func (a *Aws) ImageId(artifactId string) (string, error) {
+	oopsBuilder := oops.
+		Code("ImageId").
+		In("provider").
+		In("aws").
+		With("artifactId", artifactId)
+
+	_, amiId, found := strings.Cut(artifactId, ":")
+	if !found || amiId == "" {
+		return "", oopsBuilder.
+			Errorf("artifact id %q isn't of the form region:ami-id", artifactId)
+	}
+
+	return amiId, nil
+}
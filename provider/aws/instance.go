@@ -0,0 +1,40 @@
+package aws
+
+import (
+	"github.com/ed3899/kumo/provider"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/samber/oops"
+)
+
+// Returns the EC2 instance recorded in the Terraform state, or nil if there is none, i.e after a destroy.
+//
+// Example:
+//
+//	("terraform/aws/terraform.tfstate") -> (&provider.Instance{Id: "i-0a1b2c3d4e5f67890", PublicIp: "3.91.10.20", ...}, nil)
+func (a *Aws) Instance(pathToTerraformState string) (*provider.Instance, error) {
+	oopsBuilder := oops.
+		Code("Instance").
+		In("provider").
+		In("aws").
+		With("pathToTerraformState", pathToTerraformState)
+
+	awsInstance, err := terraform_state.GetAwsInstanceFromTerraformState(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read the instance from the terraform state")
+	}
+
+	if awsInstance == nil {
+		return nil, nil
+	}
+
+	return &provider.Instance{
+		Id:               awsInstance.Id,
+		Type:             awsInstance.InstanceType,
+		State:            awsInstance.InstanceState,
+		PublicIp:         awsInstance.PublicIp,
+		PublicDns:        awsInstance.PublicDns,
+		ImageId:          awsInstance.Ami,
+		AvailabilityZone: awsInstance.AvailabilityZone,
+	}, nil
+}
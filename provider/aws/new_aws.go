@@ -0,0 +1,25 @@
+package aws
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider"
+)
+
+const (
+	Cloud iota.Cloud = "aws"
+)
+
+func init() {
+	provider.Register(NewAws())
+}
+
+// Returns the AWS provider: an AMI built with the amazon-ebs Packer builder, deployed on EC2 in its own VPC.
+func NewAws() *Aws {
+	return &Aws{}
+}
+
+func (a *Aws) Cloud() iota.Cloud {
+	return Cloud
+}
+
+type Aws struct{}
@@ -0,0 +1,50 @@
+package aws
+
+import "github.com/ed3899/kumo/config"
+
+// Returns a new PackerAwsEnvironment.
+func NewPackerAwsEnvironment(
+	_config *config.Config,
+) *PackerAwsEnvironment {
+	return &PackerAwsEnvironment{
+		Required: &PackerAwsRequired{
+			AWS_ACCESS_KEY:                     _config.AWS.AccessKeyId,
+			AWS_SECRET_KEY:                     _config.AWS.SecretAccessKey,
+			AWS_IAM_PROFILE:                    _config.AWS.IamProfile,
+			AWS_USER_IDS:                       _config.AWS.UserIds,
+			AWS_AMI_NAME:                       _config.AMI.Name,
+			AWS_INSTANCE_TYPE:                  _config.AWS.EC2.Instance.Type,
+			AWS_REGION:                         _config.AWS.Region,
+			AWS_EC2_AMI_NAME_FILTER:            _config.AMI.Base.Filter,
+			AWS_EC2_AMI_ROOT_DEVICE_TYPE:       _config.AMI.Base.RootDeviceType,
+			AWS_EC2_AMI_VIRTUALIZATION_TYPE:    _config.AMI.Base.VirtualizationType,
+			AWS_EC2_AMI_OWNERS:                 _config.AMI.Base.Owners,
+			AWS_EC2_SSH_USERNAME:               _config.AMI.Base.User,
+			AWS_EC2_INSTANCE_USERNAME:          _config.AMI.User,
+			AWS_EC2_INSTANCE_USERNAME_HOME:     _config.AMI.Home,
+			AWS_EC2_INSTANCE_USERNAME_PASSWORD: _config.AMI.Password,
+		},
+	}
+}
+
+type PackerAwsEnvironment struct {
+	Required *PackerAwsRequired
+}
+
+type PackerAwsRequired struct {
+	AWS_ACCESS_KEY                     string
+	AWS_SECRET_KEY                     string
+	AWS_IAM_PROFILE                    string
+	AWS_USER_IDS                       []string
+	AWS_AMI_NAME                       string
+	AWS_INSTANCE_TYPE                  string
+	AWS_REGION                         string
+	AWS_EC2_AMI_NAME_FILTER            string
+	AWS_EC2_AMI_ROOT_DEVICE_TYPE       string
+	AWS_EC2_AMI_VIRTUALIZATION_TYPE    string
+	AWS_EC2_AMI_OWNERS                 []string
+	AWS_EC2_SSH_USERNAME               string
+	AWS_EC2_INSTANCE_USERNAME          string
+	AWS_EC2_INSTANCE_USERNAME_HOME     string
+	AWS_EC2_INSTANCE_USERNAME_PASSWORD string
+}
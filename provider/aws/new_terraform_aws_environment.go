@@ -1,50 +1,47 @@
-package environment
+package aws
 
 import (
 	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/config"
 	"github.com/ed3899/kumo/utils/packer_manifest"
 	"github.com/samber/oops"
-	"github.com/spf13/viper"
 )
 
 // Returns a TerraformAwsEnvironment instance. The pathToPackerManifest is used to get the last built ami id from the packer manifest.
 func NewTerraformAwsEnvironment(
 	pathToPackerManifest string,
+	_config *config.Config,
 ) (*TerraformAwsEnvironment, error) {
 	oopBuilder := oops.
 		Code("NewTerraformAwsEnvironment").
-		In("manager").
-		In("environment").
+		In("provider").
+		In("aws").
 		Tags("TerraformAwsEnvironment").
 		With("pathToPackerManifest", pathToPackerManifest)
 
-	amiId, err := packer_manifest.GetLastBuiltAmiIdFromPackerManifest(pathToPackerManifest)
+	pickedAmiId, err := packer_manifest.GetImageId(pathToPackerManifest, NewAws().ImageId, _config.Up.AmiId)
 	if err != nil {
 		return nil, oopBuilder.
-			Wrapf(err, "failed to get last built ami id from packer manifest")
-	}
-
-	pickedAmiId, err := packer_manifest.PickAmiId(amiId, viper.GetString("Up.AmiId"))
-	if err != nil {
-		return nil, oopBuilder.
-			Wrapf(err, "failed to pick ami id")
+			Wrapf(err, "failed to get the ami id to deploy")
 	}
 
 	return &TerraformAwsEnvironment{
 		Required: &TerraformAwsRequired{
-			AWS_ACCESS_KEY_ID:     viper.GetString("AWS.AccessKeyId"),
-			AWS_SECRET_ACCESS_KEY: viper.GetString("AWS.SecretAccessKey"),
-			AWS_REGION:            viper.GetString("AWS.Region"),
-			AWS_INSTANCE_TYPE:     viper.GetString("AWS.EC2.Instance.Type"),
+			AWS_ACCESS_KEY_ID:     _config.AWS.AccessKeyId,
+			AWS_SECRET_ACCESS_KEY: _config.AWS.SecretAccessKey,
+			AWS_REGION:            _config.AWS.Region,
+			AWS_INSTANCE_TYPE:     _config.AWS.EC2.Instance.Type,
 			AMI_ID:                pickedAmiId,
-			KEY_NAME:              constants.KEY_NAME,
+			KEY_NAME:              _config.ScopedName(constants.KEY_NAME),
 			SSH_PORT:              constants.SSH_PORT,
-			IP_FILE_NAME:          constants.IP_FILE_NAME,
-			USERNAME:              viper.GetString("AMI.User"),
+			USERNAME:              _config.AMI.User,
+			NAME_TAG:              _config.ScopedName(constants.TERRAFORM_NAME_TAG),
 		},
 		Optional: &TerraformAwsOptional{
-			AWS_EC2_INSTANCE_VOLUME_TYPE: viper.GetString("AWS.EC2.Volume.Type"),
-			AWS_EC2_INSTANCE_VOLUME_SIZE: viper.GetInt("AWS.EC2.Volume.Size"),
+			AWS_EC2_INSTANCE_VOLUME_TYPE: _config.AWS.EC2.Volume.Type,
+			AWS_EC2_INSTANCE_VOLUME_SIZE: _config.AWS.EC2.Volume.Size,
+			IDLE_SHUTDOWN_MINUTES:        _config.IdleShutdown.Minutes,
+			IDLE_SHUTDOWN_CPU_THRESHOLD:  _config.IdleShutdown.CpuThreshold,
 		},
 	}, nil
 }
@@ -62,11 +59,13 @@ type TerraformAwsRequired struct {
 	AMI_ID                string
 	KEY_NAME              string
 	SSH_PORT              int
-	IP_FILE_NAME          string
 	USERNAME              string
+	NAME_TAG              string
 }
 
 type TerraformAwsOptional struct {
 	AWS_EC2_INSTANCE_VOLUME_TYPE string
 	AWS_EC2_INSTANCE_VOLUME_SIZE int
+	IDLE_SHUTDOWN_MINUTES        int
+	IDLE_SHUTDOWN_CPU_THRESHOLD  int
 }
@@ -0,0 +1,8 @@
+package aws
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the aws Packer template is rendered with, see NewPackerAwsEnvironment.
+func (a *Aws) PackerEnvironment(_config *config.Config) any {
+	return NewPackerAwsEnvironment(_config)
+}
@@ -0,0 +1,8 @@
+package aws
+
+// Returns the instance state resource, it only stops and starts the instance.
+func (a *Aws) PowerResources() map[string][]string {
+	return map[string][]string{
+		"aws_ec2_instance_state.kumo-ec2-instance-state": nil,
+	}
+}
@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"strings"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/prompt"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// Asks for the AWS values of the config, offering the current ones as defaults.
+func (a *Aws) PromptConfig(
+	_prompt *prompt.Prompt,
+	_config *config.Config,
+) error {
+	oopsBuilder := oops.
+		Code("PromptConfig").
+		In("provider").
+		In("aws")
+
+	var err error
+	ask := func(value *string, question string) {
+		if err == nil {
+			*value, err = _prompt.Ask(question, *value)
+		}
+	}
+	askSecret := func(value *string, question string) {
+		if err == nil {
+			*value, err = _prompt.AskSecret(question, *value)
+		}
+	}
+
+	userIds := strings.Join(_config.AWS.UserIds, ",")
+
+	ask(&_config.AWS.AccessKeyId, "AWS access key id")
+	askSecret(&_config.AWS.SecretAccessKey, "AWS secret access key")
+	ask(&_config.AWS.IamProfile, "AWS IAM profile")
+	ask(&userIds, "AWS account ids allowed to use the AMI, separated by commas")
+	ask(&_config.AWS.Region, "AWS region")
+	ask(&_config.AWS.EC2.Instance.Type, "EC2 instance type")
+
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for aws config values")
+	}
+
+	_config.AWS.UserIds = lo.Compact(lo.Map(strings.Split(userIds, ","), func(userId string, _ int) string {
+		return strings.TrimSpace(userId)
+	}))
+
+	return nil
+}
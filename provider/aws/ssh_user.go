@@ -0,0 +1,8 @@
+package aws
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the user created on the AMI, which is the one kumo connects as.
+func (a *Aws) SshUser(_config *config.Config) string {
+	return _config.AMI.User
+}
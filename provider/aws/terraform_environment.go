@@ -0,0 +1,16 @@
+package aws
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the aws Terraform template is rendered with, see NewTerraformAwsEnvironment.
+func (a *Aws) TerraformEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (any, error) {
+	terraformAwsEnvironment, err := NewTerraformAwsEnvironment(pathToPackerManifest, _config)
+	if err != nil {
+		return nil, err
+	}
+
+	return terraformAwsEnvironment, nil
+}
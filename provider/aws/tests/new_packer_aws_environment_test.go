@@ -1,10 +1,10 @@
 package tests
 
 import (
-	"github.com/ed3899/kumo/manager/environment"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/provider/aws"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 )
 
 var _ = Describe("NewPackerAwsEnvironment", func() {
@@ -24,32 +24,42 @@ var _ = Describe("NewPackerAwsEnvironment", func() {
 		AmiUser                   = "ami_user"
 		AmiHome                   = "ami_home"
 		AmiPassword               = "ami_password"
+
+		_config *config.Config
 	)
 
 	BeforeEach(func() {
-		viper.Set("AWS.AccessKeyId", AccessKeyId)
-		viper.Set("AWS.SecretAccessKey", SecretAccessKey)
-		viper.Set("AWS.IamProfile", IamProfile)
-		viper.Set("AWS.UserIds", UserIds)
-		viper.Set("AMI.Name", AmiName)
-		viper.Set("AWS.EC2.Instance.Type", InstanceType)
-		viper.Set("AWS.Region", Region)
-		viper.Set("AMI.Base.Filter", AmiBaseFilter)
-		viper.Set("AMI.Base.RootDeviceType", AmiBaseRootDeviceType)
-		viper.Set("AMI.Base.VirtualizationType", AmiBaseVirtualizationType)
-		viper.Set("AMI.Base.Owners", AmiBaseOwners)
-		viper.Set("AMI.Base.User", AmiBaseUser)
-		viper.Set("AMI.User", AmiUser)
-		viper.Set("AMI.Home", AmiHome)
-		viper.Set("AMI.Password", AmiPassword)
-	})
-
-	AfterEach(func() {
-		viper.Reset()
+		_config = &config.Config{
+			AWS: config.Aws{
+				AccessKeyId:     AccessKeyId,
+				SecretAccessKey: SecretAccessKey,
+				IamProfile:      IamProfile,
+				UserIds:         UserIds,
+				Region:          Region,
+				EC2: config.Ec2{
+					Instance: config.Instance{
+						Type: InstanceType,
+					},
+				},
+			},
+			AMI: config.Ami{
+				Base: config.AmiBase{
+					Filter:             AmiBaseFilter,
+					RootDeviceType:     AmiBaseRootDeviceType,
+					VirtualizationType: AmiBaseVirtualizationType,
+					Owners:             AmiBaseOwners,
+					User:               AmiBaseUser,
+				},
+				Name:     AmiName,
+				User:     AmiUser,
+				Home:     AmiHome,
+				Password: AmiPassword,
+			},
+		}
 	})
 
 	It("should return a new PackerAwsEnvironment", func() {
-		_environment := environment.NewPackerAwsEnvironment()
+		_environment := aws.NewPackerAwsEnvironment(_config)
 		Expect(_environment).ToNot(BeNil())
 		Expect(_environment.Required.AWS_ACCESS_KEY).To(Equal(AccessKeyId))
 		Expect(_environment.Required.AWS_SECRET_KEY).To(Equal(SecretAccessKey))
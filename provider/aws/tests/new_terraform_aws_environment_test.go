@@ -5,16 +5,16 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/ed3899/kumo/manager/environment"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/provider/aws"
 	"github.com/ed3899/kumo/utils/packer_manifest"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	"github.com/spf13/viper"
 )
 
 var _ = Describe("NewTerraformAwsEnvironment", func() {
 	var (
-		amiId = "ami:ami-12345678"
+		amiId                = "ami:ami-12345678"
 		tempManifestFilePath string
 	)
 
@@ -54,25 +54,53 @@ var _ = Describe("NewTerraformAwsEnvironment", func() {
 		User            = "ubuntu"
 		VolumeType      = "gp2"
 		VolumeSize      = 8
+
+		_config *config.Config
 	)
 
 	JustBeforeEach(func() {
-		viper.Set("AWS.AccessKeyId", AccessKeyId)
-		viper.Set("AWS.SecretAccessKey", SecretAccessKey)
-		viper.Set("AWS.Region", Region)
-		viper.Set("AWS.EC2.Instance.Type", InstanceType)
-		viper.Set("AMI.User", User)
-		viper.Set("AWS.EC2.Volume.Type", VolumeType)
-		viper.Set("AWS.EC2.Volume.Size", VolumeSize)
-	})
-
-	JustAfterEach(func() {
-		viper.Reset()
+		_config = &config.Config{
+			AWS: config.Aws{
+				AccessKeyId:     AccessKeyId,
+				SecretAccessKey: SecretAccessKey,
+				Region:          Region,
+				EC2: config.Ec2{
+					Instance: config.Instance{
+						Type: InstanceType,
+					},
+					Volume: config.Volume{
+						Type: VolumeType,
+						Size: VolumeSize,
+					},
+				},
+			},
+			AMI: config.Ami{
+				User: User,
+			},
+		}
 	})
 
 	It("should return a new TerraformAwsEnvironment", func() {
-		_environment, err := environment.NewTerraformAwsEnvironment(tempManifestFilePath)
+		_environment, err := aws.NewTerraformAwsEnvironment(tempManifestFilePath, _config)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(_environment).NotTo(BeNil())
 	})
+
+	It("should scope the key and name tag to the environment", func() {
+		_config.Name = "gpu-box"
+
+		_environment, err := aws.NewTerraformAwsEnvironment(tempManifestFilePath, _config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(_environment.Required.KEY_NAME).To(Equal("kumokey-gpu-box"))
+		Expect(_environment.Required.NAME_TAG).To(Equal("kumo-gpu-box"))
+	})
+
+	It("should pass the idle shutdown policy", func() {
+		_config.IdleShutdown = config.IdleShutdown{Minutes: 45, CpuThreshold: 5}
+
+		_environment, err := aws.NewTerraformAwsEnvironment(tempManifestFilePath, _config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(_environment.Optional.IDLE_SHUTDOWN_MINUTES).To(Equal(45))
+		Expect(_environment.Optional.IDLE_SHUTDOWN_CPU_THRESHOLD).To(Equal(5))
+	})
 })
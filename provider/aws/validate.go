@@ -0,0 +1,58 @@
+package aws
+
+import (
+	"regexp"
+
+	"github.com/ed3899/kumo/config"
+)
+
+var (
+	accessKeyIdPattern = regexp.MustCompile(`^[0-9A-Z]{20}$`)
+	iamProfilePattern  = regexp.MustCompile(`^[a-zA-Z0-9_+=,.@-]{1,64}$`)
+	userIdPattern      = regexp.MustCompile(`^\d{12}$`)
+	regionPattern      = regexp.MustCompile(`^[a-z]+(?:-[a-z]+)*-\d+$`)
+	amiIdPattern       = regexp.MustCompile(`^ami-[0-9a-f]{8,}$`)
+)
+
+// Returns the problems of the AWS section, of the base AMI the amazon-ebs builder filters and of Up.AmiId.
+func (a *Aws) Validate(_config *config.Config) config.Problems {
+	rules := config.NewRules()
+	aws := &_config.AWS
+
+	rules.Required("AWS.AccessKeyId", aws.AccessKeyId)
+	rules.Match("AWS.AccessKeyId", aws.AccessKeyId, accessKeyIdPattern, "must be a 20 character uppercase alphanumeric string")
+
+	rules.Required("AWS.SecretAccessKey", aws.SecretAccessKey)
+	if aws.SecretAccessKey != "" && len(aws.SecretAccessKey) != 40 {
+		rules.Report("AWS.SecretAccessKey", "must be a 40 character string")
+	}
+
+	rules.Required("AWS.IamProfile", aws.IamProfile)
+	rules.Match("AWS.IamProfile", aws.IamProfile, iamProfilePattern, "must be 1 to 64 alphanumeric characters or any of -_+=,.@")
+
+	rules.RequiredList("AWS.UserIds", aws.UserIds)
+	for i, userId := range aws.UserIds {
+		rules.Match(config.IndexPath("AWS.UserIds", i), userId, userIdPattern, "must be a 12 digit AWS account ID")
+	}
+
+	rules.Required("AWS.Region", aws.Region)
+	rules.Match("AWS.Region", aws.Region, regionPattern, "must be a valid region (i.e us-east-2)")
+
+	rules.Required("AWS.EC2.Instance.Type", aws.EC2.Instance.Type)
+
+	if aws.EC2.Volume.Size < minimumVolumeSize {
+		rules.Report("AWS.EC2.Volume.Size", "must be greater than or equal to %d", minimumVolumeSize)
+	}
+
+	// The base image filter is only read by the amazon-ebs builder.
+	base := &_config.AMI.Base
+	rules.Required("AMI.Base.Filter", base.Filter)
+	rules.Required("AMI.Base.RootDeviceType", base.RootDeviceType)
+	rules.Required("AMI.Base.VirtualizationType", base.VirtualizationType)
+	rules.RequiredList("AMI.Base.Owners", base.Owners)
+	rules.Required("AMI.Base.User", base.User)
+
+	rules.Match("Up.AmiId", _config.Up.AmiId, amiIdPattern, "must be a valid AMI ID (i.e ami-0c3fd0f5d33134a76)")
+
+	return rules.Problems()
+}
@@ -0,0 +1,19 @@
+package azure
+
+import "github.com/ed3899/kumo/config"
+
+const (
+	defaultOsDiskType = "StandardSSD_LRS"
+	minimumOsDiskSize = 30
+)
+
+// Fills the OS disk of the VM when left empty.
+func (a *Azure) ApplyDefaults(_config *config.Config) {
+	if _config.Azure.VM.Disk.Type == "" {
+		_config.Azure.VM.Disk.Type = defaultOsDiskType
+	}
+
+	if _config.Azure.VM.Disk.Size == 0 {
+		_config.Azure.VM.Disk.Size = minimumOsDiskSize
+	}
+}
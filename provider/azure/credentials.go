@@ -0,0 +1,17 @@
+package azure
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the service principal of the config as the environment variables read by Packer and Terraform.
+//
+// Example:
+//
+//	(&config.Config{Azure: config.Azure{ClientId: "2f0e...", ...}}) -> (map[string]string{"ARM_CLIENT_ID": "2f0e...", "ARM_CLIENT_SECRET": "...", ...}, nil)
+func (a *Azure) Credentials(_config *config.Config) (map[string]string, error) {
+	return map[string]string{
+		"ARM_SUBSCRIPTION_ID": _config.Azure.SubscriptionId,
+		"ARM_TENANT_ID":       _config.Azure.TenantId,
+		"ARM_CLIENT_ID":       _config.Azure.ClientId,
+		"ARM_CLIENT_SECRET":   _config.Azure.ClientSecret,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package azure
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/price_table"
+	"github.com/samber/oops"
+)
+
+// Returns the on-demand cost of what the tool is about to run. Packer only runs a temporary VM while building,
+// so its disk is left out. Terraform runs the VM with the configured OS disk.
+//
+// Example:
+//
+//	(priceTable, iota.Terraform, _config) -> (&price_table.Estimate{Region: "eastus", InstanceType: "Standard_B1s", VolumeType: "StandardSSD_LRS", ...}, nil)
+func (a *Azure) EstimateCost(
+	priceTable *price_table.PriceTable,
+	tool iota.Tool,
+	_config *config.Config,
+) (*price_table.Estimate, error) {
+	oopsBuilder := oops.
+		Code("EstimateCost").
+		In("provider").
+		In("azure").
+		With("tool", tool)
+
+	diskType, diskSize := "", 0
+	if tool == iota.Terraform {
+		diskType, diskSize = _config.Azure.VM.Disk.Type, _config.Azure.VM.Disk.Size
+	}
+
+	estimate, err := priceTable.Estimate(
+		_config.Azure.Location,
+		_config.Azure.VM.Size,
+		diskType,
+		diskSize,
+	)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to estimate the cost")
+	}
+
+	return estimate, nil
+}
@@ -0,0 +1,31 @@
+package azure
+
+import (
+	"regexp"
+
+	"github.com/samber/oops"
+)
+
+var (
+	managedImageIdPattern = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Compute/images/[^/]+$`)
+)
+
+// Returns the managed image ID from the artifact id of an azure-arm build, which is the resource ID itself.
+//
+// Example:
+//
+//	("/subscriptions/.../resourceGroups/kumo-images/providers/Microsoft.Compute/images/kumo-20231001120000") -> ("/subscriptions/.../images/kumo-20231001120000", nil)
+func (a *Azure) ImageId(artifactId string) (string, error) {
+	oopsBuilder := oops.
+		Code("ImageId").
+		In("provider").
+		In("azure").
+		With("artifactId", artifactId)
+
+	if !managedImageIdPattern.MatchString(artifactId) {
+		return "", oopsBuilder.
+			Errorf("artifact id %q isn't a managed image ID", artifactId)
+	}
+
+	return artifactId, nil
+}
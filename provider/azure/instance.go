@@ -0,0 +1,46 @@
+package azure
+
+import (
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/provider"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/samber/oops"
+)
+
+// Returns the Azure VM recorded in the Terraform state, or nil if there is none, i.e after a destroy. A
+// deallocated VM is reported as stopped like on the other clouds.
+//
+// Example:
+//
+//	("terraform/azure/terraform.tfstate") -> (&provider.Instance{Id: "4d6f7b8e-...", State: "running", PublicIp: "20.42.10.5", ...}, nil)
+func (a *Azure) Instance(pathToTerraformState string) (*provider.Instance, error) {
+	oopsBuilder := oops.
+		Code("Instance").
+		In("provider").
+		In("azure").
+		With("pathToTerraformState", pathToTerraformState)
+
+	azureInstance, err := terraform_state.GetAzureInstanceFromTerraformState(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read the instance from the terraform state")
+	}
+
+	if azureInstance == nil {
+		return nil, nil
+	}
+
+	state := constants.INSTANCE_STATE_RUNNING
+	if azureInstance.PowerAction == "deallocate" {
+		state = constants.INSTANCE_STATE_STOPPED
+	}
+
+	return &provider.Instance{
+		Id:               azureInstance.VirtualMachineId,
+		Type:             azureInstance.Size,
+		State:            state,
+		PublicIp:         azureInstance.PublicIpAddress,
+		ImageId:          azureInstance.SourceImageId,
+		AvailabilityZone: azureInstance.Location,
+	}, nil
+}
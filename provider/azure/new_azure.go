@@ -0,0 +1,26 @@
+package azure
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider"
+)
+
+const (
+	Cloud iota.Cloud = "azure"
+)
+
+func init() {
+	provider.Register(NewAzure())
+}
+
+// Returns the Azure provider: a managed image built with the azure-arm Packer builder, deployed on a Linux VM
+// in its own resource group and VNet.
+func NewAzure() *Azure {
+	return &Azure{}
+}
+
+func (a *Azure) Cloud() iota.Cloud {
+	return Cloud
+}
+
+type Azure struct{}
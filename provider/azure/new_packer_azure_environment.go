@@ -0,0 +1,50 @@
+package azure
+
+import "github.com/ed3899/kumo/config"
+
+// Returns a new PackerAzureEnvironment.
+func NewPackerAzureEnvironment(
+	_config *config.Config,
+) *PackerAzureEnvironment {
+	return &PackerAzureEnvironment{
+		Required: &PackerAzureRequired{
+			AZURE_SUBSCRIPTION_ID:            _config.Azure.SubscriptionId,
+			AZURE_TENANT_ID:                  _config.Azure.TenantId,
+			AZURE_CLIENT_ID:                  _config.Azure.ClientId,
+			AZURE_CLIENT_SECRET:              _config.Azure.ClientSecret,
+			AZURE_LOCATION:                   _config.Azure.Location,
+			AZURE_VM_SIZE:                    _config.Azure.VM.Size,
+			AZURE_IMAGE_NAME:                 _config.AMI.Name,
+			AZURE_IMAGE_RESOURCE_GROUP:       _config.Azure.ImageResourceGroup,
+			AZURE_IMAGE_PUBLISHER:            _config.Azure.Image.Publisher,
+			AZURE_IMAGE_OFFER:                _config.Azure.Image.Offer,
+			AZURE_IMAGE_SKU:                  _config.Azure.Image.Sku,
+			AZURE_SSH_USERNAME:               _config.AMI.Base.User,
+			AZURE_INSTANCE_USERNAME:          _config.AMI.User,
+			AZURE_INSTANCE_USERNAME_HOME:     _config.AMI.Home,
+			AZURE_INSTANCE_USERNAME_PASSWORD: _config.AMI.Password,
+		},
+	}
+}
+
+type PackerAzureEnvironment struct {
+	Required *PackerAzureRequired
+}
+
+type PackerAzureRequired struct {
+	AZURE_SUBSCRIPTION_ID            string
+	AZURE_TENANT_ID                  string
+	AZURE_CLIENT_ID                  string
+	AZURE_CLIENT_SECRET              string
+	AZURE_LOCATION                   string
+	AZURE_VM_SIZE                    string
+	AZURE_IMAGE_NAME                 string
+	AZURE_IMAGE_RESOURCE_GROUP       string
+	AZURE_IMAGE_PUBLISHER            string
+	AZURE_IMAGE_OFFER                string
+	AZURE_IMAGE_SKU                  string
+	AZURE_SSH_USERNAME               string
+	AZURE_INSTANCE_USERNAME          string
+	AZURE_INSTANCE_USERNAME_HOME     string
+	AZURE_INSTANCE_USERNAME_PASSWORD string
+}
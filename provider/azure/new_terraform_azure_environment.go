@@ -0,0 +1,76 @@
+package azure
+
+import (
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/packer_manifest"
+	"github.com/samber/oops"
+)
+
+// Returns a TerraformAzureEnvironment instance. The pathToPackerManifest is used to get the last built managed
+// image ID from the packer manifest.
+func NewTerraformAzureEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (*TerraformAzureEnvironment, error) {
+	oopBuilder := oops.
+		Code("NewTerraformAzureEnvironment").
+		In("provider").
+		In("azure").
+		Tags("TerraformAzureEnvironment").
+		With("pathToPackerManifest", pathToPackerManifest)
+
+	pickedImageId, err := packer_manifest.GetImageId(pathToPackerManifest, NewAzure().ImageId, _config.Up.AmiId)
+	if err != nil {
+		return nil, oopBuilder.
+			Wrapf(err, "failed to get the managed image id to deploy")
+	}
+
+	return &TerraformAzureEnvironment{
+		Required: &TerraformAzureRequired{
+			ARM_SUBSCRIPTION_ID: _config.Azure.SubscriptionId,
+			ARM_TENANT_ID:       _config.Azure.TenantId,
+			ARM_CLIENT_ID:       _config.Azure.ClientId,
+			ARM_CLIENT_SECRET:   _config.Azure.ClientSecret,
+			AZURE_LOCATION:      _config.Azure.Location,
+			AZURE_VM_SIZE:       _config.Azure.VM.Size,
+			IMAGE_ID:            pickedImageId,
+			KEY_NAME:            _config.ScopedName(constants.KEY_NAME),
+			SSH_PORT:            constants.SSH_PORT,
+			USERNAME:            _config.AMI.User,
+			NAME_TAG:            _config.ScopedName(constants.TERRAFORM_NAME_TAG),
+		},
+		Optional: &TerraformAzureOptional{
+			AZURE_OS_DISK_TYPE:          _config.Azure.VM.Disk.Type,
+			AZURE_OS_DISK_SIZE:          _config.Azure.VM.Disk.Size,
+			IDLE_SHUTDOWN_MINUTES:       _config.IdleShutdown.Minutes,
+			IDLE_SHUTDOWN_CPU_THRESHOLD: _config.IdleShutdown.CpuThreshold,
+		},
+	}, nil
+}
+
+type TerraformAzureEnvironment struct {
+	Required *TerraformAzureRequired
+	Optional *TerraformAzureOptional
+}
+
+type TerraformAzureRequired struct {
+	ARM_SUBSCRIPTION_ID string
+	ARM_TENANT_ID       string
+	ARM_CLIENT_ID       string
+	ARM_CLIENT_SECRET   string
+	AZURE_LOCATION      string
+	AZURE_VM_SIZE       string
+	IMAGE_ID            string
+	KEY_NAME            string
+	SSH_PORT            int
+	USERNAME            string
+	NAME_TAG            string
+}
+
+type TerraformAzureOptional struct {
+	AZURE_OS_DISK_TYPE          string
+	AZURE_OS_DISK_SIZE          int
+	IDLE_SHUTDOWN_MINUTES       int
+	IDLE_SHUTDOWN_CPU_THRESHOLD int
+}
@@ -0,0 +1,8 @@
+package azure
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the azure Packer template is rendered with, see NewPackerAzureEnvironment.
+func (a *Azure) PackerEnvironment(_config *config.Config) any {
+	return NewPackerAzureEnvironment(_config)
+}
@@ -0,0 +1,8 @@
+package azure
+
+// Returns the azapi action that starts or deallocates the VM.
+func (a *Azure) PowerResources() map[string][]string {
+	return map[string][]string{
+		"azapi_resource_action.kumo-vm-power": nil,
+	}
+}
@@ -0,0 +1,45 @@
+package azure
+
+import (
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/prompt"
+	"github.com/samber/oops"
+)
+
+// Asks for the Azure values of the config, offering the current ones as defaults.
+func (a *Azure) PromptConfig(
+	_prompt *prompt.Prompt,
+	_config *config.Config,
+) error {
+	oopsBuilder := oops.
+		Code("PromptConfig").
+		In("provider").
+		In("azure")
+
+	var err error
+	ask := func(value *string, question string) {
+		if err == nil {
+			*value, err = _prompt.Ask(question, *value)
+		}
+	}
+	askSecret := func(value *string, question string) {
+		if err == nil {
+			*value, err = _prompt.AskSecret(question, *value)
+		}
+	}
+
+	ask(&_config.Azure.SubscriptionId, "Azure subscription id")
+	ask(&_config.Azure.TenantId, "Azure tenant id")
+	ask(&_config.Azure.ClientId, "Service principal client id")
+	askSecret(&_config.Azure.ClientSecret, "Service principal client secret")
+	ask(&_config.Azure.Location, "Azure location")
+	ask(&_config.Azure.ImageResourceGroup, "Existing resource group to store the images in")
+	ask(&_config.Azure.VM.Size, "VM size")
+
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for azure config values")
+	}
+
+	return nil
+}
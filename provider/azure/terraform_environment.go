@@ -0,0 +1,16 @@
+package azure
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the azure Terraform template is rendered with, see NewTerraformAzureEnvironment.
+func (a *Azure) TerraformEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (any, error) {
+	terraformAzureEnvironment, err := NewTerraformAzureEnvironment(pathToPackerManifest, _config)
+	if err != nil {
+		return nil, err
+	}
+
+	return terraformAzureEnvironment, nil
+}
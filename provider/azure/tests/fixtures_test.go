@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/test_helpers"
+)
+
+const imageResourceGroupId = "/subscriptions/00000000-0000-0000-0000-000000000001/resourceGroups/kumo-images/providers/Microsoft.Compute/images/"
+
+// Returns an azure config with a placeholder service principal.
+func newAzureConfig() *config.Config {
+	return &config.Config{
+		Cloud: "azure",
+		Azure: config.Azure{
+			SubscriptionId:     "00000000-0000-0000-0000-000000000001",
+			TenantId:           "00000000-0000-0000-0000-000000000002",
+			ClientId:           "00000000-0000-0000-0000-000000000003",
+			ClientSecret:       "client-secret",
+			Location:           "eastus",
+			ImageResourceGroup: "kumo-images",
+			Image: config.AzureImage{
+				Publisher: "Canonical",
+				Offer:     "0001-com-ubuntu-server-jammy",
+				Sku:       "22_04-lts-gen2",
+			},
+			VM: config.Vm{
+				Size: "Standard_B1s",
+				Disk: config.Volume{
+					Type: "StandardSSD_LRS",
+					Size: 30,
+				},
+			},
+		},
+		AMI: config.Ami{
+			Base: config.AmiBase{
+				User: "packer",
+			},
+			Name:     "kumo",
+			User:     "dev",
+			Home:     "dev",
+			Password: "password123",
+			Tools:    []string{"docker"},
+		},
+		Git: config.Git{
+			Username: "dev",
+			Email:    "dev@kumo.com",
+		},
+		IdleShutdown: config.IdleShutdown{
+			Minutes:      60,
+			CpuThreshold: 10,
+		},
+	}
+}
+
+// Writes a manifest of two azure-arm builds, the last run built lastImageId.
+func writePackerManifest(lastImageId string) string {
+	return test_helpers.WritePackerManifest("azure-arm", imageResourceGroupId+"kumo-20231001120000", lastImageId)
+}
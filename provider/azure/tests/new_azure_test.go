@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/provider/azure"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Azure", Label("unit"), func() {
+	It("should return the service principal as ARM variables", func() {
+		credentials, err := azure.NewAzure().Credentials(newAzureConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials).To(Equal(map[string]string{
+			"ARM_SUBSCRIPTION_ID": "00000000-0000-0000-0000-000000000001",
+			"ARM_TENANT_ID":       "00000000-0000-0000-0000-000000000002",
+			"ARM_CLIENT_ID":       "00000000-0000-0000-0000-000000000003",
+			"ARM_CLIENT_SECRET":   "client-secret",
+		}))
+	})
+
+	It("should return the managed image ID of an artifact", func() {
+		imageId, err := azure.NewAzure().ImageId(imageResourceGroupId + "kumo-20231001120000")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(imageId).To(Equal(imageResourceGroupId + "kumo-20231001120000"))
+
+		_, err = azure.NewAzure().ImageId("us-east-1:ami-0c3fd0f5d33134a76")
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should map the last power action to the instance state",
+		func(action string, expected string) {
+			pathToState := filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+			state := `{"resources": [
+				{"mode": "managed", "type": "azurerm_linux_virtual_machine", "name": "kumo-vm", "instances": [{"attributes": {"virtual_machine_id": "4d6f7b8e-0000-0000-0000-000000000000", "size": "Standard_B1s", "location": "eastus", "public_ip_address": "20.42.10.5"}}]},
+				{"mode": "managed", "type": "azapi_resource_action", "name": "kumo-vm-power", "instances": [{"attributes": {"action": "` + action + `"}}]}
+			]}`
+			Expect(os.WriteFile(pathToState, []byte(state), 0644)).To(Succeed())
+
+			instance, err := azure.NewAzure().Instance(pathToState)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.Id).To(Equal("4d6f7b8e-0000-0000-0000-000000000000"))
+			Expect(instance.State).To(Equal(expected))
+			Expect(instance.PublicIp).To(Equal("20.42.10.5"))
+			Expect(instance.AvailabilityZone).To(Equal("eastus"))
+		},
+		Entry("started", "start", "running"),
+		Entry("deallocated", "deallocate", "stopped"),
+	)
+})
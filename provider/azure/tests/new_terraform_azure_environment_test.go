@@ -0,0 +1,22 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/provider/azure"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewTerraformAzureEnvironment", Label("unit"), func() {
+	It("should deploy the managed image of the last azure-arm build", func() {
+		_config := newAzureConfig()
+		pathToManifest := writePackerManifest(imageResourceGroupId + "kumo-20231002120000")
+
+		terraformAzureEnvironment, err := azure.NewTerraformAzureEnvironment(pathToManifest, _config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(terraformAzureEnvironment.Required.IMAGE_ID).To(Equal(imageResourceGroupId + "kumo-20231002120000"))
+		Expect(terraformAzureEnvironment.Required.ARM_CLIENT_SECRET).To(Equal("client-secret"))
+		Expect(terraformAzureEnvironment.Required.AZURE_VM_SIZE).To(Equal("Standard_B1s"))
+		Expect(terraformAzureEnvironment.Optional.AZURE_OS_DISK_TYPE).To(Equal("StandardSSD_LRS"))
+		Expect(terraformAzureEnvironment.Optional.AZURE_OS_DISK_SIZE).To(Equal(30))
+	})
+})
@@ -0,0 +1,59 @@
+package azure
+
+import (
+	"regexp"
+
+	"github.com/ed3899/kumo/config"
+)
+
+var (
+	uuidPattern          = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	locationPattern      = regexp.MustCompile(`^[a-z]+[a-z0-9]*$`)
+	resourceGroupPattern = regexp.MustCompile(`^[-\w.()]{0,89}[-\w()]$`)
+	// Packer appends a 15 character timestamp to the name, managed images are at most 80 characters long.
+	imageNamePattern = regexp.MustCompile(`^[a-zA-Z0-9](?:[-\w.]{0,63}[a-zA-Z0-9_])?$`)
+)
+
+// Returns the problems of the Azure section, of the managed image names and of the users.
+func (a *Azure) Validate(_config *config.Config) config.Problems {
+	rules := config.NewRules()
+	azure := &_config.Azure
+
+	for _, id := range []struct{ path, value string }{
+		{"Azure.SubscriptionId", azure.SubscriptionId},
+		{"Azure.TenantId", azure.TenantId},
+		{"Azure.ClientId", azure.ClientId},
+	} {
+		rules.Required(id.path, id.value)
+		rules.Match(id.path, id.value, uuidPattern, "must be a GUID (i.e 00000000-0000-0000-0000-000000000000)")
+	}
+
+	rules.Required("Azure.ClientSecret", azure.ClientSecret)
+
+	rules.Required("Azure.Location", azure.Location)
+	rules.Match("Azure.Location", azure.Location, locationPattern, "must be a valid location name (i.e eastus)")
+
+	rules.Required("Azure.ImageResourceGroup", azure.ImageResourceGroup)
+	rules.Match("Azure.ImageResourceGroup", azure.ImageResourceGroup, resourceGroupPattern, "must be 1 to 90 alphanumeric characters or any of -_.(), not ending with '.'")
+
+	rules.Required("Azure.Image.Publisher", azure.Image.Publisher)
+	rules.Required("Azure.Image.Offer", azure.Image.Offer)
+	rules.Required("Azure.Image.Sku", azure.Image.Sku)
+
+	rules.Required("Azure.VM.Size", azure.VM.Size)
+
+	if azure.VM.Disk.Size < minimumOsDiskSize {
+		rules.Report("Azure.VM.Disk.Size", "must be greater than or equal to %d", minimumOsDiskSize)
+	}
+
+	rules.Match("AMI.Name", _config.AMI.Name, imageNamePattern, "must be 1 to 65 alphanumeric characters, '_', '.' or '-' on azure, starting with an alphanumeric character")
+	rules.Match("Up.AmiId", _config.Up.AmiId, managedImageIdPattern, "must be a managed image ID (i.e /subscriptions/.../resourceGroups/kumo-images/providers/Microsoft.Compute/images/kumo-20231001120000)")
+
+	rules.Required("AMI.Base.User", _config.AMI.Base.User)
+	// The build deprovisions the VM, which deletes the user Packer connected as.
+	if _config.AMI.Base.User != "" && _config.AMI.Base.User == _config.AMI.User {
+		rules.Report("AMI.Base.User", "must differ from AMI.User on azure, it's deleted when the image is generalized")
+	}
+
+	return rules.Problems()
+}
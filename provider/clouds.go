@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/samber/lo"
+)
+
+// Returns every cloud with a registered provider, sorted by name.
+//
+// Example:
+//
+//	() -> []iota.Cloud{"aws"}
+func Clouds() []iota.Cloud {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	clouds := lo.Keys(providers)
+	sort.Slice(clouds, func(i, j int) bool {
+		return clouds[i] < clouds[j]
+	})
+
+	return clouds
+}
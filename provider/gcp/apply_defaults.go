@@ -0,0 +1,19 @@
+package gcp
+
+import "github.com/ed3899/kumo/config"
+
+const (
+	defaultDiskType = "pd-balanced"
+	minimumDiskSize = 10
+)
+
+// Fills the boot disk when left empty.
+func (g *Gcp) ApplyDefaults(_config *config.Config) {
+	if _config.GCP.Compute.Disk.Type == "" {
+		_config.GCP.Compute.Disk.Type = defaultDiskType
+	}
+
+	if _config.GCP.Compute.Disk.Size == 0 {
+		_config.GCP.Compute.Disk.Size = minimumDiskSize
+	}
+}
@@ -0,0 +1,29 @@
+package gcp
+
+import (
+	"github.com/ed3899/kumo/config"
+	"github.com/samber/oops"
+)
+
+// Returns the service account key of the config as the environment variable read by Packer and Terraform,
+// see CredentialsFile.
+//
+// Example:
+//
+//	(&config.Config{GCP: config.Gcp{CredentialsFile: "service-account.json", ...}}) -> (map[string]string{"GOOGLE_APPLICATION_CREDENTIALS": "/home/dev/project/service-account.json"}, nil)
+func (g *Gcp) Credentials(_config *config.Config) (map[string]string, error) {
+	oopsBuilder := oops.
+		Code("Credentials").
+		In("provider").
+		In("gcp")
+
+	absPathToKey, err := CredentialsFile(_config)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the service account key")
+	}
+
+	return map[string]string{
+		"GOOGLE_APPLICATION_CREDENTIALS": absPathToKey,
+	}, nil
+}
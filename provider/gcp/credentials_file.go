@@ -0,0 +1,39 @@
+package gcp
+
+import (
+	"path/filepath"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/service_account"
+	"github.com/samber/oops"
+)
+
+// Returns the absolute path to the service account key of the config, after checking it's a service account key.
+// Relative paths are resolved from the current working directory, where kumo.config.yaml is.
+//
+// Example:
+//
+//	(&config.Config{GCP: config.Gcp{CredentialsFile: "service-account.json", ...}}) -> ("/home/dev/project/service-account.json", nil)
+func CredentialsFile(
+	_config *config.Config,
+) (string, error) {
+	oopsBuilder := oops.
+		Code("CredentialsFile").
+		In("provider").
+		In("gcp").
+		With("credentialsFile", _config.GCP.CredentialsFile)
+
+	absPathToKey, err := filepath.Abs(_config.GCP.CredentialsFile)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to get the absolute path of the service account key")
+	}
+
+	_, err = service_account.ReadServiceAccountKey(absPathToKey)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to read the service account key")
+	}
+
+	return absPathToKey, nil
+}
@@ -0,0 +1,44 @@
+package gcp
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/price_table"
+	"github.com/samber/oops"
+)
+
+// Returns the on-demand cost of what the tool is about to run. Packer only runs a temporary instance while
+// building, so its disk is left out. Terraform runs the instance with the configured disk.
+//
+// Example:
+//
+//	(priceTable, iota.Terraform, _config) -> (&price_table.Estimate{Region: "us-central1", InstanceType: "e2-micro", VolumeType: "pd-balanced", ...}, nil)
+func (g *Gcp) EstimateCost(
+	priceTable *price_table.PriceTable,
+	tool iota.Tool,
+	_config *config.Config,
+) (*price_table.Estimate, error) {
+	oopsBuilder := oops.
+		Code("EstimateCost").
+		In("provider").
+		In("gcp").
+		With("tool", tool)
+
+	diskType, diskSize := "", 0
+	if tool == iota.Terraform {
+		diskType, diskSize = _config.GCP.Compute.Disk.Type, _config.GCP.Compute.Disk.Size
+	}
+
+	estimate, err := priceTable.Estimate(
+		_config.GCP.Region,
+		_config.GCP.Compute.Instance.Type,
+		diskType,
+		diskSize,
+	)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to estimate the cost")
+	}
+
+	return estimate, nil
+}
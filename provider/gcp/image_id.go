@@ -0,0 +1,25 @@
+package gcp
+
+import (
+	"github.com/samber/oops"
+)
+
+// Returns the image name from the artifact id of a googlecompute build, which is the image name itself.
+//
+// Example:
+//
+//	("kumo-1700000000") -> ("kumo-1700000000", nil)
+func (g *Gcp) ImageId(artifactId string) (string, error) {
+	oopsBuilder := oops.
+		Code("ImageId").
+		In("provider").
+		In("gcp").
+		With("artifactId", artifactId)
+
+	if artifactId == "" {
+		return "", oopsBuilder.
+			Errorf("artifact id is empty, expected an image name")
+	}
+
+	return artifactId, nil
+}
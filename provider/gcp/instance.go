@@ -0,0 +1,54 @@
+package gcp
+
+import (
+	"strings"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/provider"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/samber/oops"
+)
+
+// Returns the Compute Engine instance recorded in the Terraform state, or nil if there is none, i.e after a
+// destroy. The status is reported as running or stopped like on the other clouds, a stopped instance is
+// TERMINATED on Compute Engine.
+//
+// Example:
+//
+//	("terraform/gcp/terraform.tfstate") -> (&provider.Instance{Id: "4567890123456789012", State: "running", PublicIp: "34.123.45.67", ...}, nil)
+func (g *Gcp) Instance(pathToTerraformState string) (*provider.Instance, error) {
+	oopsBuilder := oops.
+		Code("Instance").
+		In("provider").
+		In("gcp").
+		With("pathToTerraformState", pathToTerraformState)
+
+	gcpInstance, err := terraform_state.GetGcpInstanceFromTerraformState(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read the instance from the terraform state")
+	}
+
+	if gcpInstance == nil {
+		return nil, nil
+	}
+
+	var state string
+	switch gcpInstance.CurrentStatus {
+	case "RUNNING":
+		state = constants.INSTANCE_STATE_RUNNING
+	case "TERMINATED":
+		state = constants.INSTANCE_STATE_STOPPED
+	default:
+		state = strings.ToLower(gcpInstance.CurrentStatus)
+	}
+
+	return &provider.Instance{
+		Id:               gcpInstance.InstanceId,
+		Type:             gcpInstance.MachineType,
+		State:            state,
+		PublicIp:         gcpInstance.NatIp,
+		ImageId:          gcpInstance.Image,
+		AvailabilityZone: gcpInstance.Zone,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package gcp
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider"
+)
+
+const (
+	Cloud iota.Cloud = "gcp"
+)
+
+func init() {
+	provider.Register(NewGcp())
+}
+
+// Returns the Google Cloud provider: an image built with the googlecompute Packer builder, deployed on Compute
+// Engine in its own VPC.
+func NewGcp() *Gcp {
+	return &Gcp{}
+}
+
+func (g *Gcp) Cloud() iota.Cloud {
+	return Cloud
+}
+
+type Gcp struct{}
@@ -0,0 +1,41 @@
+package gcp
+
+import "github.com/ed3899/kumo/config"
+
+// Returns a new PackerGcpEnvironment. The service account key isn't part of it, Packer reads it from the
+// environment, see Credentials.
+func NewPackerGcpEnvironment(
+	_config *config.Config,
+) *PackerGcpEnvironment {
+	return &PackerGcpEnvironment{
+		Required: &PackerGcpRequired{
+			GCP_PROJECT_ID:                 _config.GCP.ProjectId,
+			GCP_ZONE:                       _config.GCP.Zone,
+			GCP_MACHINE_TYPE:               _config.GCP.Compute.Instance.Type,
+			GCP_IMAGE_NAME:                 _config.AMI.Name,
+			GCP_SOURCE_IMAGE_FAMILY:        _config.GCP.Image.Family,
+			GCP_SOURCE_IMAGE_PROJECT_ID:    _config.GCP.Image.Project,
+			GCP_SSH_USERNAME:               _config.AMI.Base.User,
+			GCP_INSTANCE_USERNAME:          _config.AMI.User,
+			GCP_INSTANCE_USERNAME_HOME:     _config.AMI.Home,
+			GCP_INSTANCE_USERNAME_PASSWORD: _config.AMI.Password,
+		},
+	}
+}
+
+type PackerGcpEnvironment struct {
+	Required *PackerGcpRequired
+}
+
+type PackerGcpRequired struct {
+	GCP_PROJECT_ID                 string
+	GCP_ZONE                       string
+	GCP_MACHINE_TYPE               string
+	GCP_IMAGE_NAME                 string
+	GCP_SOURCE_IMAGE_FAMILY        string
+	GCP_SOURCE_IMAGE_PROJECT_ID    string
+	GCP_SSH_USERNAME               string
+	GCP_INSTANCE_USERNAME          string
+	GCP_INSTANCE_USERNAME_HOME     string
+	GCP_INSTANCE_USERNAME_PASSWORD string
+}
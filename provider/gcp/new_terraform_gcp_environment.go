@@ -0,0 +1,85 @@
+package gcp
+
+import (
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/packer_manifest"
+	"github.com/samber/oops"
+)
+
+// Returns a TerraformGcpEnvironment instance. The pathToPackerManifest is used to get the last built image name
+// from the packer manifest.
+func NewTerraformGcpEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (*TerraformGcpEnvironment, error) {
+	oopBuilder := oops.
+		Code("NewTerraformGcpEnvironment").
+		In("provider").
+		In("gcp").
+		Tags("TerraformGcpEnvironment").
+		With("pathToPackerManifest", pathToPackerManifest)
+
+	pickedImageName, err := packer_manifest.GetImageId(pathToPackerManifest, NewGcp().ImageId, _config.Up.AmiId)
+	if err != nil {
+		return nil, oopBuilder.
+			Wrapf(err, "failed to get the image name to deploy")
+	}
+
+	absPathToKey, err := CredentialsFile(_config)
+	if err != nil {
+		return nil, oopBuilder.
+			Wrapf(err, "failed to get the service account key")
+	}
+
+	// The vars quote the path as an HCL string, where the backslashes of a windows path would be escapes.
+	pathToKey := filepath.ToSlash(absPathToKey)
+
+	return &TerraformGcpEnvironment{
+		Required: &TerraformGcpRequired{
+			GCP_PROJECT_ID:       _config.GCP.ProjectId,
+			GCP_CREDENTIALS_FILE: pathToKey,
+			GCP_REGION:           _config.GCP.Region,
+			GCP_ZONE:             _config.GCP.Zone,
+			GCP_MACHINE_TYPE:     _config.GCP.Compute.Instance.Type,
+			IMAGE_NAME:           pickedImageName,
+			KEY_NAME:             _config.ScopedName(constants.KEY_NAME),
+			SSH_PORT:             constants.SSH_PORT,
+			USERNAME:             _config.AMI.User,
+			NAME_TAG:             _config.ScopedName(constants.TERRAFORM_NAME_TAG),
+		},
+		Optional: &TerraformGcpOptional{
+			GCP_DISK_TYPE:               _config.GCP.Compute.Disk.Type,
+			GCP_DISK_SIZE:               _config.GCP.Compute.Disk.Size,
+			IDLE_SHUTDOWN_MINUTES:       _config.IdleShutdown.Minutes,
+			IDLE_SHUTDOWN_CPU_THRESHOLD: _config.IdleShutdown.CpuThreshold,
+		},
+	}, nil
+}
+
+type TerraformGcpEnvironment struct {
+	Required *TerraformGcpRequired
+	Optional *TerraformGcpOptional
+}
+
+type TerraformGcpRequired struct {
+	GCP_PROJECT_ID       string
+	GCP_CREDENTIALS_FILE string
+	GCP_REGION           string
+	GCP_ZONE             string
+	GCP_MACHINE_TYPE     string
+	IMAGE_NAME           string
+	KEY_NAME             string
+	SSH_PORT             int
+	USERNAME             string
+	NAME_TAG             string
+}
+
+type TerraformGcpOptional struct {
+	GCP_DISK_TYPE               string
+	GCP_DISK_SIZE               int
+	IDLE_SHUTDOWN_MINUTES       int
+	IDLE_SHUTDOWN_CPU_THRESHOLD int
+}
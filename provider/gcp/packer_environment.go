@@ -0,0 +1,8 @@
+package gcp
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the gcp Packer template is rendered with, see NewPackerGcpEnvironment.
+func (g *Gcp) PackerEnvironment(_config *config.Config) any {
+	return NewPackerGcpEnvironment(_config)
+}
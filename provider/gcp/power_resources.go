@@ -0,0 +1,8 @@
+package gcp
+
+// Returns the instance, the power state is its desired_status. Changing it also changes the current_status.
+func (g *Gcp) PowerResources() map[string][]string {
+	return map[string][]string{
+		"google_compute_instance.kumo-instance": {"desired_status", "current_status"},
+	}
+}
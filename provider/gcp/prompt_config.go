@@ -0,0 +1,38 @@
+package gcp
+
+import (
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/prompt"
+	"github.com/samber/oops"
+)
+
+// Asks for the GCP values of the config, offering the current ones as defaults.
+func (g *Gcp) PromptConfig(
+	_prompt *prompt.Prompt,
+	_config *config.Config,
+) error {
+	oopsBuilder := oops.
+		Code("PromptConfig").
+		In("provider").
+		In("gcp")
+
+	var err error
+	ask := func(value *string, question string) {
+		if err == nil {
+			*value, err = _prompt.Ask(question, *value)
+		}
+	}
+
+	ask(&_config.GCP.ProjectId, "GCP project id")
+	ask(&_config.GCP.CredentialsFile, "Path to the service account key (JSON)")
+	ask(&_config.GCP.Region, "GCP region")
+	ask(&_config.GCP.Zone, "GCP zone")
+	ask(&_config.GCP.Compute.Instance.Type, "Compute Engine machine type")
+
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for gcp config values")
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package gcp
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the gcp Terraform template is rendered with, see NewTerraformGcpEnvironment.
+func (g *Gcp) TerraformEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (any, error) {
+	terraformGcpEnvironment, err := NewTerraformGcpEnvironment(pathToPackerManifest, _config)
+	if err != nil {
+		return nil, err
+	}
+
+	return terraformGcpEnvironment, nil
+}
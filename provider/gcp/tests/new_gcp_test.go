@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/provider/gcp"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Gcp", Label("unit"), func() {
+	Context("Credentials", func() {
+		It("should return the absolute path to the service account key", func() {
+			_config := newGcpConfig()
+
+			credentials, err := gcp.NewGcp().Credentials(_config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(credentials).To(Equal(map[string]string{
+				"GOOGLE_APPLICATION_CREDENTIALS": _config.GCP.CredentialsFile,
+			}))
+		})
+
+		It("should resolve a relative path from the working directory", func() {
+			_config := newGcpConfig()
+			cwd, err := os.Getwd()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.Chdir(filepath.Dir(_config.GCP.CredentialsFile))).To(Succeed())
+			DeferCleanup(os.Chdir, cwd)
+			_config.GCP.CredentialsFile = "service-account.json"
+
+			credentials, err := gcp.NewGcp().Credentials(_config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filepath.IsAbs(credentials["GOOGLE_APPLICATION_CREDENTIALS"])).To(BeTrue())
+		})
+
+		It("should reject a file that isn't a service account key", func() {
+			_config := newGcpConfig()
+			Expect(os.WriteFile(_config.GCP.CredentialsFile, []byte(`{"type": "authorized_user"}`), 0600)).To(Succeed())
+
+			_, err := gcp.NewGcp().Credentials(_config)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	It("should return the image name of an artifact", func() {
+		imageName, err := gcp.NewGcp().ImageId("kumo-20231001120000")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(imageName).To(Equal("kumo-20231001120000"))
+
+		_, err = gcp.NewGcp().ImageId("")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should report a terminated instance as stopped", func() {
+		pathToState := filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+		state := `{"resources": [{"mode": "managed", "type": "google_compute_instance", "name": "kumo-instance", "instances": [{"attributes": {"instance_id": "4567890123456789012", "machine_type": "e2-micro", "current_status": "TERMINATED", "zone": "us-central1-a"}}]}]}`
+		Expect(os.WriteFile(pathToState, []byte(state), 0644)).To(Succeed())
+
+		instance, err := gcp.NewGcp().Instance(pathToState)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance.Id).To(Equal("4567890123456789012"))
+		Expect(instance.State).To(Equal("stopped"))
+		Expect(instance.AvailabilityZone).To(Equal("us-central1-a"))
+	})
+})
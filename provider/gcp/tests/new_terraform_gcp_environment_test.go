@@ -0,0 +1,45 @@
+package tests
+
+import (
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/provider/gcp"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewTerraformGcpEnvironment", Label("unit"), func() {
+	It("should deploy the image of the last googlecompute build", func() {
+		_config := newGcpConfig()
+		pathToManifest := writePackerManifest("kumo-20231002120000")
+
+		terraformGcpEnvironment, err := gcp.NewTerraformGcpEnvironment(pathToManifest, _config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(terraformGcpEnvironment.Required.IMAGE_NAME).To(Equal("kumo-20231002120000"))
+		Expect(terraformGcpEnvironment.Required.GCP_CREDENTIALS_FILE).To(Equal(filepath.ToSlash(_config.GCP.CredentialsFile)))
+		Expect(terraformGcpEnvironment.Required.GCP_MACHINE_TYPE).To(Equal("e2-micro"))
+		Expect(terraformGcpEnvironment.Optional.GCP_DISK_TYPE).To(Equal("pd-balanced"))
+		Expect(terraformGcpEnvironment.Optional.GCP_DISK_SIZE).To(Equal(10))
+	})
+
+	It("should write the key path with forward slashes, the backslashes of windows paths are HCL escapes", func() {
+		_config := newGcpConfig()
+		if runtime.GOOS == "windows" {
+			Expect(_config.GCP.CredentialsFile).To(ContainSubstring(`\`))
+		}
+
+		terraformGcpEnvironment, err := gcp.NewTerraformGcpEnvironment(writePackerManifest("kumo-20231002120000"), _config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(terraformGcpEnvironment.Required.GCP_CREDENTIALS_FILE).NotTo(ContainSubstring(`\`))
+		Expect(terraformGcpEnvironment.Required.GCP_CREDENTIALS_FILE).To(HaveSuffix("/service-account.json"))
+	})
+
+	It("should return an error when the service account key is missing", func() {
+		_config := newGcpConfig()
+		_config.GCP.CredentialsFile = "missing.json"
+
+		_, err := gcp.NewTerraformGcpEnvironment(writePackerManifest("kumo-20231002120000"), _config)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,52 @@
+package gcp
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ed3899/kumo/config"
+)
+
+var (
+	projectIdPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{4,28}[a-z0-9]$`)
+	regionPattern    = regexp.MustCompile(`^[a-z]+-[a-z]+\d+$`)
+	zonePattern      = regexp.MustCompile(`^[a-z]+-[a-z]+\d+-[a-z]$`)
+	imagePattern     = regexp.MustCompile(`^[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?$`)
+	// Packer appends a 15 character timestamp to the name, images are at most 63 characters long.
+	imageNamePattern = regexp.MustCompile(`^[a-z](?:[-a-z0-9]{0,46}[a-z0-9])?$`)
+)
+
+// Returns the problems of the GCP section and of the image names.
+func (g *Gcp) Validate(_config *config.Config) config.Problems {
+	rules := config.NewRules()
+	gcp := &_config.GCP
+
+	rules.Required("GCP.ProjectId", gcp.ProjectId)
+	rules.Match("GCP.ProjectId", gcp.ProjectId, projectIdPattern, "must be 6 to 30 lowercase letters, digits or '-' (hyphen), starting with a letter")
+
+	rules.Required("GCP.CredentialsFile", gcp.CredentialsFile)
+
+	rules.Required("GCP.Region", gcp.Region)
+	rules.Match("GCP.Region", gcp.Region, regionPattern, "must be a valid region (i.e us-central1)")
+
+	rules.Required("GCP.Zone", gcp.Zone)
+	rules.Match("GCP.Zone", gcp.Zone, zonePattern, "must be a valid zone (i.e us-central1-a)")
+	if gcp.Region != "" && gcp.Zone != "" && !strings.HasPrefix(gcp.Zone, gcp.Region+"-") {
+		rules.Report("GCP.Zone", "must be a zone of the region %s", gcp.Region)
+	}
+
+	rules.Required("GCP.Image.Family", gcp.Image.Family)
+	rules.Required("GCP.Image.Project", gcp.Image.Project)
+
+	rules.Required("GCP.Compute.Instance.Type", gcp.Compute.Instance.Type)
+
+	if gcp.Compute.Disk.Size < minimumDiskSize {
+		rules.Report("GCP.Compute.Disk.Size", "must be greater than or equal to %d", minimumDiskSize)
+	}
+
+	rules.Match("AMI.Name", _config.AMI.Name, imageNamePattern, "must be 1 to 48 lowercase letters, digits or '-' (hyphen) on gcp, starting with a letter")
+	rules.Required("AMI.Base.User", _config.AMI.Base.User)
+	rules.Match("Up.AmiId", _config.Up.AmiId, imagePattern, "must be a valid image name (i.e kumo-1700000000)")
+
+	return rules.Problems()
+}
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/samber/oops"
+)
+
+// Returns the provider registered under the cloud.
+//
+// Example:
+//
+//	("aws") -> (&aws.Aws{}, nil)
+func Get(cloud iota.Cloud) (Provider, error) {
+	oopsBuilder := oops.
+		Code("Get").
+		In("provider").
+		Tags("Provider").
+		With("cloud", cloud)
+
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+
+	_provider, found := providers[cloud]
+	if !found {
+		return nil, oopsBuilder.
+			Errorf("unknown cloud: %q", cloud)
+	}
+
+	return _provider, nil
+}
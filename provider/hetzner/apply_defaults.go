@@ -0,0 +1,6 @@
+package hetzner
+
+import "github.com/ed3899/kumo/config"
+
+// Nothing to fill, every value of the Hetzner section is required.
+func (h *Hetzner) ApplyDefaults(_config *config.Config) {}
@@ -0,0 +1,14 @@
+package hetzner
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the API token of the config as the environment variable read by Packer and Terraform.
+//
+// Example:
+//
+//	(&config.Config{Hetzner: config.Hetzner{Token: "jEheVytlAoFl7F8MqUQ7jAo2hOXASztX...", ...}}) -> (map[string]string{"HCLOUD_TOKEN": "jEheVytlAoFl7F8MqUQ7jAo2hOXASztX..."}, nil)
+func (h *Hetzner) Credentials(_config *config.Config) (map[string]string, error) {
+	return map[string]string{
+		"HCLOUD_TOKEN": _config.Hetzner.Token,
+	}, nil
+}
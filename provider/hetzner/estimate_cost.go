@@ -0,0 +1,39 @@
+package hetzner
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/price_table"
+	"github.com/samber/oops"
+)
+
+// Returns the hourly cost of the server the tool runs. The disk and the traffic are included in the server price,
+// so no volume is estimated.
+//
+// Example:
+//
+//	(priceTable, iota.Terraform, _config) -> (&price_table.Estimate{Currency: "EUR", Region: "fsn1", InstanceType: "cx22", ...}, nil)
+func (h *Hetzner) EstimateCost(
+	priceTable *price_table.PriceTable,
+	tool iota.Tool,
+	_config *config.Config,
+) (*price_table.Estimate, error) {
+	oopsBuilder := oops.
+		Code("EstimateCost").
+		In("provider").
+		In("hetzner").
+		With("tool", tool)
+
+	estimate, err := priceTable.Estimate(
+		_config.Hetzner.Location,
+		_config.Hetzner.ServerType,
+		"",
+		0,
+	)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to estimate the cost")
+	}
+
+	return estimate, nil
+}
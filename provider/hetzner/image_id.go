@@ -0,0 +1,31 @@
+package hetzner
+
+import (
+	"regexp"
+
+	"github.com/samber/oops"
+)
+
+var (
+	snapshotIdPattern = regexp.MustCompile(`^\d+$`)
+)
+
+// Returns the snapshot ID from the artifact id of an hcloud build, which is the ID itself.
+//
+// Example:
+//
+//	("135790864") -> ("135790864", nil)
+func (h *Hetzner) ImageId(artifactId string) (string, error) {
+	oopsBuilder := oops.
+		Code("ImageId").
+		In("provider").
+		In("hetzner").
+		With("artifactId", artifactId)
+
+	if !snapshotIdPattern.MatchString(artifactId) {
+		return "", oopsBuilder.
+			Errorf("artifact id %q isn't a snapshot ID", artifactId)
+	}
+
+	return artifactId, nil
+}
@@ -0,0 +1,45 @@
+package hetzner
+
+import (
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/provider"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/samber/oops"
+)
+
+// Returns the Hetzner Cloud server recorded in the Terraform state, or nil if there is none, i.e after a destroy.
+//
+// Example:
+//
+//	("terraform/hetzner/terraform.tfstate") -> (&provider.Instance{Id: "42424242", State: "running", PublicIp: "49.12.10.5", ...}, nil)
+func (h *Hetzner) Instance(pathToTerraformState string) (*provider.Instance, error) {
+	oopsBuilder := oops.
+		Code("Instance").
+		In("provider").
+		In("hetzner").
+		With("pathToTerraformState", pathToTerraformState)
+
+	hetznerInstance, err := terraform_state.GetHetznerInstanceFromTerraformState(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read the instance from the terraform state")
+	}
+
+	if hetznerInstance == nil {
+		return nil, nil
+	}
+
+	state := constants.INSTANCE_STATE_RUNNING
+	if hetznerInstance.InstanceState == constants.INSTANCE_STATE_STOPPED {
+		state = constants.INSTANCE_STATE_STOPPED
+	}
+
+	return &provider.Instance{
+		Id:               hetznerInstance.ServerId,
+		Type:             hetznerInstance.ServerType,
+		State:            state,
+		PublicIp:         hetznerInstance.Ipv4Address,
+		ImageId:          hetznerInstance.Image,
+		AvailabilityZone: hetznerInstance.Location,
+	}, nil
+}
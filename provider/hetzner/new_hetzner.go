@@ -0,0 +1,26 @@
+package hetzner
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider"
+)
+
+const (
+	Cloud iota.Cloud = "hetzner"
+)
+
+func init() {
+	provider.Register(NewHetzner())
+}
+
+// Returns the Hetzner Cloud provider: a snapshot built with the hcloud Packer builder, deployed on a server
+// behind a firewall, without a private network.
+func NewHetzner() *Hetzner {
+	return &Hetzner{}
+}
+
+func (h *Hetzner) Cloud() iota.Cloud {
+	return Cloud
+}
+
+type Hetzner struct{}
@@ -0,0 +1,38 @@
+package hetzner
+
+import "github.com/ed3899/kumo/config"
+
+// Returns a new PackerHetznerEnvironment.
+func NewPackerHetznerEnvironment(
+	_config *config.Config,
+) *PackerHetznerEnvironment {
+	return &PackerHetznerEnvironment{
+		Required: &PackerHetznerRequired{
+			HCLOUD_TOKEN:                       _config.Hetzner.Token,
+			HETZNER_LOCATION:                   _config.Hetzner.Location,
+			HETZNER_SERVER_TYPE:                _config.Hetzner.ServerType,
+			HETZNER_BASE_IMAGE:                 _config.Hetzner.Image,
+			HETZNER_SNAPSHOT_NAME:              _config.AMI.Name,
+			HETZNER_SSH_USERNAME:               _config.AMI.Base.User,
+			HETZNER_INSTANCE_USERNAME:          _config.AMI.User,
+			HETZNER_INSTANCE_USERNAME_HOME:     _config.AMI.Home,
+			HETZNER_INSTANCE_USERNAME_PASSWORD: _config.AMI.Password,
+		},
+	}
+}
+
+type PackerHetznerEnvironment struct {
+	Required *PackerHetznerRequired
+}
+
+type PackerHetznerRequired struct {
+	HCLOUD_TOKEN                       string
+	HETZNER_LOCATION                   string
+	HETZNER_SERVER_TYPE                string
+	HETZNER_BASE_IMAGE                 string
+	HETZNER_SNAPSHOT_NAME              string
+	HETZNER_SSH_USERNAME               string
+	HETZNER_INSTANCE_USERNAME          string
+	HETZNER_INSTANCE_USERNAME_HOME     string
+	HETZNER_INSTANCE_USERNAME_PASSWORD string
+}
@@ -0,0 +1,56 @@
+package hetzner
+
+import (
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/packer_manifest"
+	"github.com/samber/oops"
+)
+
+// Returns a TerraformHetznerEnvironment instance. The pathToPackerManifest is used to get the last built snapshot
+// ID from the packer manifest.
+func NewTerraformHetznerEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (*TerraformHetznerEnvironment, error) {
+	oopBuilder := oops.
+		Code("NewTerraformHetznerEnvironment").
+		In("provider").
+		In("hetzner").
+		Tags("TerraformHetznerEnvironment").
+		With("pathToPackerManifest", pathToPackerManifest)
+
+	pickedSnapshotId, err := packer_manifest.GetImageId(pathToPackerManifest, NewHetzner().ImageId, _config.Up.AmiId)
+	if err != nil {
+		return nil, oopBuilder.
+			Wrapf(err, "failed to get the snapshot id to deploy")
+	}
+
+	return &TerraformHetznerEnvironment{
+		Required: &TerraformHetznerRequired{
+			HCLOUD_TOKEN:        _config.Hetzner.Token,
+			HETZNER_LOCATION:    _config.Hetzner.Location,
+			HETZNER_SERVER_TYPE: _config.Hetzner.ServerType,
+			SNAPSHOT_ID:         pickedSnapshotId,
+			KEY_NAME:            _config.ScopedName(constants.KEY_NAME),
+			SSH_PORT:            constants.SSH_PORT,
+			USERNAME:            _config.AMI.User,
+			NAME_TAG:            _config.ScopedName(constants.TERRAFORM_NAME_TAG),
+		},
+	}, nil
+}
+
+type TerraformHetznerEnvironment struct {
+	Required *TerraformHetznerRequired
+}
+
+type TerraformHetznerRequired struct {
+	HCLOUD_TOKEN        string
+	HETZNER_LOCATION    string
+	HETZNER_SERVER_TYPE string
+	SNAPSHOT_ID         string
+	KEY_NAME            string
+	SSH_PORT            int
+	USERNAME            string
+	NAME_TAG            string
+}
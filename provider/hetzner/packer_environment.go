@@ -0,0 +1,8 @@
+package hetzner
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the hetzner Packer template is rendered with, see NewPackerHetznerEnvironment.
+func (h *Hetzner) PackerEnvironment(_config *config.Config) any {
+	return NewPackerHetznerEnvironment(_config)
+}
@@ -0,0 +1,8 @@
+package hetzner
+
+// Returns the null resource that powers the server on or off through the Hetzner Cloud API.
+func (h *Hetzner) PowerResources() map[string][]string {
+	return map[string][]string{
+		"null_resource.kumo-server-power": nil,
+	}
+}
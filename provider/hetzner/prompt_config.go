@@ -0,0 +1,42 @@
+package hetzner
+
+import (
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/prompt"
+	"github.com/samber/oops"
+)
+
+// Asks for the Hetzner Cloud values of the config, offering the current ones as defaults.
+func (h *Hetzner) PromptConfig(
+	_prompt *prompt.Prompt,
+	_config *config.Config,
+) error {
+	oopsBuilder := oops.
+		Code("PromptConfig").
+		In("provider").
+		In("hetzner")
+
+	token, err := _prompt.AskSecret("Hetzner Cloud API token", _config.Hetzner.Token)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for hetzner config values")
+	}
+
+	location, err := _prompt.Ask("Hetzner Cloud location", _config.Hetzner.Location)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for hetzner config values")
+	}
+
+	serverType, err := _prompt.Ask("Server type", _config.Hetzner.ServerType)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for hetzner config values")
+	}
+
+	_config.Hetzner.Token = token
+	_config.Hetzner.Location = location
+	_config.Hetzner.ServerType = serverType
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package hetzner
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the user created on the snapshot, which is the one kumo connects as.
+func (h *Hetzner) SshUser(_config *config.Config) string {
+	return _config.AMI.User
+}
@@ -0,0 +1,16 @@
+package hetzner
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the hetzner Terraform template is rendered with, see NewTerraformHetznerEnvironment.
+func (h *Hetzner) TerraformEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (any, error) {
+	terraformHetznerEnvironment, err := NewTerraformHetznerEnvironment(pathToPackerManifest, _config)
+	if err != nil {
+		return nil, err
+	}
+
+	return terraformHetznerEnvironment, nil
+}
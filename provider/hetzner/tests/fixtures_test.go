@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/test_helpers"
+)
+
+const (
+	token           = "jEheVytlAoFl7F8MqUQ7jAo2hOXASztX3KgKQ8RYbSFuGFrvxGvMt1ZQdaxPSYfN"
+	firstSnapshotId = "135790864"
+	lastSnapshotId  = "135791203"
+)
+
+// Returns a hetzner config.
+func newHetznerConfig() *config.Config {
+	return &config.Config{
+		Cloud: "hetzner",
+		Hetzner: config.Hetzner{
+			Token:      token,
+			Location:   "fsn1",
+			ServerType: "cx22",
+			Image:      "ubuntu-22.04",
+		},
+		AMI: config.Ami{
+			Name: "kumo",
+			Base: config.AmiBase{
+				User: "root",
+			},
+			User:     "dev",
+			Home:     "dev",
+			Password: "password123",
+			Tools:    []string{"go"},
+		},
+		Git: config.Git{
+			Username: "dev",
+			Email:    "dev@kumo.com",
+		},
+	}
+}
+
+// Writes a manifest of two hcloud builds, the last run built lastSnapshotId.
+func writePackerManifest(lastSnapshotId string) string {
+	return test_helpers.WritePackerManifest("hcloud", firstSnapshotId, lastSnapshotId)
+}
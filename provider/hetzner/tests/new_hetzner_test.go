@@ -0,0 +1,59 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider/hetzner"
+	"github.com/ed3899/kumo/utils/price_table"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Hetzner", Label("unit"), func() {
+	It("should pass the token as HCLOUD_TOKEN", func() {
+		credentials, err := hetzner.NewHetzner().Credentials(newHetznerConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials).To(Equal(map[string]string{"HCLOUD_TOKEN": token}))
+	})
+
+	It("should return the snapshot ID of an artifact", func() {
+		snapshotId, err := hetzner.NewHetzner().ImageId(lastSnapshotId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshotId).To(Equal(lastSnapshotId))
+
+		_, err = hetzner.NewHetzner().ImageId("us-east-1:ami-0123456789abcdef0")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should estimate the server of the config", func() {
+		priceTable, err := price_table.ReadPriceTable(filepath.Join("..", "..", "..", "pricing", "hetzner.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		estimate, err := hetzner.NewHetzner().EstimateCost(priceTable, iota.Terraform, newHetznerConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(estimate.Currency).To(Equal("EUR"))
+		Expect(estimate.Hourly()).To(BeNumerically("~", 0.006))
+	})
+
+	DescribeTable("should report the state kumo put the server in",
+		func(power string, expected string) {
+			pathToState := filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+			state := `{"resources": [
+				{"mode": "managed", "type": "hcloud_server", "name": "kumo-server", "instances": [{"attributes": {"id": "42424242", "server_type": "cx22", "location": "fsn1", "ipv4_address": "49.12.10.5", "image": "` + lastSnapshotId + `"}}]}` + power + `
+			]}`
+			Expect(os.WriteFile(pathToState, []byte(state), 0644)).To(Succeed())
+
+			instance, err := hetzner.NewHetzner().Instance(pathToState)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.Id).To(Equal("42424242"))
+			Expect(instance.State).To(Equal(expected))
+			Expect(instance.PublicIp).To(Equal("49.12.10.5"))
+			Expect(instance.ImageId).To(Equal(lastSnapshotId))
+			Expect(instance.AvailabilityZone).To(Equal("fsn1"))
+		},
+		Entry("just created", "", "running"),
+		Entry("stopped", `, {"mode": "managed", "type": "null_resource", "name": "kumo-server-power", "instances": [{"attributes": {"triggers": {"instance_state": "stopped"}}}]}`, "stopped"),
+	)
+})
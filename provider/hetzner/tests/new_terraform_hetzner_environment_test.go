@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/provider/hetzner"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewTerraformHetznerEnvironment", Label("unit"), func() {
+	It("should deploy the snapshot of the last hcloud build", func() {
+		terraformHetznerEnvironment, err := hetzner.NewTerraformHetznerEnvironment(writePackerManifest(lastSnapshotId), newHetznerConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(terraformHetznerEnvironment.Required.SNAPSHOT_ID).To(Equal(lastSnapshotId))
+		Expect(terraformHetznerEnvironment.Required.HETZNER_SERVER_TYPE).To(Equal("cx22"))
+		Expect(terraformHetznerEnvironment.Required.USERNAME).To(Equal("dev"))
+	})
+})
@@ -0,0 +1,13 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHetzner(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Hetzner Suite", Label("provider", "hetzner"))
+}
@@ -0,0 +1,40 @@
+package hetzner
+
+import (
+	"regexp"
+
+	"github.com/ed3899/kumo/config"
+)
+
+var (
+	tokenPattern    = regexp.MustCompile(`^[a-zA-Z0-9]{64}$`)
+	locationPattern = regexp.MustCompile(`^[a-z]+[a-z0-9]*$`)
+	// The name is the value of the kumo-image label of the snapshots.
+	labelPattern = regexp.MustCompile(`^[a-zA-Z0-9](?:[-_.a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$`)
+)
+
+// Returns the problems of the Hetzner section, of the snapshot label and of the idle shutdown.
+func (h *Hetzner) Validate(_config *config.Config) config.Problems {
+	rules := config.NewRules()
+	hetzner := &_config.Hetzner
+
+	rules.Required("Hetzner.Token", hetzner.Token)
+	rules.Match("Hetzner.Token", hetzner.Token, tokenPattern, "must be a 64 characters API token, created from Security > API tokens")
+
+	rules.Required("Hetzner.Location", hetzner.Location)
+	rules.Match("Hetzner.Location", hetzner.Location, locationPattern, "must be a valid location (i.e fsn1)")
+
+	rules.Required("Hetzner.ServerType", hetzner.ServerType)
+	rules.Required("Hetzner.Image", hetzner.Image)
+
+	rules.Match("AMI.Name", _config.AMI.Name, labelPattern, "must be 1 to 63 alphanumeric characters, '_', '.' or '-' on hetzner, starting and ending with an alphanumeric character")
+	rules.Required("AMI.Base.User", _config.AMI.Base.User)
+	rules.Match("Up.AmiId", _config.Up.AmiId, snapshotIdPattern, "must be a snapshot ID (i.e 135790864)")
+
+	// Stopped servers are billed the same as running ones.
+	if _config.IdleShutdown.Minutes > 0 {
+		rules.Report("IdleShutdown.Minutes", "isn't supported on hetzner, stopped servers are still billed")
+	}
+
+	return rules.Problems()
+}
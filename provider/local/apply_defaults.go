@@ -0,0 +1,14 @@
+package local
+
+import "github.com/ed3899/kumo/config"
+
+const (
+	defaultSshPort = 2222
+)
+
+// Fills the port the ssh port of the container is published on when left empty.
+func (l *Local) ApplyDefaults(_config *config.Config) {
+	if _config.Local.SshPort == 0 {
+		_config.Local.SshPort = defaultSshPort
+	}
+}
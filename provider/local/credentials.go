@@ -0,0 +1,8 @@
+package local
+
+import "github.com/ed3899/kumo/config"
+
+// Returns no credentials, Packer and Terraform reach the docker engine through DOCKER_HOST like the docker CLI.
+func (l *Local) Credentials(_config *config.Config) (map[string]string, error) {
+	return map[string]string{}, nil
+}
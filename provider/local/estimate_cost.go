@@ -0,0 +1,30 @@
+package local
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/price_table"
+)
+
+const (
+	Region       = "local"
+	InstanceType = "container"
+)
+
+// Returns a free estimate, the container runs on the host.
+//
+// Example:
+//
+//	(priceTable, iota.Terraform, _config) -> (&price_table.Estimate{Region: "local", InstanceType: "container", InstanceHourly: 0, ...}, nil)
+func (l *Local) EstimateCost(
+	priceTable *price_table.PriceTable,
+	tool iota.Tool,
+	_config *config.Config,
+) (*price_table.Estimate, error) {
+	return &price_table.Estimate{
+		Currency:     priceTable.Currency,
+		Updated:      priceTable.Updated,
+		Region:       Region,
+		InstanceType: InstanceType,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package local
+
+import (
+	"regexp"
+
+	"github.com/samber/oops"
+)
+
+var (
+	imageIdPattern = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+)
+
+// Returns the docker image ID from the artifact id of a docker build, which is the ID of the committed image.
+//
+// Example:
+//
+//	("sha256:3f57d9401f8d42f986df300f0c69192fc41da28ccc8d797829467780db3dd741") -> ("sha256:3f57d9401f8d...", nil)
+func (l *Local) ImageId(artifactId string) (string, error) {
+	oopsBuilder := oops.
+		Code("ImageId").
+		In("provider").
+		In("local").
+		With("artifactId", artifactId)
+
+	if !imageIdPattern.MatchString(artifactId) {
+		return "", oopsBuilder.
+			Errorf("artifact id %q isn't a docker image ID", artifactId)
+	}
+
+	return artifactId, nil
+}
@@ -0,0 +1,51 @@
+package local
+
+import (
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/provider"
+	"github.com/ed3899/kumo/utils/terraform_state"
+	"github.com/samber/oops"
+)
+
+// Returns the container recorded in the Terraform state, or nil if there is none, i.e after a destroy. It's
+// reached on 127.0.0.1.
+//
+// Example:
+//
+//	("terraform/local/terraform.tfstate") -> (&provider.Instance{Id: "5f1c0a7e9b2d", State: "running", PublicIp: "127.0.0.1", ...}, nil)
+func (l *Local) Instance(pathToTerraformState string) (*provider.Instance, error) {
+	oopsBuilder := oops.
+		Code("Instance").
+		In("provider").
+		In("local").
+		With("pathToTerraformState", pathToTerraformState)
+
+	localInstance, err := terraform_state.GetLocalInstanceFromTerraformState(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read the instance from the terraform state")
+	}
+
+	if localInstance == nil {
+		return nil, nil
+	}
+
+	state := constants.INSTANCE_STATE_RUNNING
+	if localInstance.InstanceState == constants.INSTANCE_STATE_STOPPED {
+		state = constants.INSTANCE_STATE_STOPPED
+	}
+
+	publicIp := ""
+	if len(localInstance.Ports) > 0 {
+		publicIp = localInstance.Ports[0].Ip
+	}
+
+	return &provider.Instance{
+		Id:               localInstance.ContainerId,
+		Type:             InstanceType,
+		State:            state,
+		PublicIp:         publicIp,
+		ImageId:          localInstance.Image,
+		AvailabilityZone: Region,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package local
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider"
+)
+
+const (
+	Cloud iota.Cloud = "local"
+)
+
+func init() {
+	provider.Register(NewLocal())
+}
+
+// Returns the local provider: an image built with the docker Packer builder, run as a container on the docker
+// engine of the host. Nothing is billed and no cloud credentials are needed.
+func NewLocal() *Local {
+	return &Local{}
+}
+
+func (l *Local) Cloud() iota.Cloud {
+	return Cloud
+}
+
+type Local struct{}
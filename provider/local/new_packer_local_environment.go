@@ -0,0 +1,30 @@
+package local
+
+import "github.com/ed3899/kumo/config"
+
+// Returns a new PackerLocalEnvironment. The docker builder runs the provisioners as root, so AMI.Base isn't read.
+func NewPackerLocalEnvironment(
+	_config *config.Config,
+) *PackerLocalEnvironment {
+	return &PackerLocalEnvironment{
+		Required: &PackerLocalRequired{
+			LOCAL_BASE_IMAGE:                 _config.Local.Image,
+			LOCAL_IMAGE_NAME:                 _config.AMI.Name,
+			LOCAL_INSTANCE_USERNAME:          _config.AMI.User,
+			LOCAL_INSTANCE_USERNAME_HOME:     _config.AMI.Home,
+			LOCAL_INSTANCE_USERNAME_PASSWORD: _config.AMI.Password,
+		},
+	}
+}
+
+type PackerLocalEnvironment struct {
+	Required *PackerLocalRequired
+}
+
+type PackerLocalRequired struct {
+	LOCAL_BASE_IMAGE                 string
+	LOCAL_IMAGE_NAME                 string
+	LOCAL_INSTANCE_USERNAME          string
+	LOCAL_INSTANCE_USERNAME_HOME     string
+	LOCAL_INSTANCE_USERNAME_PASSWORD string
+}
@@ -0,0 +1,52 @@
+package local
+
+import (
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/packer_manifest"
+	"github.com/samber/oops"
+)
+
+// Returns a TerraformLocalEnvironment instance. The pathToPackerManifest is used to get the last built docker
+// image ID from the packer manifest.
+func NewTerraformLocalEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (*TerraformLocalEnvironment, error) {
+	oopBuilder := oops.
+		Code("NewTerraformLocalEnvironment").
+		In("provider").
+		In("local").
+		Tags("TerraformLocalEnvironment").
+		With("pathToPackerManifest", pathToPackerManifest)
+
+	pickedImageId, err := packer_manifest.GetImageId(pathToPackerManifest, NewLocal().ImageId, _config.Up.AmiId)
+	if err != nil {
+		return nil, oopBuilder.
+			Wrapf(err, "failed to get the docker image id to deploy")
+	}
+
+	return &TerraformLocalEnvironment{
+		Required: &TerraformLocalRequired{
+			IMAGE_ID:       pickedImageId,
+			LOCAL_SSH_PORT: _config.Local.SshPort,
+			KEY_NAME:       _config.ScopedName(constants.KEY_NAME),
+			SSH_PORT:       constants.SSH_PORT,
+			USERNAME:       _config.AMI.User,
+			NAME_TAG:       _config.ScopedName(constants.TERRAFORM_NAME_TAG),
+		},
+	}, nil
+}
+
+type TerraformLocalEnvironment struct {
+	Required *TerraformLocalRequired
+}
+
+type TerraformLocalRequired struct {
+	IMAGE_ID       string
+	LOCAL_SSH_PORT int
+	KEY_NAME       string
+	SSH_PORT       int
+	USERNAME       string
+	NAME_TAG       string
+}
@@ -0,0 +1,8 @@
+package local
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the local Packer template is rendered with, see NewPackerLocalEnvironment.
+func (l *Local) PackerEnvironment(_config *config.Config) any {
+	return NewPackerLocalEnvironment(_config)
+}
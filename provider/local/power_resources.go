@@ -0,0 +1,8 @@
+package local
+
+// Returns the null resource that starts or stops the container with the docker CLI.
+func (l *Local) PowerResources() map[string][]string {
+	return map[string][]string{
+		"null_resource.kumo-container-power": nil,
+	}
+}
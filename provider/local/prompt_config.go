@@ -0,0 +1,44 @@
+package local
+
+import (
+	"strconv"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/prompt"
+	"github.com/samber/oops"
+)
+
+// Asks for the local values of the config, offering the current ones as defaults.
+func (l *Local) PromptConfig(
+	_prompt *prompt.Prompt,
+	_config *config.Config,
+) error {
+	oopsBuilder := oops.
+		Code("PromptConfig").
+		In("provider").
+		In("local")
+
+	image, err := _prompt.Ask("Docker image to build from", _config.Local.Image)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for local config values")
+	}
+
+	sshPort, err := _prompt.Ask("Port on 127.0.0.1 to ssh into the container", strconv.Itoa(_config.Local.SshPort))
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to prompt for local config values")
+	}
+
+	port, err := strconv.Atoi(sshPort)
+	if err != nil {
+		return oopsBuilder.
+			With("sshPort", sshPort).
+			Wrapf(err, "the ssh port must be a number")
+	}
+
+	_config.Local.Image = image
+	_config.Local.SshPort = port
+
+	return nil
+}
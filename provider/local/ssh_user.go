@@ -0,0 +1,8 @@
+package local
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the user created on the image, which is the one kumo connects as.
+func (l *Local) SshUser(_config *config.Config) string {
+	return _config.AMI.User
+}
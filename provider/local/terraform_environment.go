@@ -0,0 +1,16 @@
+package local
+
+import "github.com/ed3899/kumo/config"
+
+// Returns the data the local Terraform template is rendered with, see NewTerraformLocalEnvironment.
+func (l *Local) TerraformEnvironment(
+	pathToPackerManifest string,
+	_config *config.Config,
+) (any, error) {
+	terraformLocalEnvironment, err := NewTerraformLocalEnvironment(pathToPackerManifest, _config)
+	if err != nil {
+		return nil, err
+	}
+
+	return terraformLocalEnvironment, nil
+}
@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/test_helpers"
+)
+
+const (
+	firstImageId = "sha256:1e2d3c4b5a6978877665544332211000ffeeddccbbaa99887766554433221100"
+	lastImageId  = "sha256:3f57d9401f8d42f986df300f0c69192fc41da28ccc8d797829467780db3dd741"
+)
+
+// Returns a local config.
+func newLocalConfig() *config.Config {
+	return &config.Config{
+		Cloud: "local",
+		Local: config.Local{
+			Image:   "ubuntu:22.04",
+			SshPort: 2222,
+		},
+		AMI: config.Ami{
+			Name:     "kumo",
+			User:     "dev",
+			Home:     "dev",
+			Password: "password123",
+			Tools:    []string{"go"},
+		},
+		Git: config.Git{
+			Username: "dev",
+			Email:    "dev@kumo.com",
+		},
+	}
+}
+
+// Writes a manifest of two docker builds, the last run built lastImageId.
+func writePackerManifest(lastImageId string) string {
+	return test_helpers.WritePackerManifest("docker", firstImageId, lastImageId)
+}
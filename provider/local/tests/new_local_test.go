@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/provider/local"
+	"github.com/ed3899/kumo/utils/price_table"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Local", Label("unit"), func() {
+	It("should need no credentials", func() {
+		credentials, err := local.NewLocal().Credentials(newLocalConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(credentials).To(BeEmpty())
+	})
+
+	It("should return the docker image ID of an artifact", func() {
+		imageId, err := local.NewLocal().ImageId(lastImageId)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(imageId).To(Equal(lastImageId))
+
+		_, err = local.NewLocal().ImageId("kumo:20231001120000")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should estimate nothing to pay", func() {
+		priceTable, err := price_table.ReadPriceTable(filepath.Join("..", "..", "..", "pricing", "local.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		estimate, err := local.NewLocal().EstimateCost(priceTable, iota.Terraform, newLocalConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(estimate.Hourly()).To(BeZero())
+	})
+
+	DescribeTable("should report the state kumo put the container in",
+		func(power string, expected string) {
+			pathToState := filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+			state := `{"resources": [
+				{"mode": "managed", "type": "docker_container", "name": "kumo-container", "instances": [{"attributes": {"id": "5f1c0a7e9b2d", "image": "` + lastImageId + `", "ports": [{"internal": 22, "external": 2222, "ip": "127.0.0.1"}]}}]}` + power + `
+			]}`
+			Expect(os.WriteFile(pathToState, []byte(state), 0644)).To(Succeed())
+
+			instance, err := local.NewLocal().Instance(pathToState)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instance.Id).To(Equal("5f1c0a7e9b2d"))
+			Expect(instance.State).To(Equal(expected))
+			Expect(instance.PublicIp).To(Equal("127.0.0.1"))
+			Expect(instance.ImageId).To(Equal(lastImageId))
+		},
+		Entry("just created", "", "running"),
+		Entry("stopped", `, {"mode": "managed", "type": "null_resource", "name": "kumo-container-power", "instances": [{"attributes": {"triggers": {"instance_state": "stopped"}}}]}`, "stopped"),
+	)
+})
@@ -0,0 +1,17 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/provider/local"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewTerraformLocalEnvironment", Label("unit"), func() {
+	It("should run the image of the last docker build", func() {
+		terraformLocalEnvironment, err := local.NewTerraformLocalEnvironment(writePackerManifest(lastImageId), newLocalConfig())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(terraformLocalEnvironment.Required.IMAGE_ID).To(Equal(lastImageId))
+		Expect(terraformLocalEnvironment.Required.LOCAL_SSH_PORT).To(Equal(2222))
+		Expect(terraformLocalEnvironment.Required.USERNAME).To(Equal("dev"))
+	})
+})
@@ -0,0 +1,37 @@
+package local
+
+import (
+	"regexp"
+
+	"github.com/ed3899/kumo/config"
+)
+
+var (
+	imagePattern = regexp.MustCompile(`^[a-z0-9]+(?:[._/:-][a-zA-Z0-9]+)*(?:@sha256:[0-9a-f]{64})?$`)
+	// Images are tagged with the name as repository, which must be lowercase.
+	repositoryPattern = regexp.MustCompile(`^[a-z0-9]+(?:[._-][a-z0-9]+)*$`)
+)
+
+// Returns the problems of the Local section, of the image repository and of the idle shutdown. AMI.Base.User
+// isn't required, the docker builder provisions as root.
+func (l *Local) Validate(_config *config.Config) config.Problems {
+	rules := config.NewRules()
+	local := &_config.Local
+
+	rules.Required("Local.Image", local.Image)
+	rules.Match("Local.Image", local.Image, imagePattern, "must be a docker image reference (i.e ubuntu:22.04)")
+
+	if local.SshPort < 1 || local.SshPort > 65535 {
+		rules.Report("Local.SshPort", "must be a port between 1 and 65535")
+	}
+
+	rules.Match("AMI.Name", _config.AMI.Name, repositoryPattern, "must be lowercase alphanumeric characters separated by '.', '_' or '-' on local")
+	rules.Match("Up.AmiId", _config.Up.AmiId, imageIdPattern, "must be a docker image ID (i.e sha256:3f57d9401f8d42f986df300f0c69192fc41da28ccc8d797829467780db3dd741)")
+
+	// Containers aren't billed, so no idle shutdown watchdog is installed.
+	if _config.IdleShutdown.Minutes > 0 {
+		rules.Report("IdleShutdown.Minutes", "isn't supported on local, leave it unset")
+	}
+
+	return rules.Problems()
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/utils/price_table"
+	"github.com/ed3899/kumo/utils/prompt"
+)
+
+// Everything kumo needs to know about a cloud. Each cloud is a package under provider that registers its
+// Provider from an init function, see Register. The packer, terraform and templates dirs of a cloud are named
+// after Cloud.
+type Provider interface {
+	// Returns the cloud as written in the Cloud field of kumo.config.yaml.
+	Cloud() iota.Cloud
+	// Returns the credentials from the config, keyed by the environment variables Packer and Terraform read
+	// them from.
+	Credentials(_config *config.Config) (map[string]string, error)
+	// Returns the data the cloud Packer template is rendered with.
+	PackerEnvironment(_config *config.Config) any
+	// Returns the data the cloud Terraform template is rendered with. The image to deploy is picked from the
+	// Packer manifest unless the config sets one.
+	TerraformEnvironment(pathToPackerManifest string, _config *config.Config) (any, error)
+	// Returns the user kumo connects as over ssh.
+	SshUser(_config *config.Config) string
+	// Returns the image id from the artifact id of a Packer build.
+	ImageId(artifactId string) (string, error)
+	// Returns the instance recorded in the Terraform state, or nil if there is none.
+	Instance(pathToTerraformState string) (*Instance, error)
+	// Returns the on-demand cost of what the tool runs, from the cloud price table.
+	EstimateCost(priceTable *price_table.PriceTable, tool iota.Tool, _config *config.Config) (*price_table.Estimate, error)
+	// Asks for the cloud values of the config, offering the current ones as defaults.
+	PromptConfig(_prompt *prompt.Prompt, _config *config.Config) error
+	// Returns the Terraform resources kumo stop and start apply, keyed by address with the attributes that hold
+	// the power state, nil when the whole resource does. Any other change is refused, see cmd.SetInstanceState.
+	PowerResources() map[string][]string
+	// Fills the optional cloud values of the config that were left empty, see config.Load.
+	ApplyDefaults(_config *config.Config)
+	// Returns the problems of the cloud values of the config, see config.Load.
+	Validate(_config *config.Config) config.Problems
+}
+
+var _ config.CloudRules = Provider(nil)
+
+type Instance struct {
+	Id               string
+	Type             string
+	State            string
+	PublicIp         string
+	PublicDns        string
+	ImageId          string
+	AvailabilityZone string
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/ed3899/kumo/common/iota"
+)
+
+var (
+	providers      = map[iota.Cloud]Provider{}
+	providersMutex = &sync.RWMutex{}
+)
+
+// Registers a provider under its cloud. Providers call it from an init function, so importing their package
+// is enough to support the cloud. A provider registered later for the same cloud replaces the former, which
+// lets tests register a fake one.
+//
+// Example:
+//
+//	func init() {
+//		provider.Register(&Aws{})
+//	}
+func Register(_provider Provider) {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+
+	providers[_provider.Cloud()] = _provider
+}
+
+// Removes the provider registered under the cloud, if any.
+func Unregister(cloud iota.Cloud) {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+
+	delete(providers, cloud)
+}
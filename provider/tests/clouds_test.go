@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/manager/environment"
+	"github.com/ed3899/kumo/provider"
+	"github.com/ed3899/kumo/utils/test_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Checks what every registered cloud has in common: its registration, the image its Terraform vars deploy and
+// the vars of each tool against the variables the dir of the cloud declares.
+var _ = Describe("Clouds", Label("unit"), func() {
+	var (
+		root = filepath.Join("..", "..")
+	)
+
+	for _, cloud := range provider.Clouds() {
+		cloud := cloud
+
+		Context(cloud.Name(), func() {
+			var (
+				fixture *cloudFixture
+			)
+
+			BeforeEach(func() {
+				var found bool
+				fixture, found = cloudFixtures[cloud]
+				Expect(found).To(BeTrue(), "no fixture for %s", cloud.Name())
+			})
+
+			// Renders the terraform vars with the given image as the last build.
+			renderTerraformVars := func(artifactId string, _config *config.Config) (string, error) {
+				_provider, err := provider.Get(cloud)
+				Expect(err).ToNot(HaveOccurred())
+
+				pathToManifest := test_helpers.WritePackerManifest(fixture.builderType, artifactId)
+				terraformEnvironment, err := _provider.TerraformEnvironment(pathToManifest, _config)
+				if err != nil {
+					return "", err
+				}
+
+				pathToVars := test_helpers.RenderTemplates(filepath.Join(root, "templates", "terraform"), cloud, &environment.Environment[any]{
+					Base:  test_helpers.NewTerraformBaseEnvironment(),
+					Cloud: terraformEnvironment,
+				})
+
+				return pathToVars, nil
+			}
+
+			It("should be registered under its cloud", func() {
+				_provider, err := provider.Get(cloud)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(_provider.Cloud()).To(Equal(cloud))
+			})
+
+			It("should render the packer vars", func() {
+				_config := newConfig(cloud)
+
+				packerEnvironment, err := environment.NewPackerEnvironment(cloud, _config)
+				Expect(err).ToNot(HaveOccurred())
+
+				pathToVars := test_helpers.RenderTemplates(filepath.Join(root, "templates", "packer"), cloud, packerEnvironment)
+
+				test_helpers.ExpectDeclared(pathToVars, filepath.Join(root, "packer", cloud.Name()), ".pkr.hcl")
+			})
+
+			It("should render the terraform vars with the image of the last build", func() {
+				pathToVars, err := renderTerraformVars(fixture.artifactId, newConfig(cloud))
+				Expect(err).ToNot(HaveOccurred())
+
+				content, err := os.ReadFile(pathToVars)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring(`"` + fixture.imageId + `"`))
+
+				test_helpers.ExpectDeclared(pathToVars, filepath.Join(root, "terraform", cloud.Name()), ".tf")
+			})
+
+			It("should prefer the image of the config", func() {
+				_config := newConfig(cloud)
+				_config.Up.AmiId = fixture.configImageId
+
+				pathToVars, err := renderTerraformVars(fixture.artifactId, _config)
+				Expect(err).ToNot(HaveOccurred())
+
+				content, err := os.ReadFile(pathToVars)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring(`"` + fixture.configImageId + `"`))
+				Expect(string(content)).NotTo(ContainSubstring(`"` + fixture.imageId + `"`))
+			})
+
+			It("should return an error when the last build has no image", func() {
+				_, err := renderTerraformVars("", newConfig(cloud))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	}
+})
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/config"
+	"github.com/ed3899/kumo/provider"
+	"github.com/ed3899/kumo/utils/price_table"
+	"github.com/ed3899/kumo/utils/prompt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// A provider for a cloud that doesn't exist, only its name matters.
+type fakeProvider struct {
+	cloud iota.Cloud
+}
+
+func (f *fakeProvider) Cloud() iota.Cloud {
+	return f.cloud
+}
+
+func (f *fakeProvider) Credentials(_config *config.Config) (map[string]string, error) {
+	return map[string]string{}, nil
+}
+
+func (f *fakeProvider) PackerEnvironment(_config *config.Config) any {
+	return nil
+}
+
+func (f *fakeProvider) TerraformEnvironment(pathToPackerManifest string, _config *config.Config) (any, error) {
+	return nil, nil
+}
+
+func (f *fakeProvider) SshUser(_config *config.Config) string {
+	return "fake"
+}
+
+func (f *fakeProvider) ImageId(artifactId string) (string, error) {
+	return artifactId, nil
+}
+
+func (f *fakeProvider) Instance(pathToTerraformState string) (*provider.Instance, error) {
+	return nil, nil
+}
+
+func (f *fakeProvider) EstimateCost(priceTable *price_table.PriceTable, tool iota.Tool, _config *config.Config) (*price_table.Estimate, error) {
+	return &price_table.Estimate{}, nil
+}
+
+func (f *fakeProvider) PromptConfig(_prompt *prompt.Prompt, _config *config.Config) error {
+	return nil
+}
+
+func (f *fakeProvider) PowerResources() map[string][]string {
+	return nil
+}
+
+func (f *fakeProvider) ApplyDefaults(_config *config.Config) {}
+
+func (f *fakeProvider) Validate(_config *config.Config) config.Problems {
+	return nil
+}
+
+var _ = Describe("Register", Label("unit"), func() {
+	AfterEach(func() {
+		provider.Unregister("fake-b")
+		provider.Unregister("fake-a")
+	})
+
+	It("should get a registered provider by its cloud", func() {
+		fake := &fakeProvider{cloud: "fake-a"}
+		provider.Register(fake)
+
+		_provider, err := provider.Get("fake-a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(_provider).To(BeIdenticalTo(fake))
+	})
+
+	It("should replace the provider registered before for the same cloud", func() {
+		provider.Register(&fakeProvider{cloud: "fake-a"})
+		replacement := &fakeProvider{cloud: "fake-a"}
+		provider.Register(replacement)
+
+		_provider, err := provider.Get("fake-a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(_provider).To(BeIdenticalTo(replacement))
+	})
+
+	It("should list the registered clouds sorted by name", func() {
+		provider.Register(&fakeProvider{cloud: "fake-b"})
+		provider.Register(&fakeProvider{cloud: "fake-a"})
+
+		// The real clouds are registered by the imports of the suite, see fixtures_test.go.
+		Expect(provider.Clouds()).To(Equal([]iota.Cloud{"aws", "azure", "fake-a", "fake-b", "gcp", "hetzner", "local"}))
+	})
+
+	It("should return an error for an unregistered cloud", func() {
+		provider.Register(&fakeProvider{cloud: "fake-a"})
+		provider.Unregister("fake-a")
+
+		_, err := provider.Get("fake-a")
+		Expect(err).To(HaveOccurred())
+		Expect(provider.Clouds()).ToNot(ContainElement(iota.Cloud("fake-a")))
+	})
+})
@@ -0,0 +1,28 @@
+package remote
+
+import (
+	"github.com/samber/oops"
+)
+
+// Closes the sftp session and the ssh connection.
+func (r *Remote) Close() error {
+	oopsBuilder := oops.
+		Code("Close").
+		In("remote").
+		Tags("Remote")
+
+	sftpErr := r.Sftp.Close()
+
+	err := r.Ssh.Close()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to close ssh connection")
+	}
+
+	if sftpErr != nil {
+		return oopsBuilder.
+			Wrapf(sftpErr, "failed to close sftp session")
+	}
+
+	return nil
+}
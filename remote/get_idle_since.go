@@ -0,0 +1,66 @@
+package remote
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	idleShutdownScript = "/usr/local/bin/kumo-idle-shutdown"
+	idleSinceFile      = "/var/lib/kumo/idle-since"
+)
+
+// Returns since when the idle shutdown watchdog considers the instance idle, or nil if the instance is in use.
+// Fails when the watchdog isn't installed, which happens on instances created before idle shutdown existed.
+//
+// Example:
+//
+//	(client) -> 2023-10-18 10:00:00 +0000 UTC
+func GetIdleSince(
+	client *ssh.Client,
+) (*time.Time, error) {
+	oopsBuilder := oops.
+		Code("GetIdleSince").
+		In("remote").
+		Tags("Remote")
+
+	session, err := client.NewSession()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to open ssh session")
+	}
+	defer session.Close()
+
+	output, err := session.Output(
+		"test -x " + idleShutdownScript + " || exit 3; cat " + idleSinceFile + " 2>/dev/null || true",
+	)
+	if err != nil {
+		if exitError, ok := err.(*ssh.ExitError); ok && exitError.ExitStatus() == 3 {
+			return nil, oopsBuilder.
+				Errorf("the idle shutdown watchdog isn't installed on the instance, recreate it with kumo destroy and kumo up")
+		}
+
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read %s", idleSinceFile)
+	}
+
+	trimmed := strings.TrimSpace(string(output))
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	seconds, err := strconv.ParseInt(trimmed, 10, 64)
+	if err != nil {
+		return nil, oopsBuilder.
+			With("output", trimmed).
+			Wrapf(err, "failed to parse %s", idleSinceFile)
+	}
+
+	idleSince := time.Unix(seconds, 0)
+
+	return &idleSince, nil
+}
@@ -0,0 +1,44 @@
+package remote
+
+import (
+	"github.com/ed3899/kumo/manager"
+	"github.com/pkg/sftp"
+	"github.com/samber/oops"
+	"golang.org/x/crypto/ssh"
+)
+
+// Returns a Remote instance connected to the deployed instance over ssh, with an sftp session on top.
+func NewRemote(
+	_manager *manager.Manager,
+) (*Remote, error) {
+	oopsBuilder := oops.
+		Code("NewRemote").
+		In("remote").
+		Tags("Remote")
+
+	sshClient, err := NewSshClient(_manager)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to connect to the instance")
+	}
+
+	sftpClient, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to start sftp session")
+	}
+
+	return &Remote{
+		Address: sshClient.RemoteAddr().String(),
+		Ssh:     sshClient,
+		Sftp:    sftpClient,
+	}, nil
+}
+
+type Remote struct {
+	Address string
+	Ssh     *ssh.Client
+	Sftp    *sftp.Client
+}
@@ -0,0 +1,69 @@
+package remote
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ed3899/kumo/manager"
+	"github.com/samber/oops"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	dialTimeout = 15 * time.Second
+)
+
+// Returns an ssh client connected to the deployed instance with the identity file, user and address generated by
+// kumo up, see manager.GetInstanceAddress. IPv6 addresses are supported. Host keys aren't checked, same as with
+// the generated ssh config.
+func NewSshClient(
+	_manager *manager.Manager,
+) (*ssh.Client, error) {
+	oopsBuilder := oops.
+		Code("NewSshClient").
+		In("remote").
+		Tags("Remote")
+
+	instanceAddress, err := _manager.GetInstanceAddress()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the instance address")
+	}
+
+	privateKey, err := os.ReadFile(_manager.Path.Terraform.IdentityFile)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read identity file: %s", _manager.Path.Terraform.IdentityFile)
+	}
+
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to parse identity file: %s", _manager.Path.Terraform.IdentityFile)
+	}
+
+	instancePort, err := _manager.GetInstancePort()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get the instance port")
+	}
+
+	address := net.JoinHostPort(instanceAddress, strconv.Itoa(instancePort))
+
+	client, err := ssh.Dial("tcp", address, &ssh.ClientConfig{
+		User: _manager.Provider.SshUser(_manager.Config),
+		Auth: []ssh.AuthMethod{
+			ssh.PublicKeys(signer),
+		},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         dialTimeout,
+	})
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to connect to %s", address)
+	}
+
+	return client, nil
+}
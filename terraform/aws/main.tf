@@ -6,15 +6,17 @@ locals {
   AWS_INSTANCE_TYPE            = trimspace(var.AWS_INSTANCE_TYPE)
   AWS_EC2_INSTANCE_VOLUME_TYPE = trimspace(var.AWS_EC2_INSTANCE_VOLUME_TYPE)
   AWS_EC2_INSTANCE_VOLUME_SIZE = var.AWS_EC2_INSTANCE_VOLUME_SIZE
+  IDLE_SHUTDOWN_MINUTES        = var.IDLE_SHUTDOWN_MINUTES
+  IDLE_SHUTDOWN_CPU_THRESHOLD  = var.IDLE_SHUTDOWN_CPU_THRESHOLD
 
   ALLOWED_IP   = trimspace(var.ALLOWED_IP)
   KEY_NAME     = trimspace(var.KEY_NAME)
   SSH_PORT     = var.SSH_PORT
-  IP_FILE_NAME = trimspace(var.IP_FILE_NAME)
   USERNAME     = trimspace(var.USERNAME)
 
   first_available_zone = length(data.aws_availability_zones.available.names) > 0 ? data.aws_availability_zones.available.names[0] : null
-  KUMO_NAME_TAG       = "kumo"
+  KUMO_NAME_TAG        = trimspace(var.NAME_TAG)
+  INSTANCE_STATE       = trimspace(var.INSTANCE_STATE)
 }
 
 terraform {
@@ -48,9 +50,10 @@ data "aws_ami" "kumo-ami" {
 }
 
 resource "aws_vpc" "kumo-vpc" {
-  cidr_block           = "10.0.0.0/16"
-  enable_dns_hostnames = true
-  enable_dns_support   = true
+  cidr_block                       = "10.0.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+  enable_dns_hostnames             = true
+  enable_dns_support               = true
 
   tags = {
     Name = local.KUMO_NAME_TAG
@@ -71,6 +74,10 @@ resource "aws_subnet" "kumo-subnet" {
   map_public_ip_on_launch = true
   availability_zone       = data.aws_availability_zones.available.names[0]
 
+  # The first /64 of the /56 Amazon assigns to the VPC, so the instance gets a public IPv6 too.
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.kumo-vpc.ipv6_cidr_block, 8, 0)
+  assign_ipv6_address_on_creation = true
+
   tags = {
     Name = local.KUMO_NAME_TAG
   }
@@ -84,6 +91,11 @@ resource "aws_route_table" "kumo-route-table" {
     gateway_id = aws_internet_gateway.kumo-internet-gateway.id
   }
 
+  route {
+    ipv6_cidr_block = "::/0"
+    gateway_id      = aws_internet_gateway.kumo-internet-gateway.id
+  }
+
   tags = {
     Name = local.KUMO_NAME_TAG
   }
@@ -111,6 +123,15 @@ resource "aws_vpc_security_group_egress_rule" "kumo-security-group-egress-rule"
   to_port     = 0
 }
 
+resource "aws_vpc_security_group_egress_rule" "kumo-security-group-egress-rule-ipv6" {
+  security_group_id = aws_security_group.kumo-security-group.id
+
+  cidr_ipv6   = "::/0"
+  from_port   = 0
+  ip_protocol = "-1"
+  to_port     = 0
+}
+
 resource "aws_vpc_security_group_ingress_rule" "kumo-security-group-ingress-rule" {
   security_group_id = aws_security_group.kumo-security-group.id
 
@@ -145,9 +166,10 @@ resource "aws_instance" "kumo-ec2-instance" {
   vpc_security_group_ids = [
     aws_security_group.kumo-security-group.id
   ]
-  subnet_id         = aws_subnet.kumo-subnet.id
-  availability_zone = local.first_available_zone
-  key_name          = aws_key_pair.kumo-ssh-key-pair.key_name
+  subnet_id          = aws_subnet.kumo-subnet.id
+  availability_zone  = local.first_available_zone
+  key_name           = aws_key_pair.kumo-ssh-key-pair.key_name
+  ipv6_address_count = 1
 
   root_block_device {
     volume_type = local.AWS_EC2_INSTANCE_VOLUME_TYPE
@@ -168,14 +190,64 @@ resource "aws_instance" "kumo-ec2-instance" {
     # Set correct permissions for the authorized_keys file
     chmod 600 $path
     chown $user:$user $path
+
+    # Install the idle shutdown watchdog, configured through the KumoIdleShutdown tags
+    mkdir -p /var/lib/kumo
+    echo "${base64encode(templatefile("${path.module}/idle_shutdown.sh.tftpl", { ssh_port = local.SSH_PORT }))}" | base64 -d > /usr/local/bin/kumo-idle-shutdown
+    chmod 755 /usr/local/bin/kumo-idle-shutdown
+
+    cat > /etc/systemd/system/kumo-idle-shutdown.service <<UNIT
+    [Unit]
+    Description=Stop the instance when idle, installed by kumo
+
+    [Service]
+    Type=oneshot
+    ExecStart=/usr/local/bin/kumo-idle-shutdown
+    UNIT
+
+    cat > /etc/systemd/system/kumo-idle-shutdown.timer <<UNIT
+    [Unit]
+    Description=Check every minute whether the instance is idle, installed by kumo
+
+    [Timer]
+    OnBootSec=5min
+    OnUnitActiveSec=1min
+
+    [Install]
+    WantedBy=timers.target
+    UNIT
+
+    systemctl daemon-reload
+    systemctl enable --now kumo-idle-shutdown.timer
   EOF
 
+  # The idle shutdown watchdog stops the instance by shutting it down.
+  instance_initiated_shutdown_behavior = "stop"
+
+  # The idle shutdown watchdog reads its policy from the instance tags.
+  metadata_options {
+    http_endpoint          = "enabled"
+    instance_metadata_tags = "enabled"
+  }
+
   tags = {
-    Name = local.KUMO_NAME_TAG
+    Name                         = local.KUMO_NAME_TAG
+    KumoIdleShutdownMinutes      = local.IDLE_SHUTDOWN_MINUTES
+    KumoIdleShutdownCpuThreshold = local.IDLE_SHUTDOWN_CPU_THRESHOLD
   }
 }
 
-resource "local_file" "kumo-ec2-public-ip" {
-  content  = aws_instance.kumo-ec2-instance.public_ip
-  filename = local.IP_FILE_NAME
+# Stopping keeps the EBS volume, starting again gives the instance a new public IP.
+resource "aws_ec2_instance_state" "kumo-ec2-instance-state" {
+  instance_id = aws_instance.kumo-ec2-instance.id
+  state       = local.INSTANCE_STATE
+}
+
+# Read after the state change, so it holds the current public IP rather than the one before the stop or start.
+data "aws_instance" "kumo-ec2-instance" {
+  instance_id = aws_instance.kumo-ec2-instance.id
+
+  depends_on = [
+    aws_ec2_instance_state.kumo-ec2-instance-state
+  ]
 }
\ No newline at end of file
@@ -0,0 +1,21 @@
+# Read by kumo with terraform output -json, or from the state, to connect to the instance.
+# The data source is read after kumo stop or start, so these follow the current public IP.
+output "instance_id" {
+  value = aws_instance.kumo-ec2-instance.id
+}
+
+output "public_ip" {
+  value = data.aws_instance.kumo-ec2-instance.public_ip
+}
+
+output "public_ipv6" {
+  value = try(data.aws_instance.kumo-ec2-instance.ipv6_addresses[0], "")
+}
+
+output "public_dns" {
+  value = data.aws_instance.kumo-ec2-instance.public_dns
+}
+
+output "availability_zone" {
+  value = aws_instance.kumo-ec2-instance.availability_zone
+}
@@ -70,6 +70,28 @@ variable "AWS_EC2_INSTANCE_VOLUME_SIZE" {
   }
 }
 
+variable "IDLE_SHUTDOWN_MINUTES" {
+  description = "The minutes without ssh connections and with low CPU usage after which the EC2 instance stops itself, 0 disables it"
+  type        = number
+  default     = 0
+
+  validation {
+    condition     = var.IDLE_SHUTDOWN_MINUTES >= 0
+    error_message = "IDLE_SHUTDOWN_MINUTES must be greater than or equal to 0"
+  }
+}
+
+variable "IDLE_SHUTDOWN_CPU_THRESHOLD" {
+  description = "The CPU usage percentage under which the EC2 instance counts as idle"
+  type        = number
+  default     = 10
+
+  validation {
+    condition     = var.IDLE_SHUTDOWN_CPU_THRESHOLD >= 1 && var.IDLE_SHUTDOWN_CPU_THRESHOLD <= 100
+    error_message = "IDLE_SHUTDOWN_CPU_THRESHOLD must be between 1 and 100"
+  }
+}
+
 variable "ALLOWED_IP" {
   description = "The IP address to allow SSH access from"
   type        = string
@@ -105,22 +127,33 @@ variable "SSH_PORT" {
   }
 }
 
-variable "IP_FILE_NAME" {
-  description = "The file to write the EC2 instance IP address to"
+variable "USERNAME" {
+  description = "The username to use for SSH"
   type        = string
 
   validation {
-    condition     = length(var.IP_FILE_NAME) > 0
-    error_message = "IP_FILE_NAME must be present"
+    condition     = length(var.USERNAME) > 0
+    error_message = "USERNAME must be present"
   }
 }
 
-variable "USERNAME" {
-  description = "The username to use for SSH"
+variable "NAME_TAG" {
+  description = "The Name tag of the created resources, unique per kumo environment"
   type        = string
 
   validation {
-    condition     = length(var.USERNAME) > 0
-    error_message = "USERNAME must be present"
+    condition     = length(var.NAME_TAG) > 0
+    error_message = "NAME_TAG must be present"
   }
-}
\ No newline at end of file
+}
+
+variable "INSTANCE_STATE" {
+  description = "Whether the EC2 instance is running or stopped. Set by kumo stop and kumo start"
+  type        = string
+  default     = "running"
+
+  validation {
+    condition     = contains(["running", "stopped"], var.INSTANCE_STATE)
+    error_message = "INSTANCE_STATE must be either running or stopped"
+  }
+}
@@ -0,0 +1,209 @@
+locals {
+  ARM_SUBSCRIPTION_ID         = trimspace(var.ARM_SUBSCRIPTION_ID)
+  ARM_TENANT_ID               = trimspace(var.ARM_TENANT_ID)
+  ARM_CLIENT_ID               = trimspace(var.ARM_CLIENT_ID)
+  ARM_CLIENT_SECRET           = trimspace(var.ARM_CLIENT_SECRET)
+  AZURE_LOCATION              = trimspace(var.AZURE_LOCATION)
+  AZURE_VM_SIZE               = trimspace(var.AZURE_VM_SIZE)
+  IMAGE_ID                    = trimspace(var.IMAGE_ID)
+  AZURE_OS_DISK_TYPE          = trimspace(var.AZURE_OS_DISK_TYPE)
+  AZURE_OS_DISK_SIZE          = var.AZURE_OS_DISK_SIZE
+  IDLE_SHUTDOWN_MINUTES       = var.IDLE_SHUTDOWN_MINUTES
+  IDLE_SHUTDOWN_CPU_THRESHOLD = var.IDLE_SHUTDOWN_CPU_THRESHOLD
+
+  ALLOWED_IP = trimspace(var.ALLOWED_IP)
+  KEY_NAME   = trimspace(var.KEY_NAME)
+  SSH_PORT   = var.SSH_PORT
+  USERNAME   = trimspace(var.USERNAME)
+
+  KUMO_NAME_TAG  = trimspace(var.NAME_TAG)
+  INSTANCE_STATE = trimspace(var.INSTANCE_STATE)
+}
+
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+    azapi = {
+      source  = "azure/azapi"
+      version = "~> 1.0"
+    }
+  }
+
+  required_version = ">= 1.2.0"
+}
+
+provider "azurerm" {
+  features {}
+
+  subscription_id = local.ARM_SUBSCRIPTION_ID
+  tenant_id       = local.ARM_TENANT_ID
+  client_id       = local.ARM_CLIENT_ID
+  client_secret   = local.ARM_CLIENT_SECRET
+}
+
+provider "azapi" {
+  subscription_id = local.ARM_SUBSCRIPTION_ID
+  tenant_id       = local.ARM_TENANT_ID
+  client_id       = local.ARM_CLIENT_ID
+  client_secret   = local.ARM_CLIENT_SECRET
+}
+
+# Everything but the image lives in this group, so destroying it leaves nothing behind.
+resource "azurerm_resource_group" "kumo-resource-group" {
+  name     = "${local.KUMO_NAME_TAG}-rg"
+  location = local.AZURE_LOCATION
+
+  tags = {
+    Name = local.KUMO_NAME_TAG
+  }
+}
+
+resource "azurerm_virtual_network" "kumo-vnet" {
+  name                = "${local.KUMO_NAME_TAG}-vnet"
+  resource_group_name = azurerm_resource_group.kumo-resource-group.name
+  location            = azurerm_resource_group.kumo-resource-group.location
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "kumo-subnet" {
+  name                 = "${local.KUMO_NAME_TAG}-subnet"
+  resource_group_name  = azurerm_resource_group.kumo-resource-group.name
+  virtual_network_name = azurerm_virtual_network.kumo-vnet.name
+  address_prefixes     = ["10.0.1.0/24"]
+}
+
+# Static, so the address survives a deallocation done by kumo stop.
+resource "azurerm_public_ip" "kumo-public-ip" {
+  name                = "${local.KUMO_NAME_TAG}-ip"
+  resource_group_name = azurerm_resource_group.kumo-resource-group.name
+  location            = azurerm_resource_group.kumo-resource-group.location
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+# Standard public IPs deny inbound traffic unless a security group allows it.
+resource "azurerm_network_security_group" "kumo-nsg" {
+  name                = "${local.KUMO_NAME_TAG}-nsg"
+  resource_group_name = azurerm_resource_group.kumo-resource-group.name
+  location            = azurerm_resource_group.kumo-resource-group.location
+
+  security_rule {
+    name                       = "allow-ssh"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = tostring(local.SSH_PORT)
+    source_address_prefix      = local.ALLOWED_IP
+    destination_address_prefix = "*"
+  }
+}
+
+resource "azurerm_network_interface" "kumo-nic" {
+  name                = "${local.KUMO_NAME_TAG}-nic"
+  resource_group_name = azurerm_resource_group.kumo-resource-group.name
+  location            = azurerm_resource_group.kumo-resource-group.location
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.kumo-subnet.id
+    private_ip_address_allocation = "Dynamic"
+    public_ip_address_id          = azurerm_public_ip.kumo-public-ip.id
+  }
+}
+
+resource "azurerm_network_interface_security_group_association" "kumo-nic-nsg" {
+  network_interface_id      = azurerm_network_interface.kumo-nic.id
+  network_security_group_id = azurerm_network_security_group.kumo-nsg.id
+}
+
+resource "tls_private_key" "kumo-ssh-key" {
+  algorithm = "RSA"
+  rsa_bits  = 4096
+}
+
+resource "local_file" "kumo-ssh-private-key" {
+  content         = tls_private_key.kumo-ssh-key.private_key_openssh
+  file_permission = "0600"
+  filename        = local.KEY_NAME
+}
+
+resource "azurerm_linux_virtual_machine" "kumo-vm" {
+  name                = local.KUMO_NAME_TAG
+  resource_group_name = azurerm_resource_group.kumo-resource-group.name
+  location            = azurerm_resource_group.kumo-resource-group.location
+  size                = local.AZURE_VM_SIZE
+  source_image_id     = local.IMAGE_ID
+
+  network_interface_ids = [azurerm_network_interface.kumo-nic.id]
+
+  # Azure only accepts RSA keys for the admin user.
+  admin_username                  = local.USERNAME
+  disable_password_authentication = true
+
+  admin_ssh_key {
+    username   = local.USERNAME
+    public_key = tls_private_key.kumo-ssh-key.public_key_openssh
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = local.AZURE_OS_DISK_TYPE
+    disk_size_gb         = local.AZURE_OS_DISK_SIZE
+  }
+
+  # The idle shutdown watchdog reads its policy from the KumoIdleShutdown tags.
+  custom_data = base64encode(<<-EOF
+    #!/bin/bash
+    # Install the idle shutdown watchdog, configured through the KumoIdleShutdown tags
+    mkdir -p /var/lib/kumo
+    echo "${base64encode(templatefile("${path.module}/idle_shutdown.sh.tftpl", { ssh_port = local.SSH_PORT }))}" | base64 -d > /usr/local/bin/kumo-idle-shutdown
+    chmod 755 /usr/local/bin/kumo-idle-shutdown
+
+    cat > /etc/systemd/system/kumo-idle-shutdown.service <<UNIT
+    [Unit]
+    Description=Stop the VM when idle, installed by kumo
+
+    [Service]
+    Type=oneshot
+    ExecStart=/usr/local/bin/kumo-idle-shutdown
+    UNIT
+
+    cat > /etc/systemd/system/kumo-idle-shutdown.timer <<UNIT
+    [Unit]
+    Description=Check every minute whether the VM is idle, installed by kumo
+
+    [Timer]
+    OnBootSec=5min
+    OnUnitActiveSec=1min
+
+    [Install]
+    WantedBy=timers.target
+    UNIT
+
+    systemctl daemon-reload
+    systemctl enable --now kumo-idle-shutdown.timer
+  EOF
+  )
+
+  tags = {
+    Name                         = local.KUMO_NAME_TAG
+    KumoIdleShutdownMinutes      = local.IDLE_SHUTDOWN_MINUTES
+    KumoIdleShutdownCpuThreshold = local.IDLE_SHUTDOWN_CPU_THRESHOLD
+  }
+
+  depends_on = [azurerm_network_interface_security_group_association.kumo-nic-nsg]
+}
+
+# azurerm doesn't manage the power state of a VM. Deallocating stops the compute billing, the disk and the
+# static public IP are kept.
+resource "azapi_resource_action" "kumo-vm-power" {
+  type        = "Microsoft.Compute/virtualMachines@2023-03-01"
+  resource_id = azurerm_linux_virtual_machine.kumo-vm.id
+  action      = local.INSTANCE_STATE == "running" ? "start" : "deallocate"
+  method      = "POST"
+}
@@ -0,0 +1,21 @@
+# Read by kumo with terraform output -json, or from the state, to connect to the VM.
+# The public IP is static, so it stays the same across kumo stop and start.
+output "instance_id" {
+  value = azurerm_linux_virtual_machine.kumo-vm.virtual_machine_id
+}
+
+output "public_ip" {
+  value = azurerm_public_ip.kumo-public-ip.ip_address
+}
+
+output "public_ipv6" {
+  value = ""
+}
+
+output "public_dns" {
+  value = ""
+}
+
+output "availability_zone" {
+  value = azurerm_linux_virtual_machine.kumo-vm.location
+}
@@ -0,0 +1,180 @@
+variable "ARM_SUBSCRIPTION_ID" {
+  description = "The Azure subscription to deploy to"
+  type        = string
+
+  validation {
+    condition     = length(var.ARM_SUBSCRIPTION_ID) > 0
+    error_message = "ARM_SUBSCRIPTION_ID must be present"
+  }
+}
+
+variable "ARM_TENANT_ID" {
+  description = "The Microsoft Entra tenant of the service principal"
+  type        = string
+
+  validation {
+    condition     = length(var.ARM_TENANT_ID) > 0
+    error_message = "ARM_TENANT_ID must be present"
+  }
+}
+
+variable "ARM_CLIENT_ID" {
+  description = "The application (client) id of the service principal"
+  type        = string
+
+  validation {
+    condition     = length(var.ARM_CLIENT_ID) > 0
+    error_message = "ARM_CLIENT_ID must be present"
+  }
+}
+
+variable "ARM_CLIENT_SECRET" {
+  description = "The client secret of the service principal"
+  type        = string
+  sensitive   = true
+
+  validation {
+    condition     = length(var.ARM_CLIENT_SECRET) > 0
+    error_message = "ARM_CLIENT_SECRET must be present"
+  }
+}
+
+variable "AZURE_LOCATION" {
+  description = "The Azure location to deploy to"
+  type        = string
+
+  validation {
+    condition     = length(var.AZURE_LOCATION) > 0
+    error_message = "AZURE_LOCATION must be present"
+  }
+}
+
+variable "AZURE_VM_SIZE" {
+  description = "The VM size to use"
+  type        = string
+
+  validation {
+    condition     = length(var.AZURE_VM_SIZE) > 0
+    error_message = "AZURE_VM_SIZE must be present"
+  }
+}
+
+variable "IMAGE_ID" {
+  description = "The resource ID of the managed image to use for the VM"
+  type        = string
+
+  validation {
+    condition     = length(var.IMAGE_ID) > 0
+    error_message = "IMAGE_ID must be set"
+  }
+
+  validation {
+    condition     = can(regex("(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\\.Compute/images/[^/]+$", var.IMAGE_ID))
+    error_message = "IMAGE_ID must be a valid managed image ID"
+  }
+}
+
+variable "AZURE_OS_DISK_TYPE" {
+  description = "The storage account type of the OS disk"
+  type        = string
+  default     = "StandardSSD_LRS"
+}
+
+variable "AZURE_OS_DISK_SIZE" {
+  description = "The OS disk size of the VM in GiB"
+  type        = number
+  default     = 30
+
+  validation {
+    condition     = var.AZURE_OS_DISK_SIZE >= 30
+    error_message = "AZURE_OS_DISK_SIZE must be greater than or equal to 30"
+  }
+}
+
+variable "IDLE_SHUTDOWN_MINUTES" {
+  description = "The minutes without ssh connections and with low CPU usage after which the VM stops itself, 0 disables it"
+  type        = number
+  default     = 0
+
+  validation {
+    condition     = var.IDLE_SHUTDOWN_MINUTES >= 0
+    error_message = "IDLE_SHUTDOWN_MINUTES must be greater than or equal to 0"
+  }
+}
+
+variable "IDLE_SHUTDOWN_CPU_THRESHOLD" {
+  description = "The CPU usage percentage under which the VM counts as idle"
+  type        = number
+  default     = 10
+
+  validation {
+    condition     = var.IDLE_SHUTDOWN_CPU_THRESHOLD >= 1 && var.IDLE_SHUTDOWN_CPU_THRESHOLD <= 100
+    error_message = "IDLE_SHUTDOWN_CPU_THRESHOLD must be between 1 and 100"
+  }
+}
+
+variable "ALLOWED_IP" {
+  description = "The IP address to allow SSH access from"
+  type        = string
+
+  validation {
+    condition     = length(var.ALLOWED_IP) > 0
+    error_message = "ALLOWED_IP must be present"
+  }
+
+  validation {
+    condition     = can(regex("^(?:[0-9]{1,3}\\.){3}[0-9]{1,3}(?:/[0-9]{1,2})?$", var.ALLOWED_IP))
+    error_message = "ALLOWED_IP must be a valid IP address with a CIDR mask"
+  }
+}
+
+variable "KEY_NAME" {
+  description = "The name of the SSH key to create"
+  type        = string
+
+  validation {
+    condition     = length(var.KEY_NAME) > 0
+    error_message = "KEY_NAME must be present"
+  }
+}
+
+variable "SSH_PORT" {
+  description = "The port to use for SSH"
+  type        = number
+
+  validation {
+    condition     = var.SSH_PORT > 0
+    error_message = "SSH_PORT must be greater than 0"
+  }
+}
+
+variable "USERNAME" {
+  description = "The username to use for SSH"
+  type        = string
+
+  validation {
+    condition     = length(var.USERNAME) > 0
+    error_message = "USERNAME must be present"
+  }
+}
+
+variable "NAME_TAG" {
+  description = "The name prefix and label of the created resources, unique per kumo environment"
+  type        = string
+
+  validation {
+    condition     = length(var.NAME_TAG) > 0
+    error_message = "NAME_TAG must be present"
+  }
+}
+
+variable "INSTANCE_STATE" {
+  description = "Whether the VM is running or stopped. Set by kumo stop and kumo start"
+  type        = string
+  default     = "running"
+
+  validation {
+    condition     = contains(["running", "stopped"], var.INSTANCE_STATE)
+    error_message = "INSTANCE_STATE must be either running or stopped"
+  }
+}
@@ -0,0 +1,147 @@
+locals {
+  GCP_PROJECT_ID              = trimspace(var.GCP_PROJECT_ID)
+  GCP_CREDENTIALS_FILE        = trimspace(var.GCP_CREDENTIALS_FILE)
+  GCP_REGION                  = trimspace(var.GCP_REGION)
+  GCP_ZONE                    = trimspace(var.GCP_ZONE)
+  GCP_MACHINE_TYPE            = trimspace(var.GCP_MACHINE_TYPE)
+  IMAGE_NAME                  = trimspace(var.IMAGE_NAME)
+  GCP_DISK_TYPE               = trimspace(var.GCP_DISK_TYPE)
+  GCP_DISK_SIZE               = var.GCP_DISK_SIZE
+  IDLE_SHUTDOWN_MINUTES       = var.IDLE_SHUTDOWN_MINUTES
+  IDLE_SHUTDOWN_CPU_THRESHOLD = var.IDLE_SHUTDOWN_CPU_THRESHOLD
+
+  ALLOWED_IP = trimspace(var.ALLOWED_IP)
+  KEY_NAME   = trimspace(var.KEY_NAME)
+  SSH_PORT   = var.SSH_PORT
+  USERNAME   = trimspace(var.USERNAME)
+
+  KUMO_NAME_TAG  = trimspace(var.NAME_TAG)
+  INSTANCE_STATE = trimspace(var.INSTANCE_STATE)
+}
+
+terraform {
+  required_providers {
+    google = {
+      source  = "hashicorp/google"
+      version = "~> 5.0"
+    }
+  }
+
+  required_version = ">= 1.2.0"
+}
+
+provider "google" {
+  project     = local.GCP_PROJECT_ID
+  region      = local.GCP_REGION
+  zone        = local.GCP_ZONE
+  credentials = file(local.GCP_CREDENTIALS_FILE)
+}
+
+data "google_compute_image" "kumo-image" {
+  name    = local.IMAGE_NAME
+  project = local.GCP_PROJECT_ID
+}
+
+resource "google_compute_network" "kumo-network" {
+  name                    = "${local.KUMO_NAME_TAG}-network"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "kumo-subnetwork" {
+  name          = "${local.KUMO_NAME_TAG}-subnetwork"
+  network       = google_compute_network.kumo-network.id
+  region        = local.GCP_REGION
+  ip_cidr_range = "10.0.0.0/24"
+}
+
+# Custom networks deny all ingress and allow all egress unless told otherwise.
+resource "google_compute_firewall" "kumo-firewall-ssh" {
+  name          = "${local.KUMO_NAME_TAG}-allow-ssh"
+  network       = google_compute_network.kumo-network.id
+  direction     = "INGRESS"
+  source_ranges = [local.ALLOWED_IP]
+
+  allow {
+    protocol = "tcp"
+    ports    = [tostring(local.SSH_PORT)]
+  }
+}
+
+resource "tls_private_key" "kumo-ssh-key" {
+  algorithm = "ED25519"
+}
+
+resource "local_file" "kumo-ssh-private-key" {
+  content         = tls_private_key.kumo-ssh-key.private_key_openssh
+  file_permission = "0600"
+  filename        = local.KEY_NAME
+}
+
+resource "google_compute_instance" "kumo-instance" {
+  name         = local.KUMO_NAME_TAG
+  machine_type = local.GCP_MACHINE_TYPE
+  zone         = local.GCP_ZONE
+
+  # Stopping keeps the boot disk, starting again gives the instance a new public IP.
+  desired_status            = local.INSTANCE_STATE == "running" ? "RUNNING" : "TERMINATED"
+  allow_stopping_for_update = true
+
+  boot_disk {
+    initialize_params {
+      image = data.google_compute_image.kumo-image.self_link
+      type  = local.GCP_DISK_TYPE
+      size  = local.GCP_DISK_SIZE
+    }
+  }
+
+  network_interface {
+    subnetwork = google_compute_subnetwork.kumo-subnetwork.id
+
+    # Ephemeral public IP
+    access_config {}
+  }
+
+  # The guest agent adds the key to the authorized_keys of the user. The idle shutdown watchdog reads its
+  # policy from the kumo-idle-shutdown attributes.
+  metadata = {
+    ssh-keys                         = "${local.USERNAME}:${trimspace(tls_private_key.kumo-ssh-key.public_key_openssh)}"
+    kumo-idle-shutdown-minutes       = local.IDLE_SHUTDOWN_MINUTES
+    kumo-idle-shutdown-cpu-threshold = local.IDLE_SHUTDOWN_CPU_THRESHOLD
+  }
+
+  metadata_startup_script = <<-EOF
+    #!/bin/bash
+    # Install the idle shutdown watchdog, configured through the kumo-idle-shutdown metadata attributes
+    mkdir -p /var/lib/kumo
+    echo "${base64encode(templatefile("${path.module}/idle_shutdown.sh.tftpl", { ssh_port = local.SSH_PORT }))}" | base64 -d > /usr/local/bin/kumo-idle-shutdown
+    chmod 755 /usr/local/bin/kumo-idle-shutdown
+
+    cat > /etc/systemd/system/kumo-idle-shutdown.service <<UNIT
+    [Unit]
+    Description=Stop the instance when idle, installed by kumo
+
+    [Service]
+    Type=oneshot
+    ExecStart=/usr/local/bin/kumo-idle-shutdown
+    UNIT
+
+    cat > /etc/systemd/system/kumo-idle-shutdown.timer <<UNIT
+    [Unit]
+    Description=Check every minute whether the instance is idle, installed by kumo
+
+    [Timer]
+    OnBootSec=5min
+    OnUnitActiveSec=1min
+
+    [Install]
+    WantedBy=timers.target
+    UNIT
+
+    systemctl daemon-reload
+    systemctl enable --now kumo-idle-shutdown.timer
+  EOF
+
+  labels = {
+    name = local.KUMO_NAME_TAG
+  }
+}
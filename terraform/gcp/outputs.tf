@@ -0,0 +1,21 @@
+# Read by kumo with terraform output -json, or from the state, to connect to the instance.
+# The instance is refreshed after kumo stop or start, so these follow the current public IP.
+output "instance_id" {
+  value = google_compute_instance.kumo-instance.instance_id
+}
+
+output "public_ip" {
+  value = try(google_compute_instance.kumo-instance.network_interface[0].access_config[0].nat_ip, "")
+}
+
+output "public_ipv6" {
+  value = ""
+}
+
+output "public_dns" {
+  value = ""
+}
+
+output "availability_zone" {
+  value = google_compute_instance.kumo-instance.zone
+}
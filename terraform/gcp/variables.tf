@@ -0,0 +1,169 @@
+variable "GCP_PROJECT_ID" {
+  description = "The Google Cloud project to deploy to"
+  type        = string
+
+  validation {
+    condition     = length(var.GCP_PROJECT_ID) > 0
+    error_message = "GCP_PROJECT_ID must be present"
+  }
+}
+
+variable "GCP_CREDENTIALS_FILE" {
+  description = "The absolute path to the service account key to use"
+  type        = string
+
+  validation {
+    condition     = length(var.GCP_CREDENTIALS_FILE) > 0
+    error_message = "GCP_CREDENTIALS_FILE must be present"
+  }
+}
+
+variable "GCP_REGION" {
+  description = "The Google Cloud region to use"
+  type        = string
+
+  validation {
+    condition     = length(var.GCP_REGION) > 0
+    error_message = "GCP_REGION must be present"
+  }
+}
+
+variable "GCP_ZONE" {
+  description = "The zone of the region to create the instance in"
+  type        = string
+
+  validation {
+    condition     = length(var.GCP_ZONE) > 0
+    error_message = "GCP_ZONE must be present"
+  }
+}
+
+variable "GCP_MACHINE_TYPE" {
+  description = "The Compute Engine machine type to use"
+  type        = string
+
+  validation {
+    condition     = length(var.GCP_MACHINE_TYPE) > 0
+    error_message = "GCP_MACHINE_TYPE must be present"
+  }
+}
+
+variable "IMAGE_NAME" {
+  description = "The name of the image to use for the instance"
+  type        = string
+
+  validation {
+    condition     = length(var.IMAGE_NAME) > 0
+    error_message = "IMAGE_NAME must be set"
+  }
+
+  validation {
+    condition     = can(regex("^[a-z](?:[-a-z0-9]{0,61}[a-z0-9])?$", var.IMAGE_NAME))
+    error_message = "IMAGE_NAME must be a valid image name"
+  }
+}
+
+variable "GCP_DISK_TYPE" {
+  description = "The boot disk type of the instance"
+  type        = string
+  default     = "pd-balanced"
+}
+
+variable "GCP_DISK_SIZE" {
+  description = "The boot disk size of the instance in GiB"
+  type        = number
+  default     = 10
+
+  validation {
+    condition     = var.GCP_DISK_SIZE >= 10
+    error_message = "GCP_DISK_SIZE must be greater than or equal to 10"
+  }
+}
+
+variable "IDLE_SHUTDOWN_MINUTES" {
+  description = "The minutes without ssh connections and with low CPU usage after which the instance stops itself, 0 disables it"
+  type        = number
+  default     = 0
+
+  validation {
+    condition     = var.IDLE_SHUTDOWN_MINUTES >= 0
+    error_message = "IDLE_SHUTDOWN_MINUTES must be greater than or equal to 0"
+  }
+}
+
+variable "IDLE_SHUTDOWN_CPU_THRESHOLD" {
+  description = "The CPU usage percentage under which the instance counts as idle"
+  type        = number
+  default     = 10
+
+  validation {
+    condition     = var.IDLE_SHUTDOWN_CPU_THRESHOLD >= 1 && var.IDLE_SHUTDOWN_CPU_THRESHOLD <= 100
+    error_message = "IDLE_SHUTDOWN_CPU_THRESHOLD must be between 1 and 100"
+  }
+}
+
+variable "ALLOWED_IP" {
+  description = "The IP address to allow SSH access from"
+  type        = string
+
+  validation {
+    condition     = length(var.ALLOWED_IP) > 0
+    error_message = "ALLOWED_IP must be present"
+  }
+
+  validation {
+    condition     = can(regex("^(?:[0-9]{1,3}\\.){3}[0-9]{1,3}(?:/[0-9]{1,2})?$", var.ALLOWED_IP))
+    error_message = "ALLOWED_IP must be a valid IP address with a CIDR mask"
+  }
+}
+
+variable "KEY_NAME" {
+  description = "The name of the SSH key to create"
+  type        = string
+
+  validation {
+    condition     = length(var.KEY_NAME) > 0
+    error_message = "KEY_NAME must be present"
+  }
+}
+
+variable "SSH_PORT" {
+  description = "The port to use for SSH"
+  type        = number
+
+  validation {
+    condition     = var.SSH_PORT > 0
+    error_message = "SSH_PORT must be greater than 0"
+  }
+}
+
+variable "USERNAME" {
+  description = "The username to use for SSH"
+  type        = string
+
+  validation {
+    condition     = length(var.USERNAME) > 0
+    error_message = "USERNAME must be present"
+  }
+}
+
+variable "NAME_TAG" {
+  description = "The name prefix and label of the created resources, unique per kumo environment"
+  type        = string
+
+  validation {
+    condition     = length(var.NAME_TAG) > 0
+    error_message = "NAME_TAG must be present"
+  }
+}
+
+variable "INSTANCE_STATE" {
+  description = "Whether the instance is running or stopped. Set by kumo stop and kumo start"
+  type        = string
+  default     = "running"
+
+  validation {
+    condition     = contains(["running", "stopped"], var.INSTANCE_STATE)
+    error_message = "INSTANCE_STATE must be either running or stopped"
+  }
+}
@@ -0,0 +1,111 @@
+locals {
+  HCLOUD_TOKEN        = trimspace(var.HCLOUD_TOKEN)
+  HETZNER_LOCATION    = trimspace(var.HETZNER_LOCATION)
+  HETZNER_SERVER_TYPE = trimspace(var.HETZNER_SERVER_TYPE)
+  SNAPSHOT_ID         = trimspace(var.SNAPSHOT_ID)
+
+  ALLOWED_IP = trimspace(var.ALLOWED_IP)
+  KEY_NAME   = trimspace(var.KEY_NAME)
+  SSH_PORT   = var.SSH_PORT
+  USERNAME   = trimspace(var.USERNAME)
+
+  KUMO_NAME_TAG  = trimspace(var.NAME_TAG)
+  INSTANCE_STATE = trimspace(var.INSTANCE_STATE)
+
+  # local-exec runs commands with cmd on windows and sh elsewhere, only the latter has home paths starting with /.
+  HCLOUD_TOKEN_REFERENCE = substr(pathexpand("~"), 0, 1) == "/" ? "$HCLOUD_TOKEN" : "%HCLOUD_TOKEN%"
+}
+
+terraform {
+  required_providers {
+    hcloud = {
+      source  = "hetznercloud/hcloud"
+      version = "~> 1.45"
+    }
+  }
+
+  required_version = ">= 1.2.0"
+}
+
+provider "hcloud" {
+  token = local.HCLOUD_TOKEN
+}
+
+resource "tls_private_key" "kumo-ssh-key" {
+  algorithm = "ED25519"
+}
+
+resource "local_file" "kumo-ssh-private-key" {
+  content         = tls_private_key.kumo-ssh-key.private_key_openssh
+  file_permission = "0600"
+  filename        = local.KEY_NAME
+}
+
+# Only authorized for root by Hetzner Cloud, the user_data of the server authorizes it for the instance user.
+resource "hcloud_ssh_key" "kumo-ssh-key" {
+  name       = local.KUMO_NAME_TAG
+  public_key = tls_private_key.kumo-ssh-key.public_key_openssh
+
+  labels = {
+    kumo-name = local.KUMO_NAME_TAG
+  }
+}
+
+resource "hcloud_firewall" "kumo-firewall" {
+  name = local.KUMO_NAME_TAG
+
+  rule {
+    description = "allow-ssh"
+    direction   = "in"
+    protocol    = "tcp"
+    port        = tostring(local.SSH_PORT)
+    source_ips  = [local.ALLOWED_IP]
+  }
+
+  labels = {
+    kumo-name = local.KUMO_NAME_TAG
+  }
+}
+
+resource "hcloud_server" "kumo-server" {
+  name         = local.KUMO_NAME_TAG
+  image        = local.SNAPSHOT_ID
+  server_type  = local.HETZNER_SERVER_TYPE
+  location     = local.HETZNER_LOCATION
+  ssh_keys     = [hcloud_ssh_key.kumo-ssh-key.id]
+  firewall_ids = [hcloud_firewall.kumo-firewall.id]
+
+  public_net {
+    ipv4_enabled = true
+    ipv6_enabled = true
+  }
+
+  user_data = <<-EOT
+    #!/bin/bash
+    install -d -m 700 -o ${local.USERNAME} -g ${local.USERNAME} ~${local.USERNAME}/.ssh
+    echo "${trimspace(tls_private_key.kumo-ssh-key.public_key_openssh)}" >> ~${local.USERNAME}/.ssh/authorized_keys
+    chown ${local.USERNAME}:${local.USERNAME} ~${local.USERNAME}/.ssh/authorized_keys
+    chmod 600 ~${local.USERNAME}/.ssh/authorized_keys
+  EOT
+
+  labels = {
+    kumo-name = local.KUMO_NAME_TAG
+  }
+}
+
+# The hcloud provider doesn't power servers off, the actions of the API do. curl fails on an error response, so
+# kumo stop and start fail too. The token is passed through the environment to keep it out of the command line.
+resource "null_resource" "kumo-server-power" {
+  triggers = {
+    server_id      = hcloud_server.kumo-server.id
+    instance_state = local.INSTANCE_STATE
+  }
+
+  provisioner "local-exec" {
+    command = "curl -fsS -X POST -H \"Authorization: Bearer ${local.HCLOUD_TOKEN_REFERENCE}\" https://api.hetzner.cloud/v1/servers/${self.triggers.server_id}/actions/${self.triggers.instance_state == "running" ? "poweron" : "shutdown"}"
+
+    environment = {
+      HCLOUD_TOKEN = local.HCLOUD_TOKEN
+    }
+  }
+}
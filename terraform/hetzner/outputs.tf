@@ -0,0 +1,21 @@
+# Read by kumo with terraform output -json, or from the state, to connect to the server.
+# The primary IPs of a server stay the same across kumo stop and start.
+output "instance_id" {
+  value = hcloud_server.kumo-server.id
+}
+
+output "public_ip" {
+  value = hcloud_server.kumo-server.ipv4_address
+}
+
+output "public_ipv6" {
+  value = hcloud_server.kumo-server.ipv6_address
+}
+
+output "public_dns" {
+  value = ""
+}
+
+output "availability_zone" {
+  value = hcloud_server.kumo-server.location
+}
@@ -0,0 +1,106 @@
+variable "HCLOUD_TOKEN" {
+  description = "The Hetzner Cloud API token of the project to deploy to"
+  type        = string
+  sensitive   = true
+
+  validation {
+    condition     = can(regex("^[a-zA-Z0-9]{64}$", var.HCLOUD_TOKEN))
+    error_message = "HCLOUD_TOKEN must be 64 alphanumeric characters"
+  }
+}
+
+variable "HETZNER_LOCATION" {
+  description = "The Hetzner Cloud location to deploy to"
+  type        = string
+
+  validation {
+    condition     = length(var.HETZNER_LOCATION) > 0
+    error_message = "HETZNER_LOCATION must be present"
+  }
+}
+
+variable "HETZNER_SERVER_TYPE" {
+  description = "The server type to use"
+  type        = string
+
+  validation {
+    condition     = length(var.HETZNER_SERVER_TYPE) > 0
+    error_message = "HETZNER_SERVER_TYPE must be present"
+  }
+}
+
+variable "SNAPSHOT_ID" {
+  description = "The ID of the snapshot to deploy"
+  type        = string
+
+  validation {
+    condition     = can(regex("^[0-9]+$", var.SNAPSHOT_ID))
+    error_message = "SNAPSHOT_ID must be a valid snapshot ID"
+  }
+}
+
+variable "ALLOWED_IP" {
+  description = "The IP address to allow SSH access from"
+  type        = string
+
+  validation {
+    condition     = length(var.ALLOWED_IP) > 0
+    error_message = "ALLOWED_IP must be present"
+  }
+
+  validation {
+    condition     = can(regex("^(?:[0-9]{1,3}\\.){3}[0-9]{1,3}(?:/[0-9]{1,2})?$", var.ALLOWED_IP))
+    error_message = "ALLOWED_IP must be a valid IP address with a CIDR mask"
+  }
+}
+
+variable "KEY_NAME" {
+  description = "The name of the SSH key to create"
+  type        = string
+
+  validation {
+    condition     = length(var.KEY_NAME) > 0
+    error_message = "KEY_NAME must be present"
+  }
+}
+
+variable "SSH_PORT" {
+  description = "The port to use for SSH"
+  type        = number
+
+  validation {
+    condition     = var.SSH_PORT > 0
+    error_message = "SSH_PORT must be greater than 0"
+  }
+}
+
+variable "USERNAME" {
+  description = "The username to use for SSH"
+  type        = string
+
+  validation {
+    condition     = length(var.USERNAME) > 0
+    error_message = "USERNAME must be present"
+  }
+}
+
+variable "NAME_TAG" {
+  description = "The name prefix and label of the created resources, unique per kumo environment"
+  type        = string
+
+  validation {
+    condition     = length(var.NAME_TAG) > 0
+    error_message = "NAME_TAG must be present"
+  }
+}
+
+variable "INSTANCE_STATE" {
+  description = "Whether the server is running or stopped. Set by kumo stop and kumo start"
+  type        = string
+  default     = "running"
+
+  validation {
+    condition     = contains(["running", "stopped"], var.INSTANCE_STATE)
+    error_message = "INSTANCE_STATE must be either running or stopped"
+  }
+}
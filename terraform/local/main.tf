@@ -0,0 +1,75 @@
+locals {
+  IMAGE_ID       = trimspace(var.IMAGE_ID)
+  LOCAL_SSH_PORT = var.LOCAL_SSH_PORT
+
+  KEY_NAME = trimspace(var.KEY_NAME)
+  SSH_PORT = var.SSH_PORT
+  USERNAME = trimspace(var.USERNAME)
+
+  KUMO_NAME_TAG  = trimspace(var.NAME_TAG)
+  INSTANCE_STATE = trimspace(var.INSTANCE_STATE)
+}
+
+terraform {
+  required_providers {
+    docker = {
+      source  = "kreuzwerker/docker"
+      version = "~> 3.0"
+    }
+  }
+
+  required_version = ">= 1.2.0"
+}
+
+# The docker engine is reached through DOCKER_HOST, like the docker CLI.
+provider "docker" {}
+
+resource "tls_private_key" "kumo-ssh-key" {
+  algorithm = "ED25519"
+}
+
+resource "local_file" "kumo-ssh-private-key" {
+  content         = tls_private_key.kumo-ssh-key.private_key_openssh
+  file_permission = "0600"
+  filename        = local.KEY_NAME
+}
+
+# The entrypoint of the image authorizes the key for the user and runs the ssh server, see packer/local.
+resource "docker_container" "kumo-container" {
+  name     = local.KUMO_NAME_TAG
+  hostname = local.KUMO_NAME_TAG
+  image    = local.IMAGE_ID
+
+  # Stopped by kumo stop, a stopped container must not be replaced.
+  must_run = false
+  restart  = "no"
+
+  env = [
+    "KUMO_USER=${local.USERNAME}",
+    "KUMO_AUTHORIZED_KEY=${trimspace(tls_private_key.kumo-ssh-key.public_key_openssh)}",
+  ]
+
+  # Only reachable from the host, ALLOWED_IP isn't needed.
+  ports {
+    internal = local.SSH_PORT
+    external = local.LOCAL_SSH_PORT
+    ip       = "127.0.0.1"
+  }
+
+  labels {
+    label = "kumo.name"
+    value = local.KUMO_NAME_TAG
+  }
+}
+
+# The docker provider doesn't stop containers, the docker CLI of the host does.
+resource "null_resource" "kumo-container-power" {
+  triggers = {
+    container_id   = docker_container.kumo-container.id
+    instance_state = local.INSTANCE_STATE
+  }
+
+  provisioner "local-exec" {
+    command = "docker ${self.triggers.instance_state == "running" ? "start" : "stop"} ${self.triggers.container_id}"
+  }
+}
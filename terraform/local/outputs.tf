@@ -0,0 +1,25 @@
+# Read by kumo with terraform output -json, or from the state, to connect to the container.
+output "instance_id" {
+  value = docker_container.kumo-container.id
+}
+
+output "public_ip" {
+  value = "127.0.0.1"
+}
+
+output "public_ipv6" {
+  value = ""
+}
+
+output "public_dns" {
+  value = ""
+}
+
+output "availability_zone" {
+  value = "local"
+}
+
+# The container is reached on a port of the host instead of the ssh port.
+output "ssh_port" {
+  value = local.LOCAL_SSH_PORT
+}
@@ -0,0 +1,91 @@
+variable "IMAGE_ID" {
+  description = "The ID of the docker image to run"
+  type        = string
+
+  validation {
+    condition     = length(var.IMAGE_ID) > 0
+    error_message = "IMAGE_ID must be set"
+  }
+
+  validation {
+    condition     = can(regex("^sha256:[0-9a-f]{64}$", var.IMAGE_ID))
+    error_message = "IMAGE_ID must be a valid docker image ID"
+  }
+}
+
+variable "LOCAL_SSH_PORT" {
+  description = "The port on 127.0.0.1 the ssh port of the container is published on"
+  type        = number
+
+  validation {
+    condition     = var.LOCAL_SSH_PORT > 0 && var.LOCAL_SSH_PORT < 65536
+    error_message = "LOCAL_SSH_PORT must be between 1 and 65535"
+  }
+}
+
+# Assigned by the base vars of every cloud, the container only listens on 127.0.0.1.
+variable "ALLOWED_IP" {
+  description = "The IP address to allow SSH access from, unused"
+  type        = string
+
+  validation {
+    condition     = length(var.ALLOWED_IP) > 0
+    error_message = "ALLOWED_IP must be present"
+  }
+
+  validation {
+    condition     = can(regex("^(?:[0-9]{1,3}\\.){3}[0-9]{1,3}(?:/[0-9]{1,2})?$", var.ALLOWED_IP))
+    error_message = "ALLOWED_IP must be a valid IP address with a CIDR mask"
+  }
+}
+
+variable "KEY_NAME" {
+  description = "The name of the SSH key to create"
+  type        = string
+
+  validation {
+    condition     = length(var.KEY_NAME) > 0
+    error_message = "KEY_NAME must be present"
+  }
+}
+
+variable "SSH_PORT" {
+  description = "The port to use for SSH"
+  type        = number
+
+  validation {
+    condition     = var.SSH_PORT > 0
+    error_message = "SSH_PORT must be greater than 0"
+  }
+}
+
+variable "USERNAME" {
+  description = "The username to use for SSH"
+  type        = string
+
+  validation {
+    condition     = length(var.USERNAME) > 0
+    error_message = "USERNAME must be present"
+  }
+}
+
+variable "NAME_TAG" {
+  description = "The name prefix and label of the created resources, unique per kumo environment"
+  type        = string
+
+  validation {
+    condition     = length(var.NAME_TAG) > 0
+    error_message = "NAME_TAG must be present"
+  }
+}
+
+variable "INSTANCE_STATE" {
+  description = "Whether the container is running or stopped. Set by kumo stop and kumo start"
+  type        = string
+  default     = "running"
+
+  validation {
+    condition     = contains(["running", "stopped"], var.INSTANCE_STATE)
+    error_message = "INSTANCE_STATE must be either running or stopped"
+  }
+}
@@ -0,0 +1,88 @@
+package transfer
+
+import (
+	"io"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// Creates the dirs and copies the files of the entries while showing the progress of the total bytes. Done is
+// called after each file is written.
+func (t *Transfer) copyEntries(
+	name string,
+	entries []*entry,
+	summary *Summary,
+	mkdir func(*entry) error,
+	open func(*entry) (io.ReadCloser, io.WriteCloser, error),
+	done func(*entry) error,
+) error {
+	oopsBuilder := oops.
+		Code("copyEntries").
+		In("transfer").
+		Tags("Transfer")
+
+	total := lo.SumBy(entries, func(e *entry) int64 {
+		if e.isDir {
+			return 0
+		}
+
+		return e.size
+	})
+
+	progress, bar := t.newBar(name, total)
+
+	err := func() error {
+		for _, e := range entries {
+			if e.isDir {
+				err := mkdir(e)
+				if err != nil {
+					return oopsBuilder.
+						Wrapf(err, "failed to create dir %s", e.relativePath)
+				}
+
+				summary.Dirs++
+				continue
+			}
+
+			source, destination, err := open(e)
+			if err != nil {
+				return oopsBuilder.
+					Wrapf(err, "failed to open %s", e.relativePath)
+			}
+
+			written, err := io.Copy(destination, bar.ProxyReader(source))
+			source.Close()
+			closeErr := destination.Close()
+			if err != nil {
+				return oopsBuilder.
+					Wrapf(err, "failed to copy %s", e.relativePath)
+			}
+			if closeErr != nil {
+				return oopsBuilder.
+					Wrapf(closeErr, "failed to close %s", e.relativePath)
+			}
+
+			err = done(e)
+			if err != nil {
+				return oopsBuilder.
+					Wrapf(err, "failed to set the times of %s", e.relativePath)
+			}
+
+			summary.Files++
+			summary.Bytes += written
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		bar.Abort(false)
+	} else {
+		// Completes the bar even if the files changed size while being copied.
+		bar.SetTotal(-1, true)
+	}
+	progress.Wait()
+
+	return err
+}
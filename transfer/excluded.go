@@ -0,0 +1,19 @@
+package transfer
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Returns true if the path, relative to the local root, must not be synced. Paths that no longer exist are
+// checked both as a file and as a dir.
+func (s *Sync) excluded(
+	relativePath string,
+) bool {
+	info, err := os.Lstat(filepath.Join(s.LocalRoot, filepath.FromSlash(relativePath)))
+	if err != nil {
+		return isExcluded(s.matcher, relativePath, false) || isExcluded(s.matcher, relativePath, true)
+	}
+
+	return isExcluded(s.matcher, relativePath, info.IsDir())
+}
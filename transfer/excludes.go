@@ -0,0 +1,51 @@
+package transfer
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"strings"
+
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+var ignoreFiles = []string{".gitignore", ".kumoignore"}
+
+// Returns the matcher for the paths to skip under root. The .git dir is always skipped. Missing ignore
+// files are fine.
+func (t *Transfer) excludes(
+	open func(name string) (io.ReadCloser, error),
+	root string,
+) *ignore.GitIgnore {
+	lines := []string{".git/"}
+
+	for _, ignoreFile := range ignoreFiles {
+		file, err := open(path.Join(root, ignoreFile))
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		file.Close()
+	}
+
+	lines = append(lines, t.ExcludePatterns...)
+
+	return ignore.CompileIgnoreLines(lines...)
+}
+
+// Returns true if the path, relative to the transferred dir and slash separated, must be skipped.
+func isExcluded(
+	matcher *ignore.GitIgnore,
+	relativePath string,
+	isDir bool,
+) bool {
+	if isDir && !strings.HasSuffix(relativePath, "/") {
+		relativePath += "/"
+	}
+
+	return matcher.MatchesPath(relativePath)
+}
@@ -0,0 +1,30 @@
+package transfer
+
+import (
+	"github.com/vbauerster/mpb/v8"
+	"github.com/vbauerster/mpb/v8/decor"
+)
+
+// Returns a progress bar for the total bytes to transfer, like the ones of the download package.
+func (t *Transfer) newBar(
+	name string,
+	total int64,
+) (*mpb.Progress, *mpb.Bar) {
+	progress := mpb.New(mpb.WithOutput(t.Output), mpb.WithWidth(64))
+
+	bar := progress.AddBar(
+		total,
+		mpb.PrependDecorators(
+			decor.Name(name),
+			decor.Counters(decor.SizeB1024(0), " % .2f / % .2f"),
+		),
+		mpb.AppendDecorators(
+			decor.OnComplete(
+				decor.Percentage(decor.WCSyncSpace),
+				"done",
+			),
+		),
+	)
+
+	return progress, bar
+}
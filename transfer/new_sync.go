@@ -0,0 +1,45 @@
+package transfer
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// Returns a Sync instance. The Sync instance keeps the remote root in sync with the local root, one way.
+// Files newer on the remote are reported as conflicts and left alone unless force is set.
+func NewSync(
+	_transfer *Transfer,
+	localRoot,
+	remoteRoot string,
+	force bool,
+) *Sync {
+	return &Sync{
+		Transfer:   _transfer,
+		LocalRoot:  localRoot,
+		RemoteRoot: remoteRoot,
+		Force:      force,
+		matcher: _transfer.excludes(func(name string) (io.ReadCloser, error) {
+			return os.Open(filepath.FromSlash(name))
+		}, filepath.ToSlash(localRoot)),
+	}
+}
+
+type Sync struct {
+	Transfer   *Transfer
+	LocalRoot  string
+	RemoteRoot string
+	Force      bool
+	matcher    *ignore.GitIgnore
+}
+
+// The outcome of syncing a set of paths. Paths are relative to the local root and slash separated.
+type Batch struct {
+	Pushed    []string
+	Deleted   []string
+	Conflicts []string
+	Unchanged int
+	Bytes     int64
+}
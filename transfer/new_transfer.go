@@ -0,0 +1,55 @@
+package transfer
+
+import (
+	"io"
+	"io/fs"
+	"time"
+
+	"github.com/pkg/sftp"
+)
+
+// Returns a Transfer instance. The Transfer instance is used to push and pull files and dirs over sftp.
+// Exclude patterns follow the .gitignore syntax and are added to the ones found in the .gitignore and
+// .kumoignore files at the root of the transferred dir.
+func NewTransfer(
+	client *sftp.Client,
+	output io.Writer,
+	excludePatterns []string,
+) *Transfer {
+	return &Transfer{
+		Client:          client,
+		Output:          output,
+		ExcludePatterns: excludePatterns,
+	}
+}
+
+type Transfer struct {
+	Client          *sftp.Client
+	Output          io.Writer
+	ExcludePatterns []string
+}
+
+type Summary struct {
+	Files   int
+	Dirs    int
+	Bytes   int64
+	Skipped int
+}
+
+type entry struct {
+	relativePath string
+	isDir        bool
+	size         int64
+	mode         uint32
+	modTime      time.Time
+}
+
+func newEntry(relativePath string, info fs.FileInfo) *entry {
+	return &entry{
+		relativePath: relativePath,
+		isDir:        info.IsDir(),
+		size:         info.Size(),
+		mode:         uint32(info.Mode().Perm()),
+		modTime:      info.ModTime(),
+	}
+}
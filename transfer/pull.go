@@ -0,0 +1,123 @@
+package transfer
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/samber/oops"
+)
+
+// Copies a remote file or dir to the local path, recursively. For a dir, the contents of the remote dir end
+// up in the local dir. Excluded paths and anything that isn't a regular file or a dir are skipped. Modes and
+// modification times are kept.
+//
+// Example:
+//
+//	("workspace/project", "./project") -> (&Summary{Files: 12, Dirs: 3, Bytes: 48213}, nil)
+func (t *Transfer) Pull(
+	remotePath,
+	localPath string,
+) (*Summary, error) {
+	oopsBuilder := oops.
+		Code("Pull").
+		In("transfer").
+		Tags("Transfer").
+		With("remotePath", remotePath).
+		With("localPath", localPath)
+
+	info, err := t.Client.Stat(remotePath)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to stat remote %s", remotePath)
+	}
+
+	summary := &Summary{}
+	entries := []*entry{newEntry(".", info)}
+
+	if info.IsDir() {
+		entries = []*entry{}
+
+		matcher := t.excludes(func(name string) (io.ReadCloser, error) {
+			return t.Client.Open(name)
+		}, remotePath)
+
+		walker := t.Client.Walk(remotePath)
+		for walker.Step() {
+			err := walker.Err()
+			if err != nil {
+				return nil, oopsBuilder.
+					Wrapf(err, "failed to walk remote %s", remotePath)
+			}
+
+			stat := walker.Stat()
+
+			relativePath, err := filepath.Rel(remotePath, walker.Path())
+			if err != nil {
+				return nil, oopsBuilder.
+					Wrapf(err, "failed to get the relative path of %s", walker.Path())
+			}
+			relativePath = filepath.ToSlash(relativePath)
+
+			if relativePath != "." && isExcluded(matcher, relativePath, stat.IsDir()) {
+				summary.Skipped++
+
+				if stat.IsDir() {
+					walker.SkipDir()
+				}
+
+				continue
+			}
+
+			if !stat.IsDir() && !stat.Mode().IsRegular() {
+				summary.Skipped++
+
+				continue
+			}
+
+			entries = append(entries, newEntry(relativePath, stat))
+		}
+	}
+
+	err = t.copyEntries(
+		"pull",
+		entries,
+		summary,
+		func(e *entry) error {
+			return os.MkdirAll(filepath.Join(localPath, filepath.FromSlash(e.relativePath)), 0755)
+		},
+		func(e *entry) (io.ReadCloser, io.WriteCloser, error) {
+			source, err := t.Client.Open(path.Join(remotePath, e.relativePath))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			destinationPath := filepath.Join(localPath, filepath.FromSlash(e.relativePath))
+
+			err = os.MkdirAll(filepath.Dir(destinationPath), 0755)
+			if err != nil {
+				source.Close()
+				return nil, nil, err
+			}
+
+			destination, err := os.OpenFile(destinationPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, fs.FileMode(e.mode))
+			if err != nil {
+				source.Close()
+				return nil, nil, err
+			}
+
+			return source, destination, nil
+		},
+		func(e *entry) error {
+			return os.Chtimes(filepath.Join(localPath, filepath.FromSlash(e.relativePath)), e.modTime, e.modTime)
+		},
+	)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to pull %s to %s", remotePath, localPath)
+	}
+
+	return summary, nil
+}
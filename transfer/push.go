@@ -0,0 +1,98 @@
+package transfer
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/samber/oops"
+)
+
+// Copies a local file or dir to the remote path, recursively. For a dir, the contents of the local dir end
+// up in the remote dir. Excluded paths and anything that isn't a regular file or a dir are skipped. Modes and
+// modification times are kept.
+//
+// Example:
+//
+//	("./project", "workspace/project") -> (&Summary{Files: 12, Dirs: 3, Bytes: 48213}, nil)
+func (t *Transfer) Push(
+	localPath,
+	remotePath string,
+) (*Summary, error) {
+	oopsBuilder := oops.
+		Code("Push").
+		In("transfer").
+		Tags("Transfer").
+		With("localPath", localPath).
+		With("remotePath", remotePath)
+
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to stat %s", localPath)
+	}
+
+	summary := &Summary{}
+	entries := []*entry{newEntry(".", info)}
+
+	if info.IsDir() {
+		matcher := t.excludes(func(name string) (io.ReadCloser, error) {
+			return os.Open(filepath.FromSlash(name))
+		}, filepath.ToSlash(localPath))
+
+		entries, summary.Skipped, err = walkLocal(localPath, localPath, matcher)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "failed to list the files to push")
+		}
+	}
+
+	err = t.copyEntries(
+		"push",
+		entries,
+		summary,
+		func(e *entry) error {
+			return t.Client.MkdirAll(path.Join(remotePath, e.relativePath))
+		},
+		func(e *entry) (io.ReadCloser, io.WriteCloser, error) {
+			source, err := os.Open(filepath.Join(localPath, filepath.FromSlash(e.relativePath)))
+			if err != nil {
+				return nil, nil, err
+			}
+
+			destinationPath := path.Join(remotePath, e.relativePath)
+
+			err = t.Client.MkdirAll(path.Dir(destinationPath))
+			if err != nil {
+				source.Close()
+				return nil, nil, err
+			}
+
+			destination, err := t.Client.OpenFile(destinationPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+			if err != nil {
+				source.Close()
+				return nil, nil, err
+			}
+
+			err = destination.Chmod(fs.FileMode(e.mode))
+			if err != nil {
+				source.Close()
+				destination.Close()
+				return nil, nil, err
+			}
+
+			return source, destination, nil
+		},
+		func(e *entry) error {
+			return t.Client.Chtimes(path.Join(remotePath, e.relativePath), e.modTime, e.modTime)
+		},
+	)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to push %s to %s", localPath, remotePath)
+	}
+
+	return summary, nil
+}
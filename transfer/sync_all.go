@@ -0,0 +1,22 @@
+package transfer
+
+import (
+	"github.com/samber/oops"
+)
+
+// Syncs every file under the local root. Remote files missing locally are kept.
+func (s *Sync) SyncAll() (*Batch, error) {
+	oopsBuilder := oops.
+		Code("SyncAll").
+		In("transfer").
+		Tags("Sync").
+		With("localRoot", s.LocalRoot)
+
+	batch, err := s.SyncPaths([]string{"."})
+	if err != nil {
+		return batch, oopsBuilder.
+			Wrapf(err, "failed to sync %s", s.LocalRoot)
+	}
+
+	return batch, nil
+}
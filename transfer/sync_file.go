@@ -0,0 +1,95 @@
+package transfer
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/samber/oops"
+)
+
+// Uploads a regular file unless the remote copy has the same size and modification time, or is newer.
+// The remote copy gets the mode and modification time of the local file, so later syncs can compare them.
+func (s *Sync) syncFile(
+	e *entry,
+	batch *Batch,
+) error {
+	oopsBuilder := oops.
+		Code("syncFile").
+		In("transfer").
+		Tags("Sync").
+		With("relativePath", e.relativePath)
+
+	remotePath := path.Join(s.RemoteRoot, e.relativePath)
+
+	remoteInfo, err := s.Transfer.Client.Stat(remotePath)
+	switch {
+	case err == nil:
+		// sftp only keeps seconds.
+		if remoteInfo.Size() == e.size && remoteInfo.ModTime().Unix() == e.modTime.Unix() {
+			batch.Unchanged++
+			return nil
+		}
+
+		if !s.Force && remoteInfo.ModTime().Unix() > e.modTime.Unix() {
+			batch.Conflicts = append(batch.Conflicts, e.relativePath)
+			return nil
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return oopsBuilder.
+			Wrapf(err, "failed to stat remote %s", remotePath)
+	}
+
+	source, err := os.Open(filepath.Join(s.LocalRoot, filepath.FromSlash(e.relativePath)))
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to open %s", e.relativePath)
+	}
+	defer source.Close()
+
+	err = s.Transfer.Client.MkdirAll(path.Dir(remotePath))
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to create remote dir for %s", remotePath)
+	}
+
+	destination, err := s.Transfer.Client.OpenFile(remotePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to open remote %s", remotePath)
+	}
+
+	written, err := io.Copy(destination, source)
+	if err != nil {
+		destination.Close()
+		return oopsBuilder.
+			Wrapf(err, "failed to copy %s", e.relativePath)
+	}
+
+	err = destination.Chmod(fs.FileMode(e.mode))
+	if err != nil {
+		destination.Close()
+		return oopsBuilder.
+			Wrapf(err, "failed to chmod remote %s", remotePath)
+	}
+
+	err = destination.Close()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to close remote %s", remotePath)
+	}
+
+	err = s.Transfer.Client.Chtimes(remotePath, e.modTime, e.modTime)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to set the times of remote %s", remotePath)
+	}
+
+	batch.Pushed = append(batch.Pushed, e.relativePath)
+	batch.Bytes += written
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package transfer
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// Syncs the given paths, relative to the local root and slash separated. Dirs are synced recursively and
+// paths missing locally are deleted on the remote, which covers renames too. Remote files newer than the
+// local ones are reported as conflicts and skipped unless Force is set.
+//
+// Example:
+//
+//	([]string{"main.go", "old.go"}) -> (&Batch{Pushed: []string{"main.go"}, Deleted: []string{"old.go"}}, nil)
+func (s *Sync) SyncPaths(
+	relativePaths []string,
+) (*Batch, error) {
+	oopsBuilder := oops.
+		Code("SyncPaths").
+		In("transfer").
+		Tags("Sync").
+		With("localRoot", s.LocalRoot).
+		With("remoteRoot", s.RemoteRoot)
+
+	relativePaths = lo.Uniq(relativePaths)
+	sort.Strings(relativePaths)
+
+	batch := &Batch{}
+
+	err := s.Transfer.Client.MkdirAll(s.RemoteRoot)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to create remote dir %s", s.RemoteRoot)
+	}
+
+	for _, relativePath := range relativePaths {
+		if relativePath != "." && s.excluded(relativePath) {
+			continue
+		}
+
+		localPath := filepath.Join(s.LocalRoot, filepath.FromSlash(relativePath))
+		remotePath := path.Join(s.RemoteRoot, relativePath)
+
+		info, err := os.Lstat(localPath)
+		if errors.Is(err, fs.ErrNotExist) {
+			_, err := s.Transfer.Client.Lstat(remotePath)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			err = s.Transfer.Client.RemoveAll(remotePath)
+			if err != nil {
+				return batch, oopsBuilder.
+					Wrapf(err, "failed to delete remote %s", remotePath)
+			}
+
+			batch.Deleted = append(batch.Deleted, relativePath)
+			continue
+		}
+		if err != nil {
+			return batch, oopsBuilder.
+				Wrapf(err, "failed to stat %s", localPath)
+		}
+
+		if !info.IsDir() {
+			if info.Mode().IsRegular() {
+				err = s.syncFile(newEntry(relativePath, info), batch)
+				if err != nil {
+					return batch, oopsBuilder.
+						Wrapf(err, "failed to sync %s", relativePath)
+				}
+			}
+
+			continue
+		}
+
+		entries, _, err := walkLocal(s.LocalRoot, localPath, s.matcher)
+		if err != nil {
+			return batch, oopsBuilder.
+				Wrapf(err, "failed to list the files to sync under %s", localPath)
+		}
+
+		for _, e := range entries {
+			if e.isDir {
+				err = s.Transfer.Client.MkdirAll(path.Join(s.RemoteRoot, e.relativePath))
+				if err != nil {
+					return batch, oopsBuilder.
+						Wrapf(err, "failed to create remote dir for %s", e.relativePath)
+				}
+
+				continue
+			}
+
+			err = s.syncFile(e, batch)
+			if err != nil {
+				return batch, oopsBuilder.
+					Wrapf(err, "failed to sync %s", e.relativePath)
+			}
+		}
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,33 @@
+package tests
+
+import (
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/sftp"
+)
+
+// Returns a client connected to an in-process sftp server serving the local filesystem.
+func newSftpClient() *sftp.Client {
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	server, err := sftp.NewServer(struct {
+		io.Reader
+		io.WriteCloser
+	}{serverReader, serverWriter})
+	Expect(err).ToNot(HaveOccurred())
+
+	go server.Serve()
+
+	client, err := sftp.NewClientPipe(clientReader, clientWriter)
+	Expect(err).ToNot(HaveOccurred())
+
+	DeferCleanup(func() {
+		server.Close()
+		client.Close()
+	})
+
+	return client
+}
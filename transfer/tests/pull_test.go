@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/transfer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pull", Label("unit"), func() {
+	var (
+		remoteDir string
+		localDir  string
+	)
+
+	BeforeEach(func() {
+		remoteDir = GinkgoT().TempDir()
+		localDir = filepath.Join(GinkgoT().TempDir(), "project")
+
+		Expect(os.MkdirAll(filepath.Join(remoteDir, "out", "bin"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(remoteDir, "cache"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(remoteDir, ".kumoignore"), []byte("cache/\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(remoteDir, "report.txt"), []byte("report"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(remoteDir, "out", "bin", "app"), []byte("binary"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(remoteDir, "cache", "entry"), []byte("cached"), 0644)).To(Succeed())
+	})
+
+	It("should copy the remote dir recursively honoring the ignore files", func() {
+		_transfer := transfer.NewTransfer(newSftpClient(), io.Discard, nil)
+
+		summary, err := _transfer.Pull(remoteDir, localDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(filepath.Join(localDir, "report.txt")).To(BeAnExistingFile())
+		Expect(filepath.Join(localDir, "cache")).ToNot(BeAnExistingFile())
+
+		content, err := os.ReadFile(filepath.Join(localDir, "out", "bin", "app"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("binary"))
+
+		info, err := os.Stat(filepath.Join(localDir, "out", "bin", "app"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+
+		Expect(summary.Files).To(Equal(3))
+		Expect(summary.Skipped).To(Equal(1))
+	})
+
+	It("should return an error if the remote path doesn't exist", func() {
+		_transfer := transfer.NewTransfer(newSftpClient(), io.Discard, nil)
+
+		_, err := _transfer.Pull(filepath.Join(remoteDir, "missing"), localDir)
+		Expect(err).To(HaveOccurred())
+	})
+})
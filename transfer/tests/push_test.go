@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/transfer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Push", Label("unit"), func() {
+	var (
+		localDir  string
+		remoteDir string
+	)
+
+	BeforeEach(func() {
+		localDir = GinkgoT().TempDir()
+		remoteDir = filepath.Join(GinkgoT().TempDir(), "project")
+
+		Expect(os.MkdirAll(filepath.Join(localDir, "src", "pkg"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(localDir, "node_modules", "lib"), 0755)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(localDir, ".git"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, ".gitignore"), []byte("node_modules/\n*.log\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, ".kumoignore"), []byte("secret.txt\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "main.go"), []byte("package main"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "run.sh"), []byte("#!/bin/sh"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "debug.log"), []byte("log"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "secret.txt"), []byte("secret"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "src", "pkg", "lib.go"), []byte("package pkg"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "node_modules", "lib", "index.js"), []byte("js"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, ".git", "HEAD"), []byte("ref"), 0644)).To(Succeed())
+	})
+
+	It("should copy the dir recursively honoring the ignore files", func() {
+		_transfer := transfer.NewTransfer(newSftpClient(), io.Discard, nil)
+
+		summary, err := _transfer.Push(localDir, remoteDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(filepath.Join(remoteDir, "main.go")).To(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, ".gitignore")).To(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, "src", "pkg", "lib.go")).To(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, "debug.log")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, "secret.txt")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, "node_modules")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, ".git")).ToNot(BeAnExistingFile())
+
+		content, err := os.ReadFile(filepath.Join(remoteDir, "src", "pkg", "lib.go"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("package pkg"))
+
+		info, err := os.Stat(filepath.Join(remoteDir, "run.sh"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+
+		Expect(summary.Files).To(Equal(5))
+		Expect(summary.Skipped).To(Equal(4))
+		Expect(summary.Bytes).To(BeNumerically(">", 0))
+	})
+
+	It("should honor the extra exclude patterns", func() {
+		_transfer := transfer.NewTransfer(newSftpClient(), io.Discard, []string{"/src"})
+
+		_, err := _transfer.Push(localDir, remoteDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(filepath.Join(remoteDir, "main.go")).To(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, "src")).ToNot(BeAnExistingFile())
+	})
+
+	It("should copy a single file", func() {
+		_transfer := transfer.NewTransfer(newSftpClient(), io.Discard, nil)
+		remoteFile := filepath.Join(GinkgoT().TempDir(), "copied.go")
+
+		summary, err := _transfer.Push(filepath.Join(localDir, "main.go"), remoteFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(summary.Files).To(Equal(1))
+
+		content, err := os.ReadFile(remoteFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("package main"))
+	})
+
+	It("should return an error if the local path doesn't exist", func() {
+		_transfer := transfer.NewTransfer(newSftpClient(), io.Discard, nil)
+
+		_, err := _transfer.Push(filepath.Join(localDir, "missing"), remoteDir)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ed3899/kumo/transfer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SyncPaths", Label("unit"), func() {
+	var (
+		localDir  string
+		remoteDir string
+		_sync     *transfer.Sync
+	)
+
+	BeforeEach(func() {
+		localDir = GinkgoT().TempDir()
+		remoteDir = filepath.Join(GinkgoT().TempDir(), "workspace")
+
+		Expect(os.MkdirAll(filepath.Join(localDir, "src"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, ".kumoignore"), []byte("*.log\n"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "main.go"), []byte("package main"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "debug.log"), []byte("log"), 0644)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "src", "lib.go"), []byte("package src"), 0644)).To(Succeed())
+
+		_sync = transfer.NewSync(transfer.NewTransfer(newSftpClient(), io.Discard, nil), localDir, remoteDir, false)
+	})
+
+	It("should push every file not excluded on the first sync", func() {
+		batch, err := _sync.SyncAll()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(batch.Pushed).To(ConsistOf(".kumoignore", "main.go", "src/lib.go"))
+		Expect(filepath.Join(remoteDir, "src", "lib.go")).To(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, "debug.log")).ToNot(BeAnExistingFile())
+	})
+
+	It("should skip unchanged files", func() {
+		_, err := _sync.SyncAll()
+		Expect(err).ToNot(HaveOccurred())
+
+		batch, err := _sync.SyncAll()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(batch.Pushed).To(BeEmpty())
+		Expect(batch.Unchanged).To(Equal(3))
+	})
+
+	It("should delete paths missing locally, covering renames", func() {
+		_, err := _sync.SyncAll()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(os.Rename(filepath.Join(localDir, "src"), filepath.Join(localDir, "pkg"))).To(Succeed())
+
+		batch, err := _sync.SyncPaths([]string{"src", "pkg"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(batch.Deleted).To(HaveExactElements("src"))
+		Expect(batch.Pushed).To(HaveExactElements("pkg/lib.go"))
+		Expect(filepath.Join(remoteDir, "src")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(remoteDir, "pkg", "lib.go")).To(BeAnExistingFile())
+	})
+
+	It("should ignore excluded paths", func() {
+		batch, err := _sync.SyncPaths([]string{"debug.log"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(batch.Pushed).To(BeEmpty())
+	})
+
+	Context("when the remote file is newer", func() {
+		BeforeEach(func() {
+			_, err := _sync.SyncAll()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(os.WriteFile(filepath.Join(remoteDir, "main.go"), []byte("package remote"), 0644)).To(Succeed())
+			future := time.Now().Add(time.Hour)
+			Expect(os.Chtimes(filepath.Join(remoteDir, "main.go"), future, future)).To(Succeed())
+
+			Expect(os.WriteFile(filepath.Join(localDir, "main.go"), []byte("package local"), 0644)).To(Succeed())
+		})
+
+		It("should report a conflict and keep the remote file", func() {
+			batch, err := _sync.SyncPaths([]string{"main.go"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batch.Conflicts).To(HaveExactElements("main.go"))
+
+			content, err := os.ReadFile(filepath.Join(remoteDir, "main.go"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal("package remote"))
+		})
+
+		It("should overwrite the remote file when forced", func() {
+			_sync.Force = true
+
+			batch, err := _sync.SyncPaths([]string{"main.go"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(batch.Conflicts).To(BeEmpty())
+			Expect(batch.Pushed).To(HaveExactElements("main.go"))
+
+			content, err := os.ReadFile(filepath.Join(remoteDir, "main.go"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal("package local"))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ed3899/kumo/transfer"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Watch", Label("unit"), func() {
+	var (
+		localDir  string
+		remoteDir string
+		batches   []*transfer.Batch
+		mutex     sync.Mutex
+	)
+
+	BeforeEach(func() {
+		localDir = GinkgoT().TempDir()
+		remoteDir = filepath.Join(GinkgoT().TempDir(), "workspace")
+		batches = nil
+
+		Expect(os.WriteFile(filepath.Join(localDir, "old.go"), []byte("package old"), 0644)).To(Succeed())
+
+		_sync := transfer.NewSync(transfer.NewTransfer(newSftpClient(), io.Discard, nil), localDir, remoteDir, false)
+		_, err := _sync.SyncAll()
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+
+			err := _sync.Watch(ctx, 50*time.Millisecond, func(batch *transfer.Batch, err error) {
+				Expect(err).ToNot(HaveOccurred())
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				batches = append(batches, batch)
+			})
+			Expect(err).ToNot(HaveOccurred())
+		}()
+
+		DeferCleanup(func() {
+			cancel()
+			<-done
+		})
+
+		// Gives the watcher time to add its watches.
+		time.Sleep(100 * time.Millisecond)
+	})
+
+	It("should push new files in new dirs and delete removed ones in one batch", func() {
+		Expect(os.MkdirAll(filepath.Join(localDir, "src"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(localDir, "src", "new.go"), []byte("package src"), 0644)).To(Succeed())
+		Expect(os.Remove(filepath.Join(localDir, "old.go"))).To(Succeed())
+
+		Eventually(func() string {
+			return filepath.Join(remoteDir, "src", "new.go")
+		}).Should(BeAnExistingFile())
+		Eventually(func() string {
+			return filepath.Join(remoteDir, "old.go")
+		}).ShouldNot(BeAnExistingFile())
+
+		Eventually(func() int {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return len(batches)
+		}).Should(Equal(1))
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		Expect(batches[0].Deleted).To(HaveExactElements("old.go"))
+	})
+
+	It("should pick up files written later in new dirs", func() {
+		Expect(os.MkdirAll(filepath.Join(localDir, "later"), 0755)).To(Succeed())
+		time.Sleep(200 * time.Millisecond)
+
+		Expect(os.WriteFile(filepath.Join(localDir, "later", "file.go"), []byte("package later"), 0644)).To(Succeed())
+
+		Eventually(func() string {
+			return filepath.Join(remoteDir, "later", "file.go")
+		}).Should(BeAnExistingFile())
+	})
+})
@@ -0,0 +1,71 @@
+package transfer
+
+import (
+	"io/fs"
+	"path/filepath"
+
+	ignore "github.com/sabhiram/go-gitignore"
+	"github.com/samber/oops"
+)
+
+// Returns the dirs and regular files under start, start included, that aren't excluded by the matcher, along
+// with the number of skipped paths. Relative paths are slash separated and relative to root, which start is
+// in.
+func walkLocal(
+	root,
+	start string,
+	matcher *ignore.GitIgnore,
+) ([]*entry, int, error) {
+	oopsBuilder := oops.
+		Code("walkLocal").
+		In("transfer").
+		Tags("Transfer").
+		With("root", root).
+		With("start", start)
+
+	entries := []*entry{}
+	skipped := 0
+
+	err := filepath.WalkDir(start, func(currentPath string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(root, currentPath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		if relativePath != "." && isExcluded(matcher, relativePath, dirEntry.IsDir()) {
+			skipped++
+
+			if dirEntry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !dirEntry.IsDir() && !dirEntry.Type().IsRegular() {
+			skipped++
+
+			return nil
+		}
+
+		info, err := dirEntry.Info()
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, newEntry(relativePath, info))
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, oopsBuilder.
+			Wrapf(err, "failed to walk %s", start)
+	}
+
+	return entries, skipped, nil
+}
@@ -0,0 +1,115 @@
+package transfer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// Watches the local root and syncs the changed paths once no change happened for the debounce duration.
+// OnBatch is called after every sync, or with the error if it failed, and watching goes on until the context
+// is done.
+func (s *Sync) Watch(
+	ctx context.Context,
+	debounce time.Duration,
+	onBatch func(*Batch, error),
+) error {
+	oopsBuilder := oops.
+		Code("Watch").
+		In("transfer").
+		Tags("Sync").
+		With("localRoot", s.LocalRoot)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to create watcher")
+	}
+	defer watcher.Close()
+
+	// fsnotify isn't recursive, every dir needs its own watch.
+	watchDirs := func(start string) error {
+		entries, _, err := walkLocal(s.LocalRoot, start, s.matcher)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range entries {
+			if !e.isDir {
+				continue
+			}
+
+			err = watcher.Add(filepath.Join(s.LocalRoot, filepath.FromSlash(e.relativePath)))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	err = watchDirs(s.LocalRoot)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to watch %s", s.LocalRoot)
+	}
+
+	pending := map[string]struct{}{}
+	var flush <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+
+			relativePath, err := filepath.Rel(s.LocalRoot, event.Name)
+			if err != nil || relativePath == "." {
+				continue
+			}
+			relativePath = filepath.ToSlash(relativePath)
+
+			if s.excluded(relativePath) {
+				continue
+			}
+
+			if event.Has(fsnotify.Create) {
+				info, err := os.Lstat(event.Name)
+				if err == nil && info.IsDir() {
+					err = watchDirs(event.Name)
+					if err != nil {
+						onBatch(nil, oopsBuilder.
+							Wrapf(err, "failed to watch %s", event.Name))
+					}
+				}
+			}
+
+			pending[relativePath] = struct{}{}
+			flush = time.After(debounce)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+
+			onBatch(nil, oopsBuilder.
+				Wrapf(err, "watcher failed"))
+
+		case <-flush:
+			relativePaths := lo.Keys(pending)
+			pending = map[string]struct{}{}
+			flush = nil
+
+			onBatch(s.SyncPaths(relativePaths))
+		}
+	}
+}
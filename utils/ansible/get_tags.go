@@ -0,0 +1,50 @@
+package ansible
+
+import (
+	"os"
+
+	"github.com/ed3899/kumo/common/constants"
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+	"gopkg.in/yaml.v3"
+)
+
+type PlaybookImport struct {
+	Name string   `yaml:"name"`
+	Tags []string `yaml:"tags"`
+}
+
+// Returns the tags declared on the imports of the given playbook, in order of appearance.
+// The "always" tag is excluded since it can't be picked.
+//
+// Example:
+//
+//	("packer/ansible/playbooks/main.yml") -> ([]string{"aws", "docker", "pulumi", ...}, nil)
+func GetTags(
+	pathToPlaybook string,
+) ([]string, error) {
+	oopsBuilder := oops.
+		Code("GetTags").
+		In("utils").
+		In("ansible").
+		With("pathToPlaybook", pathToPlaybook)
+
+	content, err := os.ReadFile(pathToPlaybook)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read playbook: %s", pathToPlaybook)
+	}
+
+	imports := []*PlaybookImport{}
+	err = yaml.Unmarshal(content, &imports)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to decode playbook: %s", pathToPlaybook)
+	}
+
+	tags := lo.FlatMap(imports, func(pi *PlaybookImport, _ int) []string {
+		return pi.Tags
+	})
+
+	return lo.Uniq(lo.Without(tags, constants.ANSIBLE_ALWAYS_TAG)), nil
+}
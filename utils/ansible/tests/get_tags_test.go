@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/ansible"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetTags", func() {
+	Context("with a valid playbook", Label("unit"), func() {
+		var (
+			playbookPath string
+		)
+
+		BeforeEach(func() {
+			playbookPath = filepath.Join(GinkgoT().TempDir(), "main.yml")
+
+			content := `- name: Base
+  ansible.builtin.import_playbook: playbooks/base/main.yml
+  tags:
+  - always
+- name: Docker
+  ansible.builtin.import_playbook: playbooks/containerization/docker.yml
+  tags:
+  - docker
+- name: Go
+  ansible.builtin.import_playbook: playbooks/programming_languages/go.yml
+  tags:
+  - go
+  - docker
+`
+			Expect(os.WriteFile(playbookPath, []byte(content), 0644)).To(Succeed())
+		})
+
+		It("should return the unique tags in order without 'always'", func() {
+			tags, err := ansible.GetTags(playbookPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tags).To(Equal([]string{"docker", "go"}))
+		})
+	})
+
+	Context("with the playbook shipped with kumo", Label("unit"), func() {
+		It("should return the known tools", func() {
+			tags, err := ansible.GetTags(filepath.Join("..", "..", "..", "packer", "ansible", "playbooks", "main.yml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tags).To(ContainElements("aws", "docker", "minikube", "node_js", "github"))
+			Expect(tags).ToNot(ContainElement("always"))
+		})
+	})
+
+	Context("with a missing playbook", Label("unit"), func() {
+		It("should return an error", func() {
+			_, err := ansible.GetTags("missing.yml")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
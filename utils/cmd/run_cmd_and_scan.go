@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"bytes"
+	"os/exec"
+)
+
+// Same as RunCmdAndStream, but every line the command writes to stdout is handed to onLine instead of being
+// printed. Stderr is still printed. Used for tools with a machine readable output.
+//
+// Example:
+//
+//	RunCmdAndScan(exec.Command("terraform", "apply", "-json"), func(line []byte) { ... })
+func RunCmdAndScan(
+	cmd *exec.Cmd,
+	onLine func(line []byte),
+) error {
+	_lineWriter := &lineWriter{
+		onLine: onLine,
+	}
+
+	err := runCmdAndCopy(cmd, _lineWriter)
+
+	_lineWriter.flush()
+
+	return err
+}
+
+// Splits what is written into lines. A last line without a new line is only handed over on flush.
+type lineWriter struct {
+	onLine  func(line []byte)
+	pending []byte
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.pending = append(w.pending, p...)
+
+	for {
+		index := bytes.IndexByte(w.pending, '\n')
+		if index < 0 {
+			return len(p), nil
+		}
+
+		w.onLine(bytes.TrimSuffix(w.pending[:index], []byte("\r")))
+		w.pending = w.pending[index+1:]
+	}
+}
+
+func (w *lineWriter) flush() {
+	if len(w.pending) > 0 {
+		w.onLine(w.pending)
+		w.pending = nil
+	}
+}
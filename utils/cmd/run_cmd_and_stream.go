@@ -28,6 +28,14 @@ import (
 //	`==> test.amazon-ebs.ubuntu: Creating temporary keypair: packer_64b824bb-026f-af2c-184e-7097c138d520`
 func RunCmdAndStream(
 	cmd *exec.Cmd,
+) error {
+	return runCmdAndCopy(cmd, os.Stdout)
+}
+
+// Runs the command copying its stdout to the writer and its stderr to our stderr, terminating it on Ctrl+C.
+func runCmdAndCopy(
+	cmd *exec.Cmd,
+	stdout io.Writer,
 ) error {
 	oopsBuilder := oops.
 		Code("RunCmdAndStream").
@@ -39,6 +47,7 @@ func RunCmdAndStream(
 	defer logger.Sync()
 
 	cmdWg := &sync.WaitGroup{}
+	copyWg := &sync.WaitGroup{}
 	cmdStreamErrChan := make(chan error, 1)
 	cmdErrChan := make(chan error, 1)
 	cmdDoneChan := make(chan bool, 1)
@@ -76,9 +85,11 @@ func RunCmdAndStream(
 
 	// Stream command StdoutPipe to our Stdout
 	cmdWg.Add(1)
+	copyWg.Add(1)
 	go func() {
 		defer cmdWg.Done()
-		_, err := io.Copy(os.Stdout, cmdStdout)
+		defer copyWg.Done()
+		_, err := io.Copy(stdout, cmdStdout)
 		if err != nil {
 			err := oopsBuilder.
 				Wrapf(err, "Error occurred while copying StdoutPipe to Stdout for command '%s'", cmd.Path)
@@ -91,8 +102,10 @@ func RunCmdAndStream(
 
 	// Stream command StderrPipe to our Stderr
 	cmdWg.Add(1)
+	copyWg.Add(1)
 	go func() {
 		defer cmdWg.Done()
+		defer copyWg.Done()
 		if _, err := io.Copy(os.Stderr, cmdStderr); err != nil {
 			err = oopsBuilder.
 				Wrapf(err, "Error occurred while copying StderrPipe to Stderr for command '%s'", cmd.Path)
@@ -103,10 +116,12 @@ func RunCmdAndStream(
 		}
 	}()
 
-	// Start a go routine to wait for the command to finish
+	// Start a go routine to wait for the command to finish. Wait closes the pipes, so it must only be called once
+	// everything was copied.
 	cmdWg.Add(1)
 	go func() {
 		defer cmdWg.Done()
+		copyWg.Wait()
 		if err := cmd.Wait(); err != nil {
 			err = oopsBuilder.
 				Wrapf(err, "Error occurred while waiting for command '%s' to finish", cmd.Path)
@@ -160,9 +175,15 @@ func RunCmdAndStream(
 					mainErrChan <- err
 				}
 
-			// If the command finished (regardless of being succesful or not), return
+			// If the command finished (regardless of being succesful or not), return. The error of the command may
+			// still be waiting in its channel, since select picks one of the ready cases at random.
 			case done := <-cmdDoneChan:
 				if done {
+					if err := <-cmdErrChan; err != nil {
+						mainErrChan <- oopsBuilder.
+							Wrapf(err, "Error encountered for %s", cmd.Path)
+					}
+
 					return
 				}
 
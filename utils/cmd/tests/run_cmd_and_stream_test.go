@@ -53,3 +53,20 @@ var _ = Describe("TerminateCommand", func() {
 		Expect(err).To(HaveOccurred())
 	})
 })
+
+var _ = Describe("RunCmdAndScan", func() {
+	It("should hand over every line of stdout", Label("integration"), func() {
+		lines := []string{}
+
+		err := cmd.RunCmdAndScan(exec.Command("sh", "-c", "printf 'first\\nsecond\\r\\n'; echo oops >&2; printf last"), func(line []byte) {
+			lines = append(lines, string(line))
+		})
+		Expect(err).To(BeNil())
+		Expect(lines).To(Equal([]string{"first", "second", "last"}))
+	})
+
+	It("should return an error when the command fails", Label("integration"), func() {
+		err := cmd.RunCmdAndScan(exec.Command("sh", "-c", "exit 1"), func(line []byte) {})
+		Expect(err).To(HaveOccurred())
+	})
+})
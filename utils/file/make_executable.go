@@ -0,0 +1,45 @@
+package file
+
+import (
+	"os"
+
+	"github.com/samber/oops"
+)
+
+// Adds the execute permission to the file at the given path for everyone allowed to read it. The permissions of
+// a zip entry depend on the host that created the zip, so they can't be relied on. Windows ignores the execute bits,
+// the extension is what matters there.
+//
+// Example:
+//
+//	("/home/dev/kumo/dependencies/packer/packer") -> nil
+func MakeExecutable(
+	path string,
+) error {
+	oopsBuilder := oops.
+		Code("MakeExecutable").
+		In("utils").
+		In("file").
+		With("path", path)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to stat: %s", path)
+	}
+
+	mode := info.Mode().Perm()
+	executable := mode | (mode&0444)>>2
+
+	if executable == mode {
+		return nil
+	}
+
+	err = os.Chmod(path, executable)
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to make executable: %s", path)
+	}
+
+	return nil
+}
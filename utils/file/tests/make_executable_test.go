@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/utils/file"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MakeExecutable", func() {
+	Context("when a file exists", func() {
+		var (
+			path string
+		)
+
+		BeforeEach(func() {
+			if runtime.GOOS == "windows" {
+				Skip("windows has no execute bits")
+			}
+
+			path = filepath.Join(GinkgoT().TempDir(), "packer")
+			Expect(os.WriteFile(path, []byte("#!/bin/sh\n"), 0640)).To(Succeed())
+		})
+
+		It("should let whoever can read it execute it", Label("unit"), func() {
+			Expect(file.MakeExecutable(path)).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0750)))
+		})
+
+		It("should leave an executable file as is", Label("unit"), func() {
+			Expect(os.Chmod(path, 0755)).To(Succeed())
+			Expect(file.MakeExecutable(path)).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0755)))
+		})
+	})
+
+	Context("when a file does not exist", func() {
+		It("should return an error", Label("unit"), func() {
+			Expect(file.MakeExecutable("/path/to/nonexistent/file")).ToNot(Succeed())
+		})
+	})
+})
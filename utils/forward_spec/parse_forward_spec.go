@@ -0,0 +1,96 @@
+package forward_spec
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+const (
+	defaultBindAddress = "localhost"
+)
+
+// Returns the forward spec parsed from the ssh -L/-R format, [bind_address:]port:host:hostport. A single
+// port is short for localhost:port:localhost:port.
+//
+// Example:
+//
+//	("8080:localhost:3000") -> (&ForwardSpec{BindAddress: "localhost", Port: 8080, Host: "localhost", HostPort: 3000}, nil)
+func ParseForwardSpec(
+	spec string,
+) (*ForwardSpec, error) {
+	oopsBuilder := oops.
+		Code("ParseForwardSpec").
+		In("utils").
+		In("forward_spec").
+		With("spec", spec)
+
+	parts := strings.Split(spec, ":")
+
+	switch len(parts) {
+	case 1:
+		parts = []string{defaultBindAddress, parts[0], defaultBindAddress, parts[0]}
+	case 3:
+		parts = append([]string{defaultBindAddress}, parts...)
+	case 4:
+	default:
+		return nil, oopsBuilder.
+			Errorf("invalid forward %q, expected [bind_address:]port:host:hostport", spec)
+	}
+
+	port, err := parsePort(parts[1])
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "invalid port in forward %q", spec)
+	}
+
+	hostPort, err := parsePort(parts[3])
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "invalid host port in forward %q", spec)
+	}
+
+	if parts[0] == "" || parts[2] == "" {
+		return nil, oopsBuilder.
+			Errorf("invalid forward %q, the addresses can't be empty", spec)
+	}
+
+	return &ForwardSpec{
+		BindAddress: parts[0],
+		Port:        port,
+		Host:        parts[2],
+		HostPort:    hostPort,
+	}, nil
+}
+
+type ForwardSpec struct {
+	BindAddress string
+	Port        int
+	Host        string
+	HostPort    int
+}
+
+// Returns the address to listen on.
+func (f *ForwardSpec) ListenAddress() string {
+	return net.JoinHostPort(f.BindAddress, strconv.Itoa(f.Port))
+}
+
+// Returns the address connections are forwarded to.
+func (f *ForwardSpec) TargetAddress() string {
+	return net.JoinHostPort(f.Host, strconv.Itoa(f.HostPort))
+}
+
+func parsePort(value string) (int, error) {
+	port, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if port < 1 || port > 65535 {
+		return 0, oops.Errorf("port %d out of range 1-65535", port)
+	}
+
+	return port, nil
+}
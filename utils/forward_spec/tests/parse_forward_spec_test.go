@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/utils/forward_spec"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseForwardSpec", Label("unit"), func() {
+	It("should parse a port:host:hostport spec", func() {
+		spec, err := forward_spec.ParseForwardSpec("8080:localhost:3000")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec).To(Equal(&forward_spec.ForwardSpec{
+			BindAddress: "localhost",
+			Port:        8080,
+			Host:        "localhost",
+			HostPort:    3000,
+		}))
+		Expect(spec.ListenAddress()).To(Equal("localhost:8080"))
+		Expect(spec.TargetAddress()).To(Equal("localhost:3000"))
+	})
+
+	It("should parse a spec with a bind address", func() {
+		spec, err := forward_spec.ParseForwardSpec("0.0.0.0:5432:db.internal:5432")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.ListenAddress()).To(Equal("0.0.0.0:5432"))
+		Expect(spec.TargetAddress()).To(Equal("db.internal:5432"))
+	})
+
+	It("should expand a single port", func() {
+		spec, err := forward_spec.ParseForwardSpec("3000")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spec.ListenAddress()).To(Equal("localhost:3000"))
+		Expect(spec.TargetAddress()).To(Equal("localhost:3000"))
+	})
+
+	DescribeTable("should return an error for invalid specs",
+		func(spec string) {
+			_, err := forward_spec.ParseForwardSpec(spec)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("empty", ""),
+		Entry("two parts", "8080:3000"),
+		Entry("too many parts", "a:1:b:2:c"),
+		Entry("non numeric port", "http:localhost:3000"),
+		Entry("port out of range", "8080:localhost:70000"),
+		Entry("zero port", "0"),
+		Entry("empty host", "8080::3000"),
+	)
+})
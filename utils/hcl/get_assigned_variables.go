@@ -0,0 +1,51 @@
+package hcl
+
+import (
+	"sort"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/samber/oops"
+)
+
+// Returns the names of the variables assigned in the given vars file (i.e .auto.tfvars). Every assigned
+// value must be a constant, as Packer and Terraform require for vars files.
+//
+// Example:
+//
+//	("terraform/aws/.auto.tfvars") -> ([]string{"AMI_ID", "AWS_REGION", ...}, nil)
+func GetAssignedVariables(
+	pathToVars string,
+) ([]string, error) {
+	oopsBuilder := oops.
+		Code("GetAssignedVariables").
+		In("utils").
+		In("hcl").
+		With("pathToVars", pathToVars)
+
+	file, diagnostics := hclparse.NewParser().ParseHCLFile(pathToVars)
+	if diagnostics.HasErrors() {
+		return nil, oopsBuilder.
+			Wrapf(diagnostics, "failed to parse vars file: %s", pathToVars)
+	}
+
+	attributes, diagnostics := file.Body.JustAttributes()
+	if diagnostics.HasErrors() {
+		return nil, oopsBuilder.
+			Wrapf(diagnostics, "vars file contains more than variable assignments: %s", pathToVars)
+	}
+
+	names := make([]string, 0, len(attributes))
+	for name, attribute := range attributes {
+		_, diagnostics := attribute.Expr.Value(nil)
+		if diagnostics.HasErrors() {
+			return nil, oopsBuilder.
+				Wrapf(diagnostics, "variable %s is not assigned a constant value", name)
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,87 @@
+package hcl
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/samber/oops"
+)
+
+type Variable struct {
+	Name     string
+	Required bool
+}
+
+// Returns the sorted variables declared through `variable` blocks in the files of the given dir ending with
+// the given extension. A variable is required when it has no default or its default is null.
+//
+// Example:
+//
+//	("terraform/aws", ".tf") -> ([]*Variable{{Name: "AMI_ID", Required: true}, ...}, nil)
+func GetDeclaredVariables(
+	pathToDir,
+	extension string,
+) ([]*Variable, error) {
+	oopsBuilder := oops.
+		Code("GetDeclaredVariables").
+		In("utils").
+		In("hcl").
+		With("pathToDir", pathToDir).
+		With("extension", extension)
+
+	entries, err := os.ReadDir(pathToDir)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read dir: %s", pathToDir)
+	}
+
+	parser := hclparse.NewParser()
+	variables := []*Variable{}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), extension) {
+			continue
+		}
+
+		path := filepath.Join(pathToDir, entry.Name())
+
+		file, diagnostics := parser.ParseHCLFile(path)
+		if diagnostics.HasErrors() {
+			return nil, oopsBuilder.
+				Wrapf(diagnostics, "failed to parse file: %s", path)
+		}
+
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			return nil, oopsBuilder.
+				Errorf("unexpected body type for file: %s", path)
+		}
+
+		for _, block := range body.Blocks {
+			if block.Type != "variable" || len(block.Labels) != 1 {
+				continue
+			}
+
+			required := true
+			if attribute, ok := block.Body.Attributes["default"]; ok {
+				value, diagnostics := attribute.Expr.Value(nil)
+				required = !diagnostics.HasErrors() && value.IsNull()
+			}
+
+			variables = append(variables, &Variable{
+				Name:     block.Labels[0],
+				Required: required,
+			})
+		}
+	}
+
+	sort.Slice(variables, func(i, j int) bool {
+		return variables[i].Name < variables[j].Name
+	})
+
+	return variables, nil
+}
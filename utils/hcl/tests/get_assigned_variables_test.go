@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/hcl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetAssignedVariables", func() {
+	var (
+		pathToVars string
+	)
+
+	BeforeEach(func() {
+		pathToVars = filepath.Join(GinkgoT().TempDir(), ".auto.tfvars")
+	})
+
+	Context("with a valid vars file", Label("unit"), func() {
+		It("should return the sorted assigned variables", func() {
+			content := `B = "b"
+A = 1
+C = ["c"]
+`
+			Expect(os.WriteFile(pathToVars, []byte(content), 0644)).To(Succeed())
+
+			assigned, err := hcl.GetAssignedVariables(pathToVars)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(assigned).To(Equal([]string{"A", "B", "C"}))
+		})
+	})
+
+	Context("with a non constant value", Label("unit"), func() {
+		It("should return an error", func() {
+			Expect(os.WriteFile(pathToVars, []byte(`A = var.B`), 0644)).To(Succeed())
+
+			_, err := hcl.GetAssignedVariables(pathToVars)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("with a missing vars file", Label("unit"), func() {
+		It("should return an error", func() {
+			_, err := hcl.GetAssignedVariables(filepath.Join(GinkgoT().TempDir(), "missing.tfvars"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
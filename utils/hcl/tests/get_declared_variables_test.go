@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/hcl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetDeclaredVariables", func() {
+	Context("with a valid dir", Label("unit"), func() {
+		var (
+			dir string
+		)
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+
+			content := `variable "REQUIRED" {
+  type = string
+}
+
+variable "NULL_DEFAULT" {
+  type    = string
+  default = null
+}
+
+variable "OPTIONAL" {
+  type    = number
+  default = 8
+}
+`
+			Expect(os.WriteFile(filepath.Join(dir, "variables.tf"), []byte(content), 0644)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dir, "ignored.pkr.hcl"), []byte(`variable "IGNORED" {}`), 0644)).To(Succeed())
+		})
+
+		It("should return the sorted variables declared in files with the extension", func() {
+			variables, err := hcl.GetDeclaredVariables(dir, ".tf")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(variables).To(Equal([]*hcl.Variable{
+				{Name: "NULL_DEFAULT", Required: true},
+				{Name: "OPTIONAL", Required: false},
+				{Name: "REQUIRED", Required: true},
+			}))
+		})
+	})
+
+	Context("with the configurations shipped with kumo", Label("unit"), func() {
+		It("should parse the packer variables", func() {
+			variables, err := hcl.GetDeclaredVariables(filepath.Join("..", "..", "..", "packer", "aws"), ".pkr.hcl")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(variables).ToNot(BeEmpty())
+		})
+
+		It("should parse the terraform variables", func() {
+			variables, err := hcl.GetDeclaredVariables(filepath.Join("..", "..", "..", "terraform", "aws"), ".tf")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(variables).ToNot(BeEmpty())
+		})
+	})
+
+	Context("with an invalid file", Label("unit"), func() {
+		It("should return an error", func() {
+			dir := GinkgoT().TempDir()
+			Expect(os.WriteFile(filepath.Join(dir, "variables.tf"), []byte(`variable "BROKEN" {`), 0644)).To(Succeed())
+
+			_, err := hcl.GetDeclaredVariables(dir, ".tf")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
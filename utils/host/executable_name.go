@@ -0,0 +1,17 @@
+package host
+
+import "fmt"
+
+// Returns the file name of the executable of the given name on the given OS, the one found in the Hashicorp zips.
+//
+// Example:
+//
+//	("packer", "windows") -> "packer.exe"
+//	("packer", "linux") -> "packer"
+func ExecutableName(name, os string) string {
+	if os == "windows" {
+		return fmt.Sprintf("%s.exe", name)
+	}
+
+	return name
+}
@@ -29,6 +29,19 @@ func HostIsCompatible(os, arch string) bool {
 			return false
 		}
 
+	case "linux":
+
+		switch arch {
+		case "amd64":
+			return true
+
+		case "arm64":
+			return true
+
+		default:
+			return false
+		}
+
 	default:
 		return false
 	}
@@ -0,0 +1,18 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/utils/host"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExecutableName", func() {
+	It("should add the .exe extension on windows", Label("unit"), func() {
+		Expect(host.ExecutableName("packer", "windows")).To(Equal("packer.exe"))
+	})
+
+	It("should keep the bare name on darwin and linux", Label("unit"), func() {
+		Expect(host.ExecutableName("terraform", "darwin")).To(Equal("terraform"))
+		Expect(host.ExecutableName("terraform", "linux")).To(Equal("terraform"))
+	})
+})
@@ -33,9 +33,23 @@ var _ = Describe("HostIsCompatible", func() {
 		})
 	})
 
+	Context("on linux", func() {
+		var linux = "linux"
+
+		It("should return true for compatible architectures", Label("unit"), func() {
+			Expect(host.HostIsCompatible(linux, "amd64")).To(BeTrue())
+			Expect(host.HostIsCompatible(linux, "arm64")).To(BeTrue())
+		})
+
+		It("should return false for incompatible architectures", Label("unit"), func() {
+			Expect(host.HostIsCompatible(linux, "386")).To(BeFalse())
+			Expect(host.HostIsCompatible(linux, "arm")).To(BeFalse())
+		})
+	})
+
 	Context("on incompatible platforms", func() {
 		It("should return false for incompatible platforms", Label("unit"), func() {
-			Expect(host.HostIsCompatible("linux", "amd64")).To(BeFalse())
+			Expect(host.HostIsCompatible("freebsd", "amd64")).To(BeFalse())
 		})
 	})
 })
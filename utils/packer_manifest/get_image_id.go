@@ -0,0 +1,36 @@
+package packer_manifest
+
+import (
+	"github.com/samber/oops"
+)
+
+// Returns the image ID to deploy: the one from the config, else the one of the last build in the Packer manifest.
+// The manifest isn't read when the config picks the image, so it needn't exist, i.e before the first build.
+//
+// Example:
+//
+//	("packer/aws/manifest.json", aws.NewAws().ImageId, "") -> ("ami-0c3fd0f5d33134a76", nil)
+//	("packer/aws/manifest.json", aws.NewAws().ImageId, "ami-1234567890abcdef0") -> ("ami-1234567890abcdef0", nil)
+func GetImageId(
+	packerManifestAbsPath string,
+	imageId func(artifactId string) (string, error),
+	imageIdFromConfig string,
+) (string, error) {
+	if imageIdFromConfig != "" {
+		return imageIdFromConfig, nil
+	}
+
+	oopsBuilder := oops.
+		Code("GetImageId").
+		In("utils").
+		In("packer_manifest").
+		With("packerManifestAbsPath", packerManifestAbsPath)
+
+	lastBuiltImageId, err := GetLastBuiltImageIdFromPackerManifest(packerManifestAbsPath, imageId)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to get last built image id from packer manifest")
+	}
+
+	return lastBuiltImageId, nil
+}
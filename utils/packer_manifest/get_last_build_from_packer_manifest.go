@@ -4,13 +4,15 @@ import (
 	"encoding/json"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/samber/lo"
 	"github.com/samber/oops"
 )
 
 type PackerBuild struct {
+	Name          string `json:"name"`
+	BuilderType   string `json:"builder_type"`
+	BuildTime     int64  `json:"build_time"`
 	PackerRunUUID string `json:"packer_run_uuid"`
 	ArtifactId    string `json:"artifact_id"`
 }
@@ -20,17 +22,19 @@ type PackerManifest struct {
 	LastRunUUID string `json:"last_run_uuid"`
 }
 
-// Returns the AMI ID of the last built AMI. The AMI ID is extracted from the Packer manifest file.
+// Returns the build of the last Packer run recorded in the Packer manifest file.
 //
 // Example:
 //
-//	("packer/aws/manifest.json") -> ("ami-0c3fd0f5d33134a76", nil)
-func GetLastBuiltAmiIdFromPackerManifest(
+//	("packer/aws/manifest.json") -> (&PackerBuild{PackerRunUUID: "7e2ba4a5-...", ArtifactId: "us-west-2:ami-0c3fd0f5d33134a76", ...}, nil)
+func GetLastBuildFromPackerManifest(
 	packerManifestAbsPath string,
-) (string, error) {
+) (*PackerBuild, error) {
 	packerManifest := &PackerManifest{}
 	oopsBuilder := oops.
-		Code("GetLastBuiltAmiIdFromPackerManifest").
+		Code("GetLastBuildFromPackerManifest").
+		In("utils").
+		In("packer_manifest").
 		With("packerManifestAbsPath", packerManifestAbsPath)
 
 	// Check if packer manifest path is absolute
@@ -38,7 +42,7 @@ func GetLastBuiltAmiIdFromPackerManifest(
 		err := oopsBuilder.
 			Errorf("The packer manifest path is not absolute: '%s'", packerManifestAbsPath)
 
-		return "", err
+		return nil, err
 	}
 
 	// Open packer manifest file
@@ -47,7 +51,7 @@ func GetLastBuiltAmiIdFromPackerManifest(
 		err := oopsBuilder.
 			Wrapf(err, "Error occurred while opening packer manifest file '%s'", packerManifestAbsPath)
 
-		return "", err
+		return nil, err
 	}
 	defer packerManifestFile.Close()
 
@@ -58,21 +62,20 @@ func GetLastBuiltAmiIdFromPackerManifest(
 		err := oopsBuilder.
 			Wrapf(err, "Error occurred while decoding packer manifest file '%s'", packerManifestAbsPath)
 
-		return "", err
+		return nil, err
 	}
 
-	// Get last built artifact id for last Packer build
-	lastBuildArtifact := lo.Filter(packerManifest.Builds, func(pb *PackerBuild, index int) bool {
+	// Get last build for last Packer run
+	lastBuild, found := lo.Find(packerManifest.Builds, func(pb *PackerBuild) bool {
 		return pb.PackerRunUUID == packerManifest.LastRunUUID
 	})
-
-	if len(lastBuildArtifact) == 0 {
+	if !found {
 		err := oopsBuilder.
-			With("lastBuildArtifact", lastBuildArtifact).
-			Wrapf(err, "No AMI ID found for last Packer build")
-		return "", err
+			With("lastRunUUID", packerManifest.LastRunUUID).
+			Errorf("No build found for last Packer run")
+
+		return nil, err
 	}
 
-	// Extract only the AMI ID
-	return strings.Split(lastBuildArtifact[0].ArtifactId, ":")[1], nil
+	return lastBuild, nil
 }
@@ -0,0 +1,43 @@
+package packer_manifest
+
+import (
+	"github.com/samber/oops"
+)
+
+// Returns the image ID of the last built image. The artifact id of the last build is read from the Packer
+// manifest file and turned into an image ID by imageId, since every builder writes it differently, e.g.
+// region:ami-id for amazon-ebs or a resource ID for azure-arm.
+//
+// Example:
+//
+//	("packer/aws/manifest.json", aws.NewAws().ImageId) -> ("ami-0c3fd0f5d33134a76", nil)
+func GetLastBuiltImageIdFromPackerManifest(
+	packerManifestAbsPath string,
+	imageId func(artifactId string) (string, error),
+) (string, error) {
+	oopsBuilder := oops.
+		Code("GetLastBuiltImageIdFromPackerManifest").
+		In("utils").
+		In("packer_manifest").
+		With("packerManifestAbsPath", packerManifestAbsPath)
+
+	lastBuild, err := GetLastBuildFromPackerManifest(packerManifestAbsPath)
+	if err != nil {
+		err := oopsBuilder.
+			Wrapf(err, "No image ID found for last Packer build")
+
+		return "", err
+	}
+
+	lastBuiltImageId, err := imageId(lastBuild.ArtifactId)
+	if err != nil {
+		err := oopsBuilder.
+			With("artifactId", lastBuild.ArtifactId).
+			With("builderType", lastBuild.BuilderType).
+			Wrapf(err, "Unexpected artifact id for last Packer build")
+
+		return "", err
+	}
+
+	return lastBuiltImageId, nil
+}
@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ed3899/kumo/utils/packer_manifest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetImageId", func() {
+	var (
+		manifestPath string
+
+		// Reads amazon-ebs artifact ids, region:ami-id.
+		amiIdOf = func(artifactId string) (string, error) {
+			_, amiId, _ := strings.Cut(artifactId, ":")
+
+			return amiId, nil
+		}
+	)
+
+	BeforeEach(func() {
+		manifestPath = filepath.Join(GinkgoT().TempDir(), "manifest.json")
+	})
+
+	It("should return the image of the last build", Label("unit"), func() {
+		manifest := &packer_manifest.PackerManifest{
+			Builds:      []*packer_manifest.PackerBuild{{PackerRunUUID: "run_uuid_1", ArtifactId: "us-west-2:ami-12345678"}},
+			LastRunUUID: "run_uuid_1",
+		}
+		content, err := json.Marshal(manifest)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(os.WriteFile(manifestPath, content, 0644)).To(Succeed())
+
+		imageId, err := packer_manifest.GetImageId(manifestPath, amiIdOf, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(imageId).To(Equal("ami-12345678"))
+	})
+
+	It("should return the image of the config without reading the manifest", Label("unit"), func() {
+		imageId, err := packer_manifest.GetImageId(manifestPath, amiIdOf, "ami-87654321")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(imageId).To(Equal("ami-87654321"))
+	})
+
+	It("should return an error when nothing was built and the config picks no image", Label("unit"), func() {
+		_, err := packer_manifest.GetImageId(manifestPath, amiIdOf, "")
+		Expect(err).To(HaveOccurred())
+	})
+})
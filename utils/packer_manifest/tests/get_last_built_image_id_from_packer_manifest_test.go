@@ -2,21 +2,33 @@ package tests
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/ed3899/kumo/utils/packer_manifest"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
-var _ = Describe("GetLastAmiIdFromPackerManifest", func() {
+var _ = Describe("GetLastBuiltImageIdFromPackerManifest", func() {
 	var (
 		amiId = "ami:ami-12345678"
 
 		tempManifestFilePath string
 		fakeManifestFilePath string
+
+		// Reads amazon-ebs artifact ids, region:ami-id.
+		amiIdOf = func(artifactId string) (string, error) {
+			_, amiId, found := strings.Cut(artifactId, ":")
+			if !found {
+				return "", errors.New("not of the form region:ami-id")
+			}
+
+			return amiId, nil
+		}
 	)
 
 	BeforeEach(func() {
@@ -51,7 +63,7 @@ var _ = Describe("GetLastAmiIdFromPackerManifest", func() {
 	Context("when the manifest file is absolute", func() {
 		Context("when the manifest file exists", func() {
 			It("should return the last built AMI ID from the manifest file", Label("unit"), func() {
-				amiId, err := packer_manifest.GetLastBuiltAmiIdFromPackerManifest(tempManifestFilePath)
+				amiId, err := packer_manifest.GetLastBuiltImageIdFromPackerManifest(tempManifestFilePath, amiIdOf)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(amiId).To(Equal(amiId))
 			})
@@ -59,7 +71,7 @@ var _ = Describe("GetLastAmiIdFromPackerManifest", func() {
 
 		Context("when the manifest file doesn't exists", func() {
 			It("should return an error for non-existent manifest file", Label("unit"), func() {
-				amiId, err := packer_manifest.GetLastBuiltAmiIdFromPackerManifest(fakeManifestFilePath)
+				amiId, err := packer_manifest.GetLastBuiltImageIdFromPackerManifest(fakeManifestFilePath, amiIdOf)
 				Expect(err).To(HaveOccurred())
 				Expect(amiId).To(BeEmpty())
 				Expect(err).To(MatchError(ContainSubstring("Error occurred while opening packer manifest file")))
@@ -70,7 +82,7 @@ var _ = Describe("GetLastAmiIdFromPackerManifest", func() {
 	Context("when the manifest file is not absolute", func() {
 		Context("when the manifest file exists", func() {
 			It("should return an error for invalid manifest path", Label("unit"), func() {
-				amiId, err := packer_manifest.GetLastBuiltAmiIdFromPackerManifest("./packer_manifest.json")
+				amiId, err := packer_manifest.GetLastBuiltImageIdFromPackerManifest("./packer_manifest.json", amiIdOf)
 				Expect(err).To(HaveOccurred())
 				Expect(amiId).To(BeEmpty())
 				Expect(err).To(MatchError(ContainSubstring("path is not absolute")))
@@ -79,11 +91,30 @@ var _ = Describe("GetLastAmiIdFromPackerManifest", func() {
 
 		Context("when the manifest file doesn't exists", func() {
 			It("should return an error for invalid manifest path", Label("unit"), func() {
-				amiId, err := packer_manifest.GetLastBuiltAmiIdFromPackerManifest("invalid_manifest_path.json")
+				amiId, err := packer_manifest.GetLastBuiltImageIdFromPackerManifest("invalid_manifest_path.json", amiIdOf)
 				Expect(err).To(HaveOccurred())
 				Expect(amiId).To(BeEmpty())
 				Expect(err.Error()).To(ContainSubstring("path is not absolute"))
 			})
 		})
 	})
+
+	Context("when the artifact id is read by another builder", func() {
+		It("should return the image ID read from the artifact id", Label("unit"), func() {
+			imageId, err := packer_manifest.GetLastBuiltImageIdFromPackerManifest(tempManifestFilePath, func(artifactId string) (string, error) {
+				return "image-of-" + artifactId, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(imageId).To(Equal("image-of-ami:ami:ami-12345678"))
+		})
+
+		It("should return an error when the artifact id can't be read", Label("unit"), func() {
+			imageId, err := packer_manifest.GetLastBuiltImageIdFromPackerManifest(tempManifestFilePath, func(artifactId string) (string, error) {
+				return "", errors.New("not a managed image")
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(imageId).To(BeEmpty())
+			Expect(err).To(MatchError(ContainSubstring("Unexpected artifact id for last Packer build")))
+		})
+	})
 })
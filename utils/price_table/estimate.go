@@ -0,0 +1,83 @@
+package price_table
+
+import (
+	"github.com/samber/oops"
+)
+
+// Returns the on-demand cost of an instance type with a volume of volumeSize GB in the region. An empty
+// volumeType leaves the volume out, e.g. for the temporary instance of a build.
+//
+// Example:
+//
+//	("us-east-1", "t2.micro", "gp2", 8) -> (&Estimate{InstanceHourly: 0.0116, VolumeMonthly: 0.8, ...}, nil)
+func (t *PriceTable) Estimate(
+	region,
+	instanceType,
+	volumeType string,
+	volumeSize int,
+) (*Estimate, error) {
+	oopsBuilder := oops.
+		Code("Estimate").
+		In("utils").
+		In("price_table").
+		With("region", region).
+		With("instanceType", instanceType).
+		With("volumeType", volumeType)
+
+	regionPrices, ok := t.Regions[region]
+	if !ok {
+		return nil, oopsBuilder.
+			Errorf("No prices known for region '%s'", region)
+	}
+
+	instanceHourly, ok := regionPrices.Instances[instanceType]
+	if !ok {
+		return nil, oopsBuilder.
+			Errorf("No price known for instance type '%s' in region '%s'", instanceType, region)
+	}
+
+	estimate := &Estimate{
+		Currency:       t.Currency,
+		Updated:        t.Updated,
+		Region:         region,
+		InstanceType:   instanceType,
+		InstanceHourly: instanceHourly,
+	}
+
+	if volumeType == "" {
+		return estimate, nil
+	}
+
+	volumeMonthlyPerGb, ok := regionPrices.Volumes[volumeType]
+	if !ok {
+		return nil, oopsBuilder.
+			Errorf("No price known for volume type '%s' in region '%s'", volumeType, region)
+	}
+
+	estimate.VolumeType = volumeType
+	estimate.VolumeSize = volumeSize
+	estimate.VolumeMonthly = volumeMonthlyPerGb * float64(volumeSize)
+
+	return estimate, nil
+}
+
+type Estimate struct {
+	Currency       string
+	Updated        string
+	Region         string
+	InstanceType   string
+	InstanceHourly float64
+	VolumeType     string
+	VolumeSize     int
+	VolumeMonthly  float64
+}
+
+// Returns the cost of running for an hour, with the volume spread over the month.
+func (e *Estimate) Hourly() float64 {
+	return e.InstanceHourly + e.VolumeMonthly/HOURS_PER_MONTH
+}
+
+// Returns the cost of running for a whole month.
+func (e *Estimate) Monthly() float64 {
+	return e.InstanceHourly*HOURS_PER_MONTH + e.VolumeMonthly
+}
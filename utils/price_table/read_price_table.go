@@ -0,0 +1,85 @@
+package price_table
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/samber/oops"
+)
+
+const (
+	HOURS_PER_MONTH = 730
+)
+
+// On-demand prices of a cloud, per region. Instances are priced per hour and volumes per GB-month.
+type PriceTable struct {
+	Currency string                   `json:"currency"`
+	Updated  string                   `json:"updated"`
+	Regions  map[string]*RegionPrices `json:"regions"`
+}
+
+type RegionPrices struct {
+	Instances map[string]float64 `json:"instances"`
+	Volumes   map[string]float64 `json:"volumes"`
+}
+
+// Returns the price table stored in the file. Tables without a currency, without regions or with prices that
+// aren't positive are rejected, so a bad refresh can't replace a good table.
+//
+// Example:
+//
+//	("pricing/aws.json") -> (&PriceTable{Currency: "USD", Updated: "2023-10-01", Regions: ...}, nil)
+func ReadPriceTable(
+	path string,
+) (*PriceTable, error) {
+	oopsBuilder := oops.
+		Code("ReadPriceTable").
+		In("utils").
+		In("price_table").
+		With("path", path)
+
+	priceTableFile, err := os.Open(path)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while opening price table file '%s'", path)
+	}
+	defer priceTableFile.Close()
+
+	priceTable := &PriceTable{}
+
+	jsonDecoder := json.NewDecoder(priceTableFile)
+	jsonDecoder.DisallowUnknownFields()
+	err = jsonDecoder.Decode(priceTable)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding price table file '%s'", path)
+	}
+
+	if priceTable.Currency == "" {
+		return nil, oopsBuilder.
+			Errorf("The price table '%s' has no currency", path)
+	}
+
+	if len(priceTable.Regions) == 0 {
+		return nil, oopsBuilder.
+			Errorf("The price table '%s' has no regions", path)
+	}
+
+	for region, regionPrices := range priceTable.Regions {
+		if regionPrices == nil {
+			return nil, oopsBuilder.
+				Errorf("The price table '%s' has no prices for region '%s'", path, region)
+		}
+
+		for name, prices := range map[string]map[string]float64{"instance": regionPrices.Instances, "volume": regionPrices.Volumes} {
+			for _type, price := range prices {
+				if price <= 0 {
+					return nil, oopsBuilder.
+						Errorf("The price table '%s' has a %s price that isn't positive for '%s' in '%s'", path, name, _type, region)
+				}
+			}
+		}
+	}
+
+	return priceTable, nil
+}
@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/utils/price_table"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Estimate", func() {
+	priceTable := &price_table.PriceTable{
+		Currency: "USD",
+		Updated:  "2023-10-01",
+		Regions: map[string]*price_table.RegionPrices{
+			"us-east-1": {
+				Instances: map[string]float64{"t2.micro": 0.0116},
+				Volumes:   map[string]float64{"gp2": 0.1},
+			},
+		},
+	}
+
+	Context("with known prices", Label("unit"), func() {
+		It("should add the instance and the volume", func() {
+			estimate, err := priceTable.Estimate("us-east-1", "t2.micro", "gp2", 20)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(estimate.Currency).To(Equal("USD"))
+			Expect(estimate.InstanceHourly).To(Equal(0.0116))
+			Expect(estimate.VolumeMonthly).To(BeNumerically("~", 2.0))
+			Expect(estimate.Monthly()).To(BeNumerically("~", 0.0116*730+2.0))
+			Expect(estimate.Hourly()).To(BeNumerically("~", 0.0116+2.0/730))
+		})
+
+		It("should leave the volume out without a volume type", func() {
+			estimate, err := priceTable.Estimate("us-east-1", "t2.micro", "", 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(estimate.VolumeMonthly).To(BeZero())
+			Expect(estimate.Hourly()).To(Equal(0.0116))
+		})
+	})
+
+	Context("with unknown prices", Label("unit"), func() {
+		DescribeTable("should return an error",
+			func(region, instanceType, volumeType string) {
+				_, err := priceTable.Estimate(region, instanceType, volumeType, 8)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("region", "ap-south-1", "t2.micro", "gp2"),
+			Entry("instance type", "us-east-1", "p4d.24xlarge", "gp2"),
+			Entry("volume type", "us-east-1", "t2.micro", "io2"),
+		)
+	})
+})
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ed3899/kumo/utils/price_table"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadPriceTable", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "aws.json")
+	})
+
+	Context("with a valid price table", Label("unit"), func() {
+		It("should return the prices", func() {
+			Expect(os.WriteFile(path, []byte(`{"currency": "USD", "updated": "2023-10-01", "regions": {
+				"us-east-1": {"instances": {"t2.micro": 0.0116}, "volumes": {"gp2": 0.1}}}}`), 0644)).To(Succeed())
+
+			priceTable, err := price_table.ReadPriceTable(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(priceTable.Currency).To(Equal("USD"))
+			Expect(priceTable.Regions["us-east-1"].Instances["t2.micro"]).To(Equal(0.0116))
+			Expect(priceTable.Regions["us-east-1"].Volumes["gp2"]).To(Equal(0.1))
+		})
+
+		It("should read the table shipped with kumo", func() {
+			_, file, _, _ := runtime.Caller(0)
+			shipped := filepath.Join(filepath.Dir(file), "..", "..", "..", "pricing", "aws.json")
+
+			priceTable, err := price_table.ReadPriceTable(shipped)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(priceTable.Regions).To(HaveKey("us-west-2"))
+		})
+	})
+
+	Context("with an invalid price table", Label("unit"), func() {
+		DescribeTable("should return an error",
+			func(content string) {
+				Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+
+				_, err := price_table.ReadPriceTable(path)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("not json", `currency: USD`),
+			Entry("unknown field", `{"currency": "USD", "regions": {"us-east-1": {"instances": {}}}, "prices": {}}`),
+			Entry("no currency", `{"regions": {"us-east-1": {"instances": {"t2.micro": 0.0116}}}}`),
+			Entry("no regions", `{"currency": "USD", "regions": {}}`),
+			Entry("a region without prices", `{"currency": "USD", "regions": {"us-east-1": null}}`),
+			Entry("a negative price", `{"currency": "USD", "regions": {"us-east-1": {"instances": {"t2.micro": -1}}}}`),
+			Entry("a zero price", `{"currency": "USD", "regions": {"us-east-1": {"volumes": {"gp2": 0}}}}`),
+		)
+
+		It("should return an error for a missing file", func() {
+			_, err := price_table.ReadPriceTable(path)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPriceTable(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PriceTable Suite", Label("utils", "price_table"))
+}
@@ -0,0 +1,52 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/samber/oops"
+)
+
+// Asks a free form question. An empty answer picks the default value. The question is repeated
+// until an answer is given when there is no default value.
+//
+// Example:
+//
+//	("AWS region", "us-west-2") -> ("us-west-2", nil)
+func (p *Prompt) Ask(
+	question,
+	defaultValue string,
+) (string, error) {
+	oopsBuilder := oops.
+		Code("Ask").
+		In("utils").
+		In("prompt").
+		Tags("Prompt").
+		With("question", question)
+
+	for {
+		if defaultValue != "" {
+			fmt.Fprintf(p.Writer, "%s [%s]: ", question, defaultValue)
+		} else {
+			fmt.Fprintf(p.Writer, "%s: ", question)
+		}
+
+		answer, err := p.readLine()
+		if errors.Is(err, io.EOF) && defaultValue != "" {
+			return defaultValue, nil
+		}
+		if err != nil {
+			return "", oopsBuilder.
+				Wrapf(err, "failed to read answer")
+		}
+
+		if answer != "" {
+			return answer, nil
+		}
+
+		if defaultValue != "" {
+			return defaultValue, nil
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/samber/oops"
+)
+
+// Same as Ask, but neither the default value nor the answer typed in a terminal are written out.
+//
+// Example:
+//
+//	("AWS secret access key", "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY") -> ("wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY", nil)
+func (p *Prompt) AskSecret(
+	question,
+	defaultValue string,
+) (string, error) {
+	oopsBuilder := oops.
+		Code("AskSecret").
+		In("utils").
+		In("prompt").
+		Tags("Prompt").
+		With("question", question)
+
+	for {
+		if defaultValue != "" {
+			fmt.Fprintf(p.Writer, "%s [****]: ", question)
+		} else {
+			fmt.Fprintf(p.Writer, "%s: ", question)
+		}
+
+		answer, err := p.readSecret()
+		if errors.Is(err, io.EOF) && defaultValue != "" {
+			return defaultValue, nil
+		}
+		if err != nil {
+			return "", oopsBuilder.
+				Wrapf(err, "failed to read answer")
+		}
+
+		if answer != "" {
+			return answer, nil
+		}
+
+		if defaultValue != "" {
+			return defaultValue, nil
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+// Asks a yes or no question. An empty answer, or no answer at all, picks the default value. The question is
+// repeated until y, yes, n or no is given.
+//
+// Example:
+//
+//	("Deploy?", false) -> answer "y" -> (true, nil)
+func (p *Prompt) Confirm(
+	question string,
+	defaultValue bool,
+) (bool, error) {
+	oopsBuilder := oops.
+		Code("Confirm").
+		In("utils").
+		In("prompt").
+		Tags("Prompt").
+		With("question", question)
+
+	hint := "y/N"
+	if defaultValue {
+		hint = "Y/n"
+	}
+
+	for {
+		fmt.Fprintf(p.Writer, "%s [%s]: ", question, hint)
+
+		answer, err := p.readLine()
+		if errors.Is(err, io.EOF) {
+			return defaultValue, nil
+		}
+		if err != nil {
+			return false, oopsBuilder.
+				Wrapf(err, "failed to read answer")
+		}
+
+		switch strings.ToLower(answer) {
+		case "":
+			return defaultValue, nil
+
+		case "y", "yes":
+			return true, nil
+
+		case "n", "no":
+			return false, nil
+		}
+
+		fmt.Fprintf(p.Writer, "%q is not a valid answer, answer y or n\n", answer)
+	}
+}
@@ -0,0 +1,28 @@
+package prompt
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Returns the choice matching the answer, either by its 1-based number or by its name.
+func findChoice(
+	choices []string,
+	answer string,
+) (string, bool) {
+	if number, err := strconv.Atoi(answer); err == nil {
+		if number < 1 || number > len(choices) {
+			return "", false
+		}
+
+		return choices[number-1], true
+	}
+
+	for _, choice := range choices {
+		if strings.EqualFold(choice, answer) {
+			return choice, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,77 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+// Asks to pick any number of the choices, by number or by name, separated by commas or spaces. An
+// empty answer picks the default values. The picked values are returned in the order of the choices.
+//
+// Example:
+//
+//	("Tools", []string{"docker", "minikube", "go"}, []string{"docker"}) -> answer "3, 1" -> ([]string{"docker", "go"}, nil)
+func (p *Prompt) MultiSelect(
+	question string,
+	choices []string,
+	defaultValues []string,
+) ([]string, error) {
+	oopsBuilder := oops.
+		Code("MultiSelect").
+		In("utils").
+		In("prompt").
+		Tags("Prompt").
+		With("question", question).
+		With("choices", choices)
+
+	if len(choices) == 0 {
+		return nil, oopsBuilder.
+			Errorf("no choices to select from")
+	}
+
+AskLoop:
+	for {
+		fmt.Fprintf(p.Writer, "%s\n", question)
+		for i, choice := range choices {
+			fmt.Fprintf(p.Writer, "  %d) %s\n", i+1, choice)
+		}
+		fmt.Fprintf(p.Writer, "Choices, separated by commas [%s]: ", strings.Join(defaultValues, ", "))
+
+		answer, err := p.readLine()
+		if errors.Is(err, io.EOF) && len(defaultValues) > 0 {
+			return defaultValues, nil
+		}
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "failed to read answer")
+		}
+
+		if answer == "" {
+			if len(defaultValues) > 0 {
+				return defaultValues, nil
+			}
+
+			continue
+		}
+
+		picked := []string{}
+		for _, field := range strings.FieldsFunc(answer, func(r rune) bool { return r == ',' || r == ' ' }) {
+			choice, ok := findChoice(choices, field)
+			if !ok {
+				fmt.Fprintf(p.Writer, "%q is not a valid choice\n", field)
+				continue AskLoop
+			}
+
+			picked = append(picked, choice)
+		}
+
+		return lo.Filter(choices, func(choice string, _ int) bool {
+			return lo.Contains(picked, choice)
+		}), nil
+	}
+}
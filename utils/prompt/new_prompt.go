@@ -0,0 +1,29 @@
+package prompt
+
+import (
+	"bufio"
+	"io"
+)
+
+// Returns a prompt that reads the answers from the reader and writes the questions to the writer.
+//
+// Example:
+//
+//	(os.Stdin, os.Stdout) -> *Prompt
+func NewPrompt(
+	reader io.Reader,
+	writer io.Writer,
+) *Prompt {
+	return &Prompt{
+		Input:  reader,
+		Reader: bufio.NewReader(reader),
+		Writer: writer,
+	}
+}
+
+type Prompt struct {
+	// The reader the prompt was created with, secrets are read from it directly when it's a terminal.
+	Input  io.Reader
+	Reader *bufio.Reader
+	Writer io.Writer
+}
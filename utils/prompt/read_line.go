@@ -0,0 +1,35 @@
+package prompt
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+// Reads the next answer without the trailing new line. A last answer without a new line is accepted,
+// an exhausted reader returns io.EOF.
+func (p *Prompt) readLine() (string, error) {
+	oopsBuilder := oops.
+		Code("readLine").
+		In("utils").
+		In("prompt").
+		Tags("Prompt")
+
+	line, err := p.Reader.ReadString('\n')
+	if err != nil {
+		if errors.Is(err, io.EOF) && line != "" {
+			return strings.TrimSpace(line), nil
+		}
+
+		if errors.Is(err, io.EOF) {
+			return "", io.EOF
+		}
+
+		return "", oopsBuilder.
+			Wrapf(err, "failed to read answer")
+	}
+
+	return strings.TrimSpace(line), nil
+}
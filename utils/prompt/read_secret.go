@@ -0,0 +1,33 @@
+package prompt
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/samber/oops"
+	"golang.org/x/term"
+)
+
+// Reads the next answer without echoing it when the input is a terminal, so secrets don't stay in the scrollback.
+// Other inputs, i.e pipes and tests, are read as lines.
+func (p *Prompt) readSecret() (string, error) {
+	file, ok := p.Input.(*os.File)
+	if !ok || !term.IsTerminal(int(file.Fd())) {
+		return p.readLine()
+	}
+
+	secret, err := term.ReadPassword(int(file.Fd()))
+	// The new line typed isn't echoed either
+	fmt.Fprintln(p.Writer)
+	if err != nil {
+		return "", oops.
+			Code("readSecret").
+			In("utils").
+			In("prompt").
+			Tags("Prompt").
+			Wrapf(err, "failed to read secret")
+	}
+
+	return strings.TrimSpace(string(secret)), nil
+}
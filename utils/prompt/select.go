@@ -0,0 +1,62 @@
+package prompt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/samber/oops"
+)
+
+// Asks to pick one of the choices, either by its number or by its name. An empty answer picks the
+// default value. The question is repeated until a valid choice is given.
+//
+// Example:
+//
+//	("Cloud", []string{"aws"}, "aws") -> ("aws", nil)
+func (p *Prompt) Select(
+	question string,
+	choices []string,
+	defaultValue string,
+) (string, error) {
+	oopsBuilder := oops.
+		Code("Select").
+		In("utils").
+		In("prompt").
+		Tags("Prompt").
+		With("question", question).
+		With("choices", choices)
+
+	if len(choices) == 0 {
+		return "", oopsBuilder.
+			Errorf("no choices to select from")
+	}
+
+	for {
+		fmt.Fprintf(p.Writer, "%s\n", question)
+		for i, choice := range choices {
+			fmt.Fprintf(p.Writer, "  %d) %s\n", i+1, choice)
+		}
+		fmt.Fprintf(p.Writer, "Choice [%s]: ", defaultValue)
+
+		answer, err := p.readLine()
+		if errors.Is(err, io.EOF) && defaultValue != "" {
+			return defaultValue, nil
+		}
+		if err != nil {
+			return "", oopsBuilder.
+				Wrapf(err, "failed to read answer")
+		}
+
+		if answer == "" && defaultValue != "" {
+			return defaultValue, nil
+		}
+
+		choice, ok := findChoice(choices, answer)
+		if ok {
+			return choice, nil
+		}
+
+		fmt.Fprintf(p.Writer, "%q is not a valid choice\n", answer)
+	}
+}
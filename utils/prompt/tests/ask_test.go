@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"bytes"
+	"os"
+	"strings"
+
+	"github.com/ed3899/kumo/utils/prompt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ask", Label("unit"), func() {
+	var (
+		output *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+	})
+
+	It("should return the answer", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("eu-west-1\n"), output).Ask("Region", "us-west-2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("eu-west-1"))
+		Expect(output.String()).To(Equal("Region [us-west-2]: "))
+	})
+
+	It("should return the default value on an empty answer", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("\n"), output).Ask("Region", "us-west-2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("us-west-2"))
+	})
+
+	It("should repeat the question until answered when there is no default value", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("\n  \ndev"), output).Ask("User", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("dev"))
+		Expect(strings.Count(output.String(), "User: ")).To(Equal(3))
+	})
+
+	It("should return an error when the input ends without a default value", func() {
+		_, err := prompt.NewPrompt(strings.NewReader(""), output).Ask("User", "")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("AskSecret", Label("unit"), func() {
+	It("should not write out the default value", func() {
+		output := &bytes.Buffer{}
+
+		answer, err := prompt.NewPrompt(strings.NewReader("\n"), output).AskSecret("Secret", "s3cr3t")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("s3cr3t"))
+		Expect(output.String()).To(Equal("Secret [****]: "))
+	})
+
+	It("should read the answer as a line from a file that isn't a terminal", func() {
+		reader, writer, err := os.Pipe()
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+
+		_, err = writer.WriteString("t0k3n\n")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(writer.Close()).To(Succeed())
+
+		answer, err := prompt.NewPrompt(reader, &bytes.Buffer{}).AskSecret("Token", "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("t0k3n"))
+	})
+})
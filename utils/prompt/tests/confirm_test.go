@@ -0,0 +1,53 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ed3899/kumo/utils/prompt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Confirm", Label("unit"), func() {
+	var (
+		output *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+	})
+
+	DescribeTable("should return the answer",
+		func(input string, expected bool) {
+			confirmed, err := prompt.NewPrompt(strings.NewReader(input), output).Confirm("Deploy?", false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(confirmed).To(Equal(expected))
+		},
+		Entry("y", "y\n", true),
+		Entry("YES", "YES\n", true),
+		Entry("n", "n\n", false),
+		Entry("no", "no\n", false),
+	)
+
+	It("should return the default value on an empty answer", func() {
+		confirmed, err := prompt.NewPrompt(strings.NewReader("\n"), output).Confirm("Deploy?", true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(confirmed).To(BeTrue())
+		Expect(output.String()).To(Equal("Deploy? [Y/n]: "))
+	})
+
+	It("should return the default value when the input ends", func() {
+		confirmed, err := prompt.NewPrompt(strings.NewReader(""), output).Confirm("Deploy?", false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(confirmed).To(BeFalse())
+		Expect(output.String()).To(Equal("Deploy? [y/N]: "))
+	})
+
+	It("should repeat the question until a valid answer is given", func() {
+		confirmed, err := prompt.NewPrompt(strings.NewReader("maybe\ny\n"), output).Confirm("Deploy?", false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(confirmed).To(BeTrue())
+		Expect(strings.Count(output.String(), "Deploy? [y/N]: ")).To(Equal(2))
+	})
+})
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ed3899/kumo/utils/prompt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MultiSelect", Label("unit"), func() {
+	var (
+		output  *bytes.Buffer
+		choices = []string{"docker", "minikube", "go"}
+	)
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+	})
+
+	It("should return the picked choices in the order of the choices", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("go, minikube 1\n"), output).MultiSelect("Tools", choices, []string{"docker"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal([]string{"docker", "minikube", "go"}))
+	})
+
+	It("should return the default values on an empty answer", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("\n"), output).MultiSelect("Tools", choices, []string{"docker"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal([]string{"docker"}))
+	})
+
+	It("should repeat the question on an invalid choice", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("go, rust\n3\n"), output).MultiSelect("Tools", choices, []string{"docker"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal([]string{"go"}))
+		Expect(output.String()).To(ContainSubstring(`"rust" is not a valid choice`))
+	})
+})
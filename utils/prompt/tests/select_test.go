@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ed3899/kumo/utils/prompt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Select", Label("unit"), func() {
+	var (
+		output  *bytes.Buffer
+		choices = []string{"aws", "gcp"}
+	)
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+	})
+
+	It("should pick a choice by number", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("2\n"), output).Select("Cloud", choices, "aws")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("gcp"))
+		Expect(output.String()).To(ContainSubstring("  2) gcp\n"))
+	})
+
+	It("should pick a choice by name", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("GCP\n"), output).Select("Cloud", choices, "aws")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("gcp"))
+	})
+
+	It("should return the default value on an empty answer", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("\n"), output).Select("Cloud", choices, "aws")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("aws"))
+	})
+
+	It("should repeat the question on an invalid choice", func() {
+		answer, err := prompt.NewPrompt(strings.NewReader("3\nazure\ngcp\n"), output).Select("Cloud", choices, "aws")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(answer).To(Equal("gcp"))
+		Expect(output.String()).To(ContainSubstring(`"3" is not a valid choice`))
+		Expect(output.String()).To(ContainSubstring(`"azure" is not a valid choice`))
+	})
+})
@@ -0,0 +1,59 @@
+package release
+
+import (
+	"github.com/ed3899/kumo/utils/url"
+	"github.com/samber/oops"
+)
+
+// Returns the checksum of fileName in the SHA256SUMS file at sha256SumsUrl, once the signature at signatureUrl
+// proves the file was signed by the armored public key.
+//
+// Example:
+//
+//	("https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_SHA256SUMS", "https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_SHA256SUMS.sig", "packer_1.7.4_linux_amd64.zip", HashicorpPublicKey) -> ("9cd8f2e1...", nil)
+func GetSha256(
+	sha256SumsUrl,
+	signatureUrl,
+	fileName,
+	armoredPublicKey string,
+) (string, error) {
+	oopsBuilder := oops.
+		Code("GetSha256").
+		In("utils").
+		In("release").
+		With("sha256SumsUrl", sha256SumsUrl).
+		With("signatureUrl", signatureUrl).
+		With("fileName", fileName)
+
+	sha256Sums, err := url.Get(sha256SumsUrl)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to get the checksums")
+	}
+
+	signature, err := url.Get(signatureUrl)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to get the signature of the checksums")
+	}
+
+	err = VerifySignature(armoredPublicKey, sha256Sums, signature)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to verify the checksums")
+	}
+
+	sums, err := ParseSha256Sums(sha256Sums)
+	if err != nil {
+		return "", oopsBuilder.
+			Wrapf(err, "failed to parse the checksums")
+	}
+
+	sum, ok := sums[fileName]
+	if !ok {
+		return "", oopsBuilder.
+			Errorf("the checksums don't list %s", fileName)
+	}
+
+	return sum, nil
+}
@@ -0,0 +1,11 @@
+package release
+
+import (
+	_ "embed"
+)
+
+// The armored public key HashiCorp signs the SHA256SUMS of its releases with, fingerprint
+// C874 011F 0AB4 0511 0D02 1055 3436 5D94 72D7 468F, see https://www.hashicorp.com/security.
+//
+//go:embed hashicorp.asc
+var HashicorpPublicKey string
@@ -0,0 +1,53 @@
+package release
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+
+	"github.com/samber/oops"
+)
+
+var (
+	// The format of sha256sum: the checksum, a space, a space or a * in binary mode, then the file name.
+	sha256SumPattern = regexp.MustCompile(`^([0-9a-f]{64}) [ *](\S+)$`)
+)
+
+// Returns the checksums of a SHA256SUMS file by file name.
+//
+// Example:
+//
+//	([]byte("9cd8f2e1...  packer_1.7.4_linux_amd64.zip\n")) -> (map[string]string{"packer_1.7.4_linux_amd64.zip": "9cd8f2e1..."}, nil)
+func ParseSha256Sums(
+	content []byte,
+) (map[string]string, error) {
+	oopsBuilder := oops.
+		Code("ParseSha256Sums").
+		In("utils").
+		In("release")
+
+	sums := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		match := sha256SumPattern.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			return nil, oopsBuilder.
+				With("line", line).
+				Errorf("line %d isn't a checksum followed by a file name", line)
+		}
+
+		sums[match[2]] = match[1]
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to read the checksums")
+	}
+
+	return sums, nil
+}
@@ -0,0 +1,5 @@
+package tests
+
+const (
+	zipName = "packer_1.9.2_linux_amd64.zip"
+)
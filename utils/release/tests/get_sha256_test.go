@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/ed3899/kumo/utils/release"
+	"github.com/ed3899/kumo/utils/test_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetSha256", func() {
+	var (
+		zipContent = []byte("packer")
+	)
+
+	It("should return the checksum of a signed release", Label("unit"), func() {
+		key, armoredPublicKey := test_helpers.NewSigningKey()
+		sha256Sums := test_helpers.NewSha256Sums(zipName, zipContent)
+
+		server := test_helpers.NewFileServer(map[string][]byte{
+			"/SHA256SUMS":     sha256Sums,
+			"/SHA256SUMS.sig": test_helpers.Sign(key, sha256Sums),
+		})
+		defer server.Close()
+
+		sum, err := release.GetSha256(server.URL+"/SHA256SUMS", server.URL+"/SHA256SUMS.sig", zipName, armoredPublicKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		expectedSum := sha256.Sum256(zipContent)
+		Expect(sum).To(Equal(hex.EncodeToString(expectedSum[:])))
+	})
+
+	It("should return an error when the checksums were tampered with", Label("unit"), func() {
+		key, armoredPublicKey := test_helpers.NewSigningKey()
+		signature := test_helpers.Sign(key, test_helpers.NewSha256Sums(zipName, zipContent))
+
+		server := test_helpers.NewFileServer(map[string][]byte{
+			"/SHA256SUMS":     test_helpers.NewSha256Sums(zipName, []byte("malicious packer")),
+			"/SHA256SUMS.sig": signature,
+		})
+		defer server.Close()
+
+		_, err := release.GetSha256(server.URL+"/SHA256SUMS", server.URL+"/SHA256SUMS.sig", zipName, armoredPublicKey)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("signature"))
+	})
+
+	It("should return an error when the checksums were signed by another key", Label("unit"), func() {
+		key, _ := test_helpers.NewSigningKey()
+		sha256Sums := test_helpers.NewSha256Sums(zipName, zipContent)
+
+		server := test_helpers.NewFileServer(map[string][]byte{
+			"/SHA256SUMS":     sha256Sums,
+			"/SHA256SUMS.sig": test_helpers.Sign(key, sha256Sums),
+		})
+		defer server.Close()
+
+		_, err := release.GetSha256(server.URL+"/SHA256SUMS", server.URL+"/SHA256SUMS.sig", zipName, release.HashicorpPublicKey)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error when the release has no such zip", Label("unit"), func() {
+		key, armoredPublicKey := test_helpers.NewSigningKey()
+		sha256Sums := test_helpers.NewSha256Sums(zipName, zipContent)
+
+		server := test_helpers.NewFileServer(map[string][]byte{
+			"/SHA256SUMS":     sha256Sums,
+			"/SHA256SUMS.sig": test_helpers.Sign(key, sha256Sums),
+		})
+		defer server.Close()
+
+		_, err := release.GetSha256(server.URL+"/SHA256SUMS", server.URL+"/SHA256SUMS.sig", "packer_1.9.2_plan9_amd64.zip", armoredPublicKey)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("don't list packer_1.9.2_plan9_amd64.zip"))
+	})
+
+	It("should return an error when the signature is missing", Label("unit"), func() {
+		server := test_helpers.NewFileServer(map[string][]byte{
+			"/SHA256SUMS":     test_helpers.NewSha256Sums(zipName, zipContent),
+			"/SHA256SUMS.sig": nil,
+		})
+		server.Close()
+
+		_, err := release.GetSha256(server.URL+"/SHA256SUMS", server.URL+"/SHA256SUMS.sig", zipName, release.HashicorpPublicKey)
+		Expect(err).To(HaveOccurred())
+	})
+})
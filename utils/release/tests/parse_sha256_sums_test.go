@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"strings"
+
+	"github.com/ed3899/kumo/utils/release"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseSha256Sums", func() {
+	var (
+		darwinSum = strings.Repeat("a", 64)
+		linuxSum  = strings.Repeat("b", 64)
+	)
+
+	It("should return the checksums by file name", Label("unit"), func() {
+		sums, err := release.ParseSha256Sums([]byte(darwinSum + "  packer_1.9.2_darwin_arm64.zip\n" + linuxSum + " *packer_1.9.2_linux_amd64.zip\n\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sums).To(Equal(map[string]string{
+			"packer_1.9.2_darwin_arm64.zip": darwinSum,
+			"packer_1.9.2_linux_amd64.zip":  linuxSum,
+		}))
+	})
+
+	It("should return an error for a malformed line", Label("unit"), func() {
+		_, err := release.ParseSha256Sums([]byte(darwinSum + "  packer_1.9.2_darwin_arm64.zip\n<html>Not Found</html>\n"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("line 2"))
+	})
+})
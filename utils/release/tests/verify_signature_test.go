@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"strings"
+
+	"github.com/ed3899/kumo/utils/release"
+	"github.com/ed3899/kumo/utils/test_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VerifySignature", func() {
+	var (
+		signed = []byte("SHA256SUMS")
+	)
+
+	It("should accept a signature of the key", Label("unit"), func() {
+		key, armoredPublicKey := test_helpers.NewSigningKey()
+
+		Expect(release.VerifySignature(armoredPublicKey, signed, test_helpers.Sign(key, signed))).To(Succeed())
+	})
+
+	It("should reject a signature of another key", Label("unit"), func() {
+		key, _ := test_helpers.NewSigningKey()
+		_, otherArmoredPublicKey := test_helpers.NewSigningKey()
+
+		Expect(release.VerifySignature(otherArmoredPublicKey, signed, test_helpers.Sign(key, signed))).ToNot(Succeed())
+	})
+
+	It("should reject a signature of other content", Label("unit"), func() {
+		key, armoredPublicKey := test_helpers.NewSigningKey()
+
+		Expect(release.VerifySignature(armoredPublicKey, []byte("tampered"), test_helpers.Sign(key, signed))).ToNot(Succeed())
+	})
+
+	It("should embed the HashiCorp public key", Label("unit"), func() {
+		Expect(strings.TrimSpace(release.HashicorpPublicKey)).To(HavePrefix("-----BEGIN PGP PUBLIC KEY BLOCK-----"))
+		Expect(release.VerifySignature(release.HashicorpPublicKey, signed, []byte("not a signature"))).ToNot(Succeed())
+	})
+})
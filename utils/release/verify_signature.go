@@ -0,0 +1,43 @@
+package release
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/samber/oops"
+	"golang.org/x/crypto/openpgp"
+)
+
+// Checks that the detached signature of signed was made by the armored public key, or one of its subkeys.
+//
+// Example:
+//
+//	(HashicorpPublicKey, sha256Sums, sha256SumsSignature) -> nil
+func VerifySignature(
+	armoredPublicKey string,
+	signed, signature []byte,
+) error {
+	oopsBuilder := oops.
+		Code("VerifySignature").
+		In("utils").
+		In("release")
+
+	keyRing, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredPublicKey))
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "failed to read the public key")
+	}
+
+	signer, err := openpgp.CheckDetachedSignature(keyRing, bytes.NewReader(signed), bytes.NewReader(signature))
+	if err != nil {
+		return oopsBuilder.
+			Wrapf(err, "the signature doesn't match the public key")
+	}
+
+	if signer == nil {
+		return oopsBuilder.
+			Errorf("the signature doesn't match the public key")
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package service_account
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/samber/oops"
+)
+
+const (
+	serviceAccountType = "service_account"
+)
+
+// Fields of a Google Cloud service account key that kumo checks, the key is handed as is to Packer and Terraform.
+type ServiceAccountKey struct {
+	Type         string `json:"type"`
+	ProjectId    string `json:"project_id"`
+	PrivateKeyId string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+}
+
+// Returns the service account key in the JSON file downloaded from the Google Cloud console. The file must be
+// a service account key, user credentials from gcloud auth aren't accepted.
+//
+// Example:
+//
+//	("service-account.json") -> (&ServiceAccountKey{Type: "service_account", ProjectId: "my-kumo-project", ClientEmail: "kumo@my-kumo-project.iam.gserviceaccount.com", ...}, nil)
+func ReadServiceAccountKey(
+	pathToKey string,
+) (*ServiceAccountKey, error) {
+	oopsBuilder := oops.
+		Code("ReadServiceAccountKey").
+		In("utils").
+		In("service_account").
+		With("pathToKey", pathToKey)
+
+	content, err := os.ReadFile(pathToKey)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading service account key '%s'", pathToKey)
+	}
+
+	serviceAccountKey := &ServiceAccountKey{}
+	err = json.Unmarshal(content, serviceAccountKey)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding service account key '%s'", pathToKey)
+	}
+
+	if serviceAccountKey.Type != serviceAccountType {
+		return nil, oopsBuilder.
+			With("type", serviceAccountKey.Type).
+			Errorf("'%s' is of type %q, expected a %q key", pathToKey, serviceAccountKey.Type, serviceAccountType)
+	}
+
+	for _, field := range []struct{ name, value string }{
+		{"project_id", serviceAccountKey.ProjectId},
+		{"private_key", serviceAccountKey.PrivateKey},
+		{"client_email", serviceAccountKey.ClientEmail},
+	} {
+		if field.value == "" {
+			return nil, oopsBuilder.
+				With("field", field.name).
+				Errorf("service account key '%s' has no %s", pathToKey, field.name)
+		}
+	}
+
+	return serviceAccountKey, nil
+}
@@ -0,0 +1,86 @@
+package ssh_config
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+type SshConfig struct {
+	Host         string
+	HostName     string
+	IdentityFile string
+	User         string
+	Port         int
+}
+
+// Reads the first host of a ssh config file, like the ones generated by kumo. Quoted values are unquoted.
+//
+// Example:
+//
+//	("kumossh") -> (&SshConfig{Host: "kumo", HostName: "3.91.10.20", IdentityFile: "/path/to/kumokey", User: "dev", Port: 22}, nil)
+func ReadSshConfig(
+	pathToSshConfig string,
+) (*SshConfig, error) {
+	oopsBuilder := oops.
+		Code("ReadSshConfig").
+		In("utils").
+		In("ssh_config").
+		With("pathToSshConfig", pathToSshConfig)
+
+	file, err := os.Open(pathToSshConfig)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while opening ssh config file '%s'", pathToSshConfig)
+	}
+	defer file.Close()
+
+	sshConfig := &SshConfig{}
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		separator := strings.IndexAny(line, " \t")
+		if separator == -1 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key := line[:separator]
+		value := strings.Trim(strings.TrimSpace(line[separator:]), `"`)
+
+		switch strings.ToLower(key) {
+		case "host":
+			// Only the first host is read.
+			if sshConfig.Host != "" {
+				return sshConfig, nil
+			}
+			sshConfig.Host = value
+
+		case "hostname":
+			sshConfig.HostName = value
+
+		case "identityfile":
+			sshConfig.IdentityFile = value
+
+		case "user":
+			sshConfig.User = value
+
+		case "port":
+			sshConfig.Port, err = strconv.Atoi(value)
+			if err != nil {
+				return nil, oopsBuilder.
+					Wrapf(err, "invalid port '%s'", value)
+			}
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading ssh config file '%s'", pathToSshConfig)
+	}
+
+	return sshConfig, nil
+}
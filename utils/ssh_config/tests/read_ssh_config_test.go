@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/ssh_config"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadSshConfig", Label("unit"), func() {
+	var (
+		sshConfigPath string
+	)
+
+	BeforeEach(func() {
+		sshConfigPath = filepath.Join(GinkgoT().TempDir(), "kumossh")
+	})
+
+	It("should read the first host", func() {
+		content := `Host kumo
+	HostName 3.91.10.20
+	IdentityFile "/path with spaces/kumokey"
+	User dev
+	Port 22
+	StrictHostKeyChecking no
+
+Host other
+	HostName 10.0.0.1
+`
+		Expect(os.WriteFile(sshConfigPath, []byte(content), 0644)).To(Succeed())
+
+		sshConfig, err := ssh_config.ReadSshConfig(sshConfigPath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sshConfig).To(Equal(&ssh_config.SshConfig{
+			Host:         "kumo",
+			HostName:     "3.91.10.20",
+			IdentityFile: "/path with spaces/kumokey",
+			User:         "dev",
+			Port:         22,
+		}))
+	})
+
+	It("should return an error for an invalid port", func() {
+		Expect(os.WriteFile(sshConfigPath, []byte("Host kumo\n\tPort ssh\n"), 0644)).To(Succeed())
+
+		_, err := ssh_config.ReadSshConfig(sshConfigPath)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error for a missing file", func() {
+		_, err := ssh_config.ReadSshConfig(sshConfigPath)
+		Expect(err).To(HaveOccurred())
+	})
+})
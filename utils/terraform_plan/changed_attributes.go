@@ -0,0 +1,36 @@
+package terraform_plan
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+// Returns the top level attributes whose value differs before and after the change, or that are only known once
+// applied, sorted. Nil when none does.
+//
+// Example:
+//
+//	(&ResourceChange{Change: {Before: {"size": 8, "type": "gp2"}, After: {"size": 16, "type": "gp2"}}}) -> []string{"size"}
+func changedAttributes(
+	resourceChange *ResourceChange,
+) []string {
+	change := resourceChange.Change
+	keys := lo.Uniq(append(append(lo.Keys(change.Before), lo.Keys(change.After)...), lo.Keys(change.AfterUnknown)...))
+
+	attributes := lo.Filter(keys, func(key string, _ int) bool {
+		if unknown, ok := change.AfterUnknown[key].(bool); ok && unknown {
+			return true
+		}
+
+		return !reflect.DeepEqual(change.Before[key], change.After[key])
+	})
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	sort.Strings(attributes)
+
+	return attributes
+}
@@ -0,0 +1,30 @@
+package terraform_plan
+
+import (
+	"github.com/samber/lo"
+)
+
+// Returns the changes of the plan to anything but the given resources, keyed by address with the attributes that
+// may change. A nil list lets the resource change in any way, otherwise only updates of the listed attributes are
+// left out.
+//
+// Example:
+//
+//	(map[string][]string{"aws_ec2_instance_state.kumo-ec2-instance-state": nil})
+//	-> []*SummarizedChange{{Address: "aws_instance.kumo-ec2-instance", Action: "replace"}}
+func (p *TerraformPlan) ChangesOutside(
+	resources map[string][]string,
+) []*SummarizedChange {
+	return lo.Reject(p.Changes, func(change *SummarizedChange, _ int) bool {
+		attributes, found := resources[change.Address]
+		if !found {
+			return false
+		}
+
+		if attributes == nil {
+			return true
+		}
+
+		return change.Action == UPDATE && lo.Every(attributes, change.Attributes)
+	})
+}
@@ -0,0 +1,24 @@
+package terraform_plan
+
+// Returns how many resources the plan adds, changes and destroys, counted like terraform does: a replacement
+// adds and destroys.
+func (p *TerraformPlan) Counts() (add, change, destroy int) {
+	for _, _change := range p.Changes {
+		switch _change.Action {
+		case CREATE:
+			add++
+
+		case UPDATE:
+			change++
+
+		case REPLACE:
+			add++
+			destroy++
+
+		case DELETE:
+			destroy++
+		}
+	}
+
+	return add, change, destroy
+}
@@ -0,0 +1,125 @@
+package terraform_plan
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/samber/oops"
+)
+
+const (
+	CREATE  = "create"
+	UPDATE  = "update"
+	REPLACE = "replace"
+	DELETE  = "delete"
+)
+
+type TerraformPlan struct {
+	TerraformVersion string              `json:"terraform_version"`
+	ResourceChanges  []*ResourceChange   `json:"resource_changes"`
+	Changes          []*SummarizedChange `json:"-"`
+}
+
+type ResourceChange struct {
+	Address string `json:"address"`
+	Mode    string `json:"mode"`
+	Type    string `json:"type"`
+	Change  struct {
+		Actions      []string       `json:"actions"`
+		Before       map[string]any `json:"before"`
+		After        map[string]any `json:"after"`
+		AfterUnknown map[string]any `json:"after_unknown"`
+	} `json:"change"`
+}
+
+// A resource the plan creates, updates, replaces or deletes.
+type SummarizedChange struct {
+	Address string
+	Type    string
+	Action  string
+	// The top level attributes an update changes, sorted. Empty for the other actions.
+	Attributes []string
+}
+
+// Returns the plan printed by terraform show -json, with the managed resources that change summarized. Data
+// sources and resources left as they are aren't part of the summary.
+//
+// Example:
+//
+//	(`{"resource_changes": [{"address": "aws_instance.kumo-ec2-instance", "change": {"actions": ["create"]}, ...}]}`)
+//	-> (&TerraformPlan{Changes: []*SummarizedChange{{Address: "aws_instance.kumo-ec2-instance", Action: "create"}}}, nil)
+func ParseTerraformPlan(
+	content []byte,
+) (*TerraformPlan, error) {
+	oopsBuilder := oops.
+		Code("ParseTerraformPlan").
+		In("utils").
+		In("terraform_plan")
+
+	terraformPlan := &TerraformPlan{}
+	err := json.Unmarshal(content, terraformPlan)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform plan")
+	}
+
+	for _, resourceChange := range terraformPlan.ResourceChanges {
+		if resourceChange.Mode != "managed" {
+			continue
+		}
+
+		action, err := summarizeActions(resourceChange.Change.Actions)
+		if err != nil {
+			return nil, oopsBuilder.
+				With("address", resourceChange.Address).
+				Wrapf(err, "Error occurred while summarizing the change of '%s'", resourceChange.Address)
+		}
+
+		if action == "" {
+			continue
+		}
+
+		summarizedChange := &SummarizedChange{
+			Address: resourceChange.Address,
+			Type:    resourceChange.Type,
+			Action:  action,
+		}
+		if action == UPDATE {
+			summarizedChange.Attributes = changedAttributes(resourceChange)
+		}
+
+		terraformPlan.Changes = append(terraformPlan.Changes, summarizedChange)
+	}
+
+	return terraformPlan, nil
+}
+
+// Returns the action of a list of terraform actions, empty when nothing changes. Terraform lists a replacement
+// as a delete and a create, in the order they happen.
+func summarizeActions(
+	actions []string,
+) (string, error) {
+	switch strings.Join(actions, ",") {
+	case "no-op", "read":
+		return "", nil
+
+	case "create":
+		return CREATE, nil
+
+	case "update":
+		return UPDATE, nil
+
+	case "delete":
+		return DELETE, nil
+
+	case "delete,create", "create,delete":
+		return REPLACE, nil
+
+	default:
+		return "", oops.
+			Code("summarizeActions").
+			In("utils").
+			In("terraform_plan").
+			Errorf("unknown actions %v", actions)
+	}
+}
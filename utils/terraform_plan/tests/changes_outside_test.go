@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/utils/terraform_plan"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChangesOutside", Label("unit"), func() {
+	powerResources := map[string][]string{
+		"null_resource.kumo-server-power":       nil,
+		"google_compute_instance.kumo-instance": {"desired_status", "current_status"},
+	}
+
+	It("should leave out any change of a resource without attributes", func() {
+		plan := &terraform_plan.TerraformPlan{Changes: []*terraform_plan.SummarizedChange{
+			{Address: "null_resource.kumo-server-power", Action: terraform_plan.REPLACE},
+		}}
+
+		Expect(plan.ChangesOutside(powerResources)).To(BeEmpty())
+	})
+
+	It("should leave out updates of the listed attributes only", func() {
+		plan := &terraform_plan.TerraformPlan{Changes: []*terraform_plan.SummarizedChange{
+			{Address: "google_compute_instance.kumo-instance", Action: terraform_plan.UPDATE, Attributes: []string{"desired_status"}},
+		}}
+		Expect(plan.ChangesOutside(powerResources)).To(BeEmpty())
+
+		resized := &terraform_plan.SummarizedChange{Address: "google_compute_instance.kumo-instance", Action: terraform_plan.UPDATE, Attributes: []string{"desired_status", "machine_type"}}
+		plan.Changes = []*terraform_plan.SummarizedChange{resized}
+		Expect(plan.ChangesOutside(powerResources)).To(HaveExactElements(resized))
+
+		replaced := &terraform_plan.SummarizedChange{Address: "google_compute_instance.kumo-instance", Action: terraform_plan.REPLACE}
+		plan.Changes = []*terraform_plan.SummarizedChange{replaced}
+		Expect(plan.ChangesOutside(powerResources)).To(HaveExactElements(replaced))
+	})
+
+	It("should return the changes of the other resources", func() {
+		replaced := &terraform_plan.SummarizedChange{Address: "hcloud_server.kumo-server", Action: terraform_plan.REPLACE}
+		plan := &terraform_plan.TerraformPlan{Changes: []*terraform_plan.SummarizedChange{
+			replaced,
+			{Address: "null_resource.kumo-server-power", Action: terraform_plan.REPLACE},
+		}}
+
+		Expect(plan.ChangesOutside(powerResources)).To(HaveExactElements(replaced))
+	})
+})
@@ -0,0 +1,75 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/utils/terraform_plan"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseTerraformPlan", func() {
+	Context("with a plan", Label("unit"), func() {
+		It("should summarize the managed resources that change", func() {
+			plan, err := terraform_plan.ParseTerraformPlan([]byte(`{
+				"format_version": "1.2",
+				"terraform_version": "1.5.7",
+				"resource_changes": [
+					{"address": "aws_instance.kumo-ec2-instance", "mode": "managed", "type": "aws_instance", "change": {"actions": ["create"]}},
+					{"address": "aws_security_group.kumo-security-group", "mode": "managed", "type": "aws_security_group", "change": {"actions": ["update"]}},
+					{"address": "aws_key_pair.kumo-key-pair", "mode": "managed", "type": "aws_key_pair", "change": {"actions": ["delete", "create"]}},
+					{"address": "local_file.kumo-ip-file", "mode": "managed", "type": "local_file", "change": {"actions": ["delete"]}},
+					{"address": "tls_private_key.kumo-private-key", "mode": "managed", "type": "tls_private_key", "change": {"actions": ["no-op"]}},
+					{"address": "data.aws_instance.kumo-ec2-instance", "mode": "data", "type": "aws_instance", "change": {"actions": ["read"]}}
+				]
+			}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.TerraformVersion).To(Equal("1.5.7"))
+			Expect(plan.Changes).To(Equal([]*terraform_plan.SummarizedChange{
+				{Address: "aws_instance.kumo-ec2-instance", Type: "aws_instance", Action: terraform_plan.CREATE},
+				{Address: "aws_security_group.kumo-security-group", Type: "aws_security_group", Action: terraform_plan.UPDATE},
+				{Address: "aws_key_pair.kumo-key-pair", Type: "aws_key_pair", Action: terraform_plan.REPLACE},
+				{Address: "local_file.kumo-ip-file", Type: "local_file", Action: terraform_plan.DELETE},
+			}))
+
+			add, change, destroy := plan.Counts()
+			Expect(add).To(Equal(2))
+			Expect(change).To(Equal(1))
+			Expect(destroy).To(Equal(2))
+		})
+
+		It("should list the attributes an update changes", func() {
+			plan, err := terraform_plan.ParseTerraformPlan([]byte(`{"resource_changes": [
+				{"address": "google_compute_instance.kumo-instance", "mode": "managed", "type": "google_compute_instance", "change": {
+					"actions": ["update"],
+					"before": {"desired_status": "RUNNING", "machine_type": "e2-micro", "current_status": "RUNNING"},
+					"after": {"desired_status": "TERMINATED", "machine_type": "e2-micro"},
+					"after_unknown": {"current_status": true}
+				}}
+			]}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Changes).To(HaveLen(1))
+			Expect(plan.Changes[0].Attributes).To(Equal([]string{"current_status", "desired_status"}))
+		})
+
+		It("should summarize nothing when nothing changes", func() {
+			plan, err := terraform_plan.ParseTerraformPlan([]byte(`{"resource_changes": [
+				{"address": "aws_instance.kumo-ec2-instance", "mode": "managed", "change": {"actions": ["no-op"]}}
+			]}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Changes).To(BeEmpty())
+		})
+	})
+
+	Context("with an invalid plan", Label("unit"), func() {
+		It("should return an error for invalid json", func() {
+			_, err := terraform_plan.ParseTerraformPlan([]byte(`Plan: 1 to add`))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error for unknown actions", func() {
+			_, err := terraform_plan.ParseTerraformPlan([]byte(`{"resource_changes": [
+				{"address": "aws_instance.kumo-ec2-instance", "mode": "managed", "change": {"actions": ["forget"]}}
+			]}`))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
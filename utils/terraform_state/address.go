@@ -0,0 +1,20 @@
+package terraform_state
+
+// Returns the address to connect to the instance: the public IPv4 when there is one, else the public IPv6, else
+// the public DNS name. Empty when the instance has none of them, i.e while stopped.
+//
+// Example:
+//
+//	(&TerraformOutputs{PublicIpv6: "2600:1f18::1", PublicDns: "ec2-....compute-1.amazonaws.com"}).Address() -> "2600:1f18::1"
+func (o *TerraformOutputs) Address() string {
+	switch {
+	case o.PublicIp != "":
+		return o.PublicIp
+
+	case o.PublicIpv6 != "":
+		return o.PublicIpv6
+
+	default:
+		return o.PublicDns
+	}
+}
@@ -0,0 +1,97 @@
+package terraform_state
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+type TerraformState struct {
+	Resources []*TerraformResource `json:"resources"`
+	Outputs   json.RawMessage      `json:"outputs"`
+}
+
+type TerraformResource struct {
+	Mode      string                       `json:"mode"`
+	Type      string                       `json:"type"`
+	Name      string                       `json:"name"`
+	Instances []*TerraformResourceInstance `json:"instances"`
+}
+
+type TerraformResourceInstance struct {
+	Attributes json.RawMessage `json:"attributes"`
+}
+
+type AwsInstance struct {
+	Id               string `json:"id"`
+	Ami              string `json:"ami"`
+	InstanceType     string `json:"instance_type"`
+	InstanceState    string `json:"instance_state"`
+	PublicIp         string `json:"public_ip"`
+	PublicDns        string `json:"public_dns"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+// Returns the EC2 instance recorded in the Terraform state file, or nil if the state doesn't hold one,
+// i.e after a destroy. The state, public IP and DNS come from the aws_instance data source when present, since
+// it's read after kumo stop or start changed them.
+//
+// Example:
+//
+//	("terraform/aws/terraform.tfstate") -> (&AwsInstance{Id: "i-0a1b2c3d4e5f67890", PublicIp: "3.91.10.20", ...}, nil)
+func GetAwsInstanceFromTerraformState(
+	pathToTerraformState string,
+) (*AwsInstance, error) {
+	oopsBuilder := oops.
+		Code("GetAwsInstanceFromTerraformState").
+		In("utils").
+		In("terraform_state").
+		With("pathToTerraformState", pathToTerraformState)
+
+	content, err := os.ReadFile(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading terraform state file '%s'", pathToTerraformState)
+	}
+
+	terraformState := &TerraformState{}
+	err = json.Unmarshal(content, terraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform state file '%s'", pathToTerraformState)
+	}
+
+	resource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "managed" && r.Type == "aws_instance" && len(r.Instances) > 0
+	})
+	if !found {
+		return nil, nil
+	}
+
+	awsInstance := &AwsInstance{}
+	err = json.Unmarshal(resource.Instances[0].Attributes, awsInstance)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding the attributes of %s.%s", resource.Type, resource.Name)
+	}
+
+	dataSource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "data" && r.Type == "aws_instance" && len(r.Instances) > 0
+	})
+	if found {
+		current := &AwsInstance{}
+		err = json.Unmarshal(dataSource.Instances[0].Attributes, current)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "Error occurred while decoding the attributes of data.%s.%s", dataSource.Type, dataSource.Name)
+		}
+
+		awsInstance.InstanceState = current.InstanceState
+		awsInstance.PublicIp = current.PublicIp
+		awsInstance.PublicDns = current.PublicDns
+	}
+
+	return awsInstance, nil
+}
@@ -0,0 +1,82 @@
+package terraform_state
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+type AzureInstance struct {
+	VirtualMachineId string `json:"virtual_machine_id"`
+	Name             string `json:"name"`
+	Size             string `json:"size"`
+	Location         string `json:"location"`
+	PublicIpAddress  string `json:"public_ip_address"`
+	SourceImageId    string `json:"source_image_id"`
+	// Last power action run on the VM, start or deallocate. Empty when none ran.
+	PowerAction string `json:"-"`
+}
+
+// Returns the Azure VM recorded in the Terraform state file, or nil if the state doesn't hold one, i.e after a
+// destroy. The azurerm provider doesn't manage the power state, so it's taken from the azapi_resource_action
+// kumo stop and kumo start run on the VM.
+//
+// Example:
+//
+//	("terraform/azure/terraform.tfstate") -> (&AzureInstance{VirtualMachineId: "4d6f7b8e-...", PublicIpAddress: "20.42.10.5", PowerAction: "start", ...}, nil)
+func GetAzureInstanceFromTerraformState(
+	pathToTerraformState string,
+) (*AzureInstance, error) {
+	oopsBuilder := oops.
+		Code("GetAzureInstanceFromTerraformState").
+		In("utils").
+		In("terraform_state").
+		With("pathToTerraformState", pathToTerraformState)
+
+	content, err := os.ReadFile(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading terraform state file '%s'", pathToTerraformState)
+	}
+
+	terraformState := &TerraformState{}
+	err = json.Unmarshal(content, terraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform state file '%s'", pathToTerraformState)
+	}
+
+	resource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "managed" && r.Type == "azurerm_linux_virtual_machine" && len(r.Instances) > 0
+	})
+	if !found {
+		return nil, nil
+	}
+
+	azureInstance := &AzureInstance{}
+	err = json.Unmarshal(resource.Instances[0].Attributes, azureInstance)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding the attributes of %s.%s", resource.Type, resource.Name)
+	}
+
+	powerResource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "managed" && r.Type == "azapi_resource_action" && len(r.Instances) > 0
+	})
+	if found {
+		power := &struct {
+			Action string `json:"action"`
+		}{}
+		err = json.Unmarshal(powerResource.Instances[0].Attributes, power)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "Error occurred while decoding the attributes of %s.%s", powerResource.Type, powerResource.Name)
+		}
+
+		azureInstance.PowerAction = power.Action
+	}
+
+	return azureInstance, nil
+}
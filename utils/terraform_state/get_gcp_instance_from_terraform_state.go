@@ -0,0 +1,101 @@
+package terraform_state
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+type GcpInstance struct {
+	InstanceId    string
+	Name          string
+	MachineType   string
+	CurrentStatus string
+	NatIp         string
+	Image         string
+	Zone          string
+}
+
+// Attributes of a google_compute_instance as written in the state. Only the first network interface and its
+// first access config are read, which is how terraform/gcp creates the instance.
+type gcpInstanceAttributes struct {
+	InstanceId       string `json:"instance_id"`
+	Name             string `json:"name"`
+	MachineType      string `json:"machine_type"`
+	CurrentStatus    string `json:"current_status"`
+	Zone             string `json:"zone"`
+	NetworkInterface []struct {
+		AccessConfig []struct {
+			NatIp string `json:"nat_ip"`
+		} `json:"access_config"`
+	} `json:"network_interface"`
+	BootDisk []struct {
+		InitializeParams []struct {
+			Image string `json:"image"`
+		} `json:"initialize_params"`
+	} `json:"boot_disk"`
+}
+
+// Returns the Compute Engine instance recorded in the Terraform state file, or nil if the state doesn't hold one,
+// i.e after a destroy. The image is returned by name, the state may hold it as a self link.
+//
+// Example:
+//
+//	("terraform/gcp/terraform.tfstate") -> (&GcpInstance{InstanceId: "4567890123456789012", NatIp: "34.123.45.67", Image: "kumo-1700000000", ...}, nil)
+func GetGcpInstanceFromTerraformState(
+	pathToTerraformState string,
+) (*GcpInstance, error) {
+	oopsBuilder := oops.
+		Code("GetGcpInstanceFromTerraformState").
+		In("utils").
+		In("terraform_state").
+		With("pathToTerraformState", pathToTerraformState)
+
+	content, err := os.ReadFile(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading terraform state file '%s'", pathToTerraformState)
+	}
+
+	terraformState := &TerraformState{}
+	err = json.Unmarshal(content, terraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform state file '%s'", pathToTerraformState)
+	}
+
+	resource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "managed" && r.Type == "google_compute_instance" && len(r.Instances) > 0
+	})
+	if !found {
+		return nil, nil
+	}
+
+	attributes := &gcpInstanceAttributes{}
+	err = json.Unmarshal(resource.Instances[0].Attributes, attributes)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding the attributes of %s.%s", resource.Type, resource.Name)
+	}
+
+	gcpInstance := &GcpInstance{
+		InstanceId:    attributes.InstanceId,
+		Name:          attributes.Name,
+		MachineType:   path.Base(attributes.MachineType),
+		CurrentStatus: attributes.CurrentStatus,
+		Zone:          path.Base(attributes.Zone),
+	}
+
+	if len(attributes.NetworkInterface) > 0 && len(attributes.NetworkInterface[0].AccessConfig) > 0 {
+		gcpInstance.NatIp = attributes.NetworkInterface[0].AccessConfig[0].NatIp
+	}
+
+	if len(attributes.BootDisk) > 0 && len(attributes.BootDisk[0].InitializeParams) > 0 {
+		gcpInstance.Image = path.Base(attributes.BootDisk[0].InitializeParams[0].Image)
+	}
+
+	return gcpInstance, nil
+}
@@ -0,0 +1,85 @@
+package terraform_state
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+type HetznerInstance struct {
+	ServerId    string `json:"id"`
+	Name        string `json:"name"`
+	ServerType  string `json:"server_type"`
+	Location    string `json:"location"`
+	Ipv4Address string `json:"ipv4_address"`
+	Ipv6Address string `json:"ipv6_address"`
+	Image       string `json:"image"`
+	// Last state kumo stop or kumo start put the server in, running or stopped. Empty when none ran.
+	InstanceState string `json:"-"`
+}
+
+// Returns the Hetzner Cloud server recorded in the Terraform state file, or nil if the state doesn't hold one,
+// i.e after a destroy. The hcloud provider doesn't power servers on or off, so the state is taken from the
+// null_resource that calls the API.
+//
+// Example:
+//
+//	("terraform/hetzner/terraform.tfstate") -> (&HetznerInstance{ServerId: "42424242", Ipv4Address: "49.12.10.5", InstanceState: "running", ...}, nil)
+func GetHetznerInstanceFromTerraformState(
+	pathToTerraformState string,
+) (*HetznerInstance, error) {
+	oopsBuilder := oops.
+		Code("GetHetznerInstanceFromTerraformState").
+		In("utils").
+		In("terraform_state").
+		With("pathToTerraformState", pathToTerraformState)
+
+	content, err := os.ReadFile(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading terraform state file '%s'", pathToTerraformState)
+	}
+
+	terraformState := &TerraformState{}
+	err = json.Unmarshal(content, terraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform state file '%s'", pathToTerraformState)
+	}
+
+	resource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "managed" && r.Type == "hcloud_server" && len(r.Instances) > 0
+	})
+	if !found {
+		return nil, nil
+	}
+
+	hetznerInstance := &HetznerInstance{}
+	err = json.Unmarshal(resource.Instances[0].Attributes, hetznerInstance)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding the attributes of %s.%s", resource.Type, resource.Name)
+	}
+
+	powerResource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "managed" && r.Type == "null_resource" && len(r.Instances) > 0
+	})
+	if found {
+		power := &struct {
+			Triggers struct {
+				InstanceState string `json:"instance_state"`
+			} `json:"triggers"`
+		}{}
+		err = json.Unmarshal(powerResource.Instances[0].Attributes, power)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "Error occurred while decoding the attributes of %s.%s", powerResource.Type, powerResource.Name)
+		}
+
+		hetznerInstance.InstanceState = power.Triggers.InstanceState
+	}
+
+	return hetznerInstance, nil
+}
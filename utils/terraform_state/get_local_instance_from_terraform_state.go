@@ -0,0 +1,88 @@
+package terraform_state
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/samber/lo"
+	"github.com/samber/oops"
+)
+
+type LocalInstance struct {
+	ContainerId string              `json:"id"`
+	Name        string              `json:"name"`
+	Image       string              `json:"image"`
+	Ports       []*LocalPortMapping `json:"ports"`
+	// Last state kumo stop or kumo start put the container in, running or stopped. Empty when none ran.
+	InstanceState string `json:"-"`
+}
+
+type LocalPortMapping struct {
+	Internal int    `json:"internal"`
+	External int    `json:"external"`
+	Ip       string `json:"ip"`
+}
+
+// Returns the docker container recorded in the Terraform state file, or nil if the state doesn't hold one, i.e
+// after a destroy. The docker provider doesn't stop containers, so the state is taken from the null_resource that
+// runs docker stop and docker start.
+//
+// Example:
+//
+//	("terraform/local/terraform.tfstate") -> (&LocalInstance{ContainerId: "5f1c0a...", Image: "sha256:3f57d9...", InstanceState: "running", ...}, nil)
+func GetLocalInstanceFromTerraformState(
+	pathToTerraformState string,
+) (*LocalInstance, error) {
+	oopsBuilder := oops.
+		Code("GetLocalInstanceFromTerraformState").
+		In("utils").
+		In("terraform_state").
+		With("pathToTerraformState", pathToTerraformState)
+
+	content, err := os.ReadFile(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading terraform state file '%s'", pathToTerraformState)
+	}
+
+	terraformState := &TerraformState{}
+	err = json.Unmarshal(content, terraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform state file '%s'", pathToTerraformState)
+	}
+
+	resource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "managed" && r.Type == "docker_container" && len(r.Instances) > 0
+	})
+	if !found {
+		return nil, nil
+	}
+
+	localInstance := &LocalInstance{}
+	err = json.Unmarshal(resource.Instances[0].Attributes, localInstance)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding the attributes of %s.%s", resource.Type, resource.Name)
+	}
+
+	powerResource, found := lo.Find(terraformState.Resources, func(r *TerraformResource) bool {
+		return r.Mode == "managed" && r.Type == "null_resource" && len(r.Instances) > 0
+	})
+	if found {
+		power := &struct {
+			Triggers struct {
+				InstanceState string `json:"instance_state"`
+			} `json:"triggers"`
+		}{}
+		err = json.Unmarshal(powerResource.Instances[0].Attributes, power)
+		if err != nil {
+			return nil, oopsBuilder.
+				Wrapf(err, "Error occurred while decoding the attributes of %s.%s", powerResource.Type, powerResource.Name)
+		}
+
+		localInstance.InstanceState = power.Triggers.InstanceState
+	}
+
+	return localInstance, nil
+}
@@ -0,0 +1,49 @@
+package terraform_state
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/samber/oops"
+)
+
+// Returns the outputs recorded in the Terraform state file by the last apply, without running terraform.
+// The outputs are empty after a destroy.
+//
+// Example:
+//
+//	("terraform/aws/terraform.tfstate") -> (&TerraformOutputs{InstanceId: "i-0a1b2c3d4e5f67890", PublicIp: "3.91.10.20", ...}, nil)
+func GetTerraformOutputsFromTerraformState(
+	pathToTerraformState string,
+) (*TerraformOutputs, error) {
+	oopsBuilder := oops.
+		Code("GetTerraformOutputsFromTerraformState").
+		In("utils").
+		In("terraform_state").
+		With("pathToTerraformState", pathToTerraformState)
+
+	content, err := os.ReadFile(pathToTerraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading terraform state file '%s'", pathToTerraformState)
+	}
+
+	terraformState := &TerraformState{}
+	err = json.Unmarshal(content, terraformState)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform state file '%s'", pathToTerraformState)
+	}
+
+	if len(terraformState.Outputs) == 0 {
+		return &TerraformOutputs{}, nil
+	}
+
+	terraformOutputs, err := ParseTerraformOutputs(terraformState.Outputs)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while reading the outputs of terraform state file '%s'", pathToTerraformState)
+	}
+
+	return terraformOutputs, nil
+}
@@ -0,0 +1,78 @@
+package terraform_state
+
+import (
+	"encoding/json"
+	"net"
+
+	"github.com/samber/oops"
+)
+
+type TerraformOutput struct {
+	Value json.RawMessage `json:"value"`
+}
+
+type TerraformOutputs struct {
+	InstanceId       string `json:"instance_id"`
+	PublicIp         string `json:"public_ip"`
+	PublicIpv6       string `json:"public_ipv6"`
+	PublicDns        string `json:"public_dns"`
+	AvailabilityZone string `json:"availability_zone"`
+	// Only output by clouds that don't reach the instance on the ssh port, i.e local.
+	SshPort int `json:"ssh_port"`
+}
+
+// Returns the instance outputs from the outputs printed by terraform output -json, or the outputs of a
+// Terraform state, both have the same shape. Missing outputs are left empty, the IPs must be valid when present.
+//
+// Example:
+//
+//	([]byte(`{"public_ip": {"value": "3.91.10.20", ...}, ...}`)) -> (&TerraformOutputs{PublicIp: "3.91.10.20", ...}, nil)
+func ParseTerraformOutputs(
+	content []byte,
+) (*TerraformOutputs, error) {
+	oopsBuilder := oops.
+		Code("ParseTerraformOutputs").
+		In("utils").
+		In("terraform_state")
+
+	outputs := map[string]*TerraformOutput{}
+	err := json.Unmarshal(content, &outputs)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform outputs")
+	}
+
+	values := map[string]json.RawMessage{}
+	for name, output := range outputs {
+		if output != nil {
+			values[name] = output.Value
+		}
+	}
+
+	// Round trip the values so the outputs are decoded by their json tags.
+	content, err = json.Marshal(values)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while encoding terraform output values")
+	}
+
+	terraformOutputs := &TerraformOutputs{}
+	err = json.Unmarshal(content, terraformOutputs)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform output values")
+	}
+
+	for _, output := range []struct{ name, ip string }{
+		{"public_ip", terraformOutputs.PublicIp},
+		{"public_ipv6", terraformOutputs.PublicIpv6},
+	} {
+		if output.ip != "" && net.ParseIP(output.ip) == nil {
+			return nil, oopsBuilder.
+				With("output", output.name).
+				Errorf("output %s is not a valid ip address: %s", output.name, output.ip)
+		}
+	}
+
+	return terraformOutputs, nil
+}
@@ -0,0 +1,17 @@
+package terraform_state
+
+import "github.com/ed3899/kumo/common/constants"
+
+// Returns the port to connect to the instance over ssh: the ssh_port output when there is one, else the ssh port
+// the clouds open.
+//
+// Example:
+//
+//	(&TerraformOutputs{PublicIp: "127.0.0.1", SshPort: 2222}).Port() -> 2222
+func (o *TerraformOutputs) Port() int {
+	if o.SshPort > 0 {
+		return o.SshPort
+	}
+
+	return constants.SSH_PORT
+}
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/terraform_state"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetAwsInstanceFromTerraformState", Label("unit"), func() {
+	var (
+		statePath string
+	)
+
+	BeforeEach(func() {
+		statePath = filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+	})
+
+	It("should return the instance attributes", func() {
+		state := `{
+  "version": 4,
+  "resources": [
+    {"mode": "data", "type": "aws_ami", "name": "kumo-ami", "instances": [{"attributes": {"id": "ami-0c3fd0f5d33134a76"}}]},
+    {
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "kumo-ec2-instance",
+      "instances": [
+        {
+          "attributes": {
+            "id": "i-0a1b2c3d4e5f67890",
+            "ami": "ami-0c3fd0f5d33134a76",
+            "instance_type": "t2.micro",
+            "instance_state": "running",
+            "public_ip": "3.91.10.20",
+            "public_dns": "ec2-3-91-10-20.compute-1.amazonaws.com",
+            "availability_zone": "us-east-1a",
+            "tags": {"Name": "kumo"}
+          }
+        }
+      ]
+    }
+  ]
+}`
+		Expect(os.WriteFile(statePath, []byte(state), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetAwsInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(Equal(&terraform_state.AwsInstance{
+			Id:               "i-0a1b2c3d4e5f67890",
+			Ami:              "ami-0c3fd0f5d33134a76",
+			InstanceType:     "t2.micro",
+			InstanceState:    "running",
+			PublicIp:         "3.91.10.20",
+			PublicDns:        "ec2-3-91-10-20.compute-1.amazonaws.com",
+			AvailabilityZone: "us-east-1a",
+		}))
+	})
+
+	It("should prefer the current state and ip read by the data source", func() {
+		state := `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "aws_instance",
+      "name": "kumo-ec2-instance",
+      "instances": [{"attributes": {"id": "i-0a1b2c3d4e5f67890", "instance_state": "running", "public_ip": "3.91.10.20", "public_dns": "ec2-3-91-10-20.compute-1.amazonaws.com"}}]
+    },
+    {
+      "mode": "data",
+      "type": "aws_instance",
+      "name": "kumo-ec2-instance",
+      "instances": [{"attributes": {"id": "i-0a1b2c3d4e5f67890", "instance_state": "stopped", "public_ip": "", "public_dns": ""}}]
+    }
+  ]
+}`
+		Expect(os.WriteFile(statePath, []byte(state), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetAwsInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance.Id).To(Equal("i-0a1b2c3d4e5f67890"))
+		Expect(instance.InstanceState).To(Equal("stopped"))
+		Expect(instance.PublicIp).To(BeEmpty())
+		Expect(instance.PublicDns).To(BeEmpty())
+	})
+
+	It("should return nil for a state without an instance", func() {
+		Expect(os.WriteFile(statePath, []byte(`{"version": 4, "resources": []}`), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetAwsInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(BeNil())
+	})
+
+	It("should return an error for a missing state", func() {
+		_, err := terraform_state.GetAwsInstanceFromTerraformState(statePath)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error for an invalid state", func() {
+		Expect(os.WriteFile(statePath, []byte(`{`), 0644)).To(Succeed())
+
+		_, err := terraform_state.GetAwsInstanceFromTerraformState(statePath)
+		Expect(err).To(HaveOccurred())
+	})
+})
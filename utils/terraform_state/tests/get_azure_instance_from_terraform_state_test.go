@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/terraform_state"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetAzureInstanceFromTerraformState", Label("unit"), func() {
+	var (
+		statePath string
+	)
+
+	BeforeEach(func() {
+		statePath = filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+	})
+
+	It("should return the vm attributes and its last power action", func() {
+		state := `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "azurerm_resource_group", "name": "kumo-resource-group", "instances": [{"attributes": {"id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/kumo"}}]},
+    {
+      "mode": "managed",
+      "type": "azurerm_linux_virtual_machine",
+      "name": "kumo-vm",
+      "instances": [
+        {
+          "attributes": {
+            "id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/kumo/providers/Microsoft.Compute/virtualMachines/kumo",
+            "virtual_machine_id": "4d6f7b8e-1a2b-4c3d-9e8f-0a1b2c3d4e5f",
+            "name": "kumo",
+            "size": "Standard_B1s",
+            "location": "eastus",
+            "public_ip_address": "20.42.10.5",
+            "source_image_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/kumo-images/providers/Microsoft.Compute/images/kumo-20231001120000"
+          }
+        }
+      ]
+    },
+    {"mode": "managed", "type": "azapi_resource_action", "name": "kumo-vm-power", "instances": [{"attributes": {"action": "deallocate"}}]}
+  ]
+}`
+		Expect(os.WriteFile(statePath, []byte(state), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetAzureInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(Equal(&terraform_state.AzureInstance{
+			VirtualMachineId: "4d6f7b8e-1a2b-4c3d-9e8f-0a1b2c3d4e5f",
+			Name:             "kumo",
+			Size:             "Standard_B1s",
+			Location:         "eastus",
+			PublicIpAddress:  "20.42.10.5",
+			SourceImageId:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/kumo-images/providers/Microsoft.Compute/images/kumo-20231001120000",
+			PowerAction:      "deallocate",
+		}))
+	})
+
+	It("should return nil when the state has no vm", func() {
+		Expect(os.WriteFile(statePath, []byte(`{"version": 4, "resources": []}`), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetAzureInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(BeNil())
+	})
+
+	It("should return an error for a missing state file", func() {
+		_, err := terraform_state.GetAzureInstanceFromTerraformState(statePath)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/terraform_state"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetGcpInstanceFromTerraformState", Label("unit"), func() {
+	var (
+		statePath string
+	)
+
+	BeforeEach(func() {
+		statePath = filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+	})
+
+	It("should return the instance attributes", func() {
+		state := `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "google_compute_network", "name": "kumo-network", "instances": [{"attributes": {"id": "projects/my-kumo-project/global/networks/kumo"}}]},
+    {
+      "mode": "managed",
+      "type": "google_compute_instance",
+      "name": "kumo-instance",
+      "instances": [
+        {
+          "attributes": {
+            "id": "projects/my-kumo-project/zones/us-central1-a/instances/kumo",
+            "instance_id": "4567890123456789012",
+            "name": "kumo",
+            "machine_type": "e2-micro",
+            "current_status": "RUNNING",
+            "zone": "us-central1-a",
+            "boot_disk": [{"initialize_params": [{"image": "https://www.googleapis.com/compute/v1/projects/my-kumo-project/global/images/kumo-1700000000"}]}],
+            "network_interface": [{"access_config": [{"nat_ip": "34.123.45.67"}]}]
+          }
+        }
+      ]
+    }
+  ]
+}`
+		Expect(os.WriteFile(statePath, []byte(state), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetGcpInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(Equal(&terraform_state.GcpInstance{
+			InstanceId:    "4567890123456789012",
+			Name:          "kumo",
+			MachineType:   "e2-micro",
+			CurrentStatus: "RUNNING",
+			NatIp:         "34.123.45.67",
+			Image:         "kumo-1700000000",
+			Zone:          "us-central1-a",
+		}))
+	})
+
+	It("should leave the ip empty for an instance without an access config", func() {
+		state := `{"resources": [{"mode": "managed", "type": "google_compute_instance", "name": "kumo-instance", "instances": [{"attributes": {"instance_id": "1", "current_status": "TERMINATED", "network_interface": [{"access_config": []}]}}]}]}`
+		Expect(os.WriteFile(statePath, []byte(state), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetGcpInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance.NatIp).To(BeEmpty())
+		Expect(instance.CurrentStatus).To(Equal("TERMINATED"))
+	})
+
+	It("should return nil when the state has no instance", func() {
+		Expect(os.WriteFile(statePath, []byte(`{"version": 4, "resources": []}`), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetGcpInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(BeNil())
+	})
+
+	It("should return an error for a missing state file", func() {
+		_, err := terraform_state.GetGcpInstanceFromTerraformState(statePath)
+		Expect(err).To(HaveOccurred())
+	})
+})
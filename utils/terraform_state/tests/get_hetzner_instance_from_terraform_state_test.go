@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/terraform_state"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetHetznerInstanceFromTerraformState", Label("unit"), func() {
+	var (
+		statePath string
+	)
+
+	BeforeEach(func() {
+		statePath = filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+	})
+
+	It("should return the server attributes and its last state", func() {
+		state := `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "hcloud_firewall", "name": "kumo-firewall", "instances": [{"attributes": {"id": "1234567"}}]},
+    {
+      "mode": "managed",
+      "type": "hcloud_server",
+      "name": "kumo-server",
+      "instances": [
+        {
+          "attributes": {
+            "id": "42424242",
+            "name": "kumo",
+            "server_type": "cx22",
+            "location": "fsn1",
+            "ipv4_address": "49.12.10.5",
+            "ipv6_address": "2a01:4f8:c17:1::1",
+            "image": "135790864",
+            "status": "running"
+          }
+        }
+      ]
+    },
+    {"mode": "managed", "type": "null_resource", "name": "kumo-server-power", "instances": [{"attributes": {"id": "1", "triggers": {"server_id": "42424242", "instance_state": "stopped"}}}]}
+  ]
+}`
+		Expect(os.WriteFile(statePath, []byte(state), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetHetznerInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(Equal(&terraform_state.HetznerInstance{
+			ServerId:      "42424242",
+			Name:          "kumo",
+			ServerType:    "cx22",
+			Location:      "fsn1",
+			Ipv4Address:   "49.12.10.5",
+			Ipv6Address:   "2a01:4f8:c17:1::1",
+			Image:         "135790864",
+			InstanceState: "stopped",
+		}))
+	})
+
+	It("should return nil when the state has no server", func() {
+		Expect(os.WriteFile(statePath, []byte(`{"version": 4, "resources": []}`), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetHetznerInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(BeNil())
+	})
+
+	It("should return an error for a missing state file", func() {
+		_, err := terraform_state.GetHetznerInstanceFromTerraformState(statePath)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,71 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/terraform_state"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetLocalInstanceFromTerraformState", Label("unit"), func() {
+	var (
+		statePath string
+	)
+
+	BeforeEach(func() {
+		statePath = filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+	})
+
+	It("should return the container attributes and its last state", func() {
+		state := `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "tls_private_key", "name": "kumo-ssh-key", "instances": [{"attributes": {"id": "abc"}}]},
+    {
+      "mode": "managed",
+      "type": "docker_container",
+      "name": "kumo-container",
+      "instances": [
+        {
+          "attributes": {
+            "id": "5f1c0a7e9b2d",
+            "name": "kumo",
+            "image": "sha256:3f57d9401f8d42f986df300f0c69192fc41da28ccc8d797829467780db3dd741",
+            "ports": [{"internal": 22, "external": 2222, "ip": "127.0.0.1", "protocol": "tcp"}]
+          }
+        }
+      ]
+    },
+    {"mode": "managed", "type": "null_resource", "name": "kumo-container-power", "instances": [{"attributes": {"id": "1", "triggers": {"container_id": "5f1c0a7e9b2d", "instance_state": "stopped"}}}]}
+  ]
+}`
+		Expect(os.WriteFile(statePath, []byte(state), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetLocalInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(Equal(&terraform_state.LocalInstance{
+			ContainerId: "5f1c0a7e9b2d",
+			Name:        "kumo",
+			Image:       "sha256:3f57d9401f8d42f986df300f0c69192fc41da28ccc8d797829467780db3dd741",
+			Ports: []*terraform_state.LocalPortMapping{
+				{Internal: 22, External: 2222, Ip: "127.0.0.1"},
+			},
+			InstanceState: "stopped",
+		}))
+	})
+
+	It("should return nil when the state has no container", func() {
+		Expect(os.WriteFile(statePath, []byte(`{"version": 4, "resources": []}`), 0644)).To(Succeed())
+
+		instance, err := terraform_state.GetLocalInstanceFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance).To(BeNil())
+	})
+
+	It("should return an error for a missing state file", func() {
+		_, err := terraform_state.GetLocalInstanceFromTerraformState(statePath)
+		Expect(err).To(HaveOccurred())
+	})
+})
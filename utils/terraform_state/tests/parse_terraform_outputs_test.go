@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/terraform_state"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseTerraformOutputs", Label("unit"), func() {
+	It("should return the outputs printed by terraform output -json", func() {
+		content := `{
+  "availability_zone": {"sensitive": false, "type": "string", "value": "us-east-1a"},
+  "instance_id": {"sensitive": false, "type": "string", "value": "i-0a1b2c3d4e5f67890"},
+  "public_dns": {"sensitive": false, "type": "string", "value": "ec2-3-91-10-20.compute-1.amazonaws.com"},
+  "public_ip": {"sensitive": false, "type": "string", "value": "3.91.10.20"},
+  "public_ipv6": {"sensitive": false, "type": "string", "value": "2600:1f18:4a3:6a00::1"},
+  "other": {"sensitive": false, "type": ["list", "string"], "value": ["a"]}
+}`
+
+		outputs, err := terraform_state.ParseTerraformOutputs([]byte(content))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outputs).To(Equal(&terraform_state.TerraformOutputs{
+			InstanceId:       "i-0a1b2c3d4e5f67890",
+			PublicIp:         "3.91.10.20",
+			PublicIpv6:       "2600:1f18:4a3:6a00::1",
+			PublicDns:        "ec2-3-91-10-20.compute-1.amazonaws.com",
+			AvailabilityZone: "us-east-1a",
+		}))
+	})
+
+	It("should leave missing outputs empty", func() {
+		outputs, err := terraform_state.ParseTerraformOutputs([]byte(`{}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outputs).To(Equal(&terraform_state.TerraformOutputs{}))
+	})
+
+	It("should return an error for an invalid ip", func() {
+		_, err := terraform_state.ParseTerraformOutputs([]byte(`{"public_ipv6": {"value": "not-an-ip"}}`))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return an error for malformed json", func() {
+		_, err := terraform_state.ParseTerraformOutputs([]byte(`{"public_ip": `))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("TerraformOutputs.Address", Label("unit"), func() {
+	It("should prefer the public ipv4, then the public ipv6, then the public dns", func() {
+		outputs := &terraform_state.TerraformOutputs{
+			PublicIp:   "3.91.10.20",
+			PublicIpv6: "2600:1f18:4a3:6a00::1",
+			PublicDns:  "ec2-3-91-10-20.compute-1.amazonaws.com",
+		}
+		Expect(outputs.Address()).To(Equal("3.91.10.20"))
+
+		outputs.PublicIp = ""
+		Expect(outputs.Address()).To(Equal("2600:1f18:4a3:6a00::1"))
+
+		outputs.PublicIpv6 = ""
+		Expect(outputs.Address()).To(Equal("ec2-3-91-10-20.compute-1.amazonaws.com"))
+
+		outputs.PublicDns = ""
+		Expect(outputs.Address()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("GetTerraformOutputsFromTerraformState", Label("unit"), func() {
+	var (
+		statePath string
+	)
+
+	BeforeEach(func() {
+		statePath = filepath.Join(GinkgoT().TempDir(), "terraform.tfstate")
+	})
+
+	It("should return the outputs of the state", func() {
+		state := `{"version": 4, "outputs": {"instance_id": {"value": "i-0a1b2c3d4e5f67890", "type": "string"}, "public_ipv6": {"value": "2600:1f18:4a3:6a00::1", "type": "string"}}, "resources": []}`
+		Expect(os.WriteFile(statePath, []byte(state), 0644)).To(Succeed())
+
+		outputs, err := terraform_state.GetTerraformOutputsFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outputs).To(Equal(&terraform_state.TerraformOutputs{
+			InstanceId: "i-0a1b2c3d4e5f67890",
+			PublicIpv6: "2600:1f18:4a3:6a00::1",
+		}))
+	})
+
+	It("should return empty outputs for a state without outputs", func() {
+		Expect(os.WriteFile(statePath, []byte(`{"version": 4, "resources": []}`), 0644)).To(Succeed())
+
+		outputs, err := terraform_state.GetTerraformOutputsFromTerraformState(statePath)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outputs).To(Equal(&terraform_state.TerraformOutputs{}))
+	})
+
+	It("should return an error when the state is missing", func() {
+		_, err := terraform_state.GetTerraformOutputsFromTerraformState(statePath)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("TerraformOutputs.Port", Label("unit"), func() {
+	It("should return the ssh_port output, else the ssh port", func() {
+		outputs, err := terraform_state.ParseTerraformOutputs([]byte(`{"public_ip": {"value": "127.0.0.1"}, "ssh_port": {"value": 2222}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(outputs.Port()).To(Equal(2222))
+
+		outputs.SshPort = 0
+		Expect(outputs.Port()).To(Equal(22))
+	})
+})
@@ -0,0 +1,13 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestTerraformState(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Terraform State Suite", Label("utils", "terraform_state"))
+}
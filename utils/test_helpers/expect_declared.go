@@ -0,0 +1,35 @@
+package test_helpers
+
+import (
+	"github.com/ed3899/kumo/utils/hcl"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+)
+
+// Expects every variable assigned in the vars to be declared in the dir, and every required one to be assigned.
+// Variables with a default are left out, as in Manager.ValidateVars.
+//
+// Example:
+//
+//	("/tmp/Test.../001/vars", "../../../packer/local", ".pkr.hcl")
+func ExpectDeclared(
+	pathToVars,
+	pathToDir,
+	extension string,
+) {
+	declared, err := hcl.GetDeclaredVariables(pathToDir, extension)
+	Expect(err).ToNot(HaveOccurred())
+
+	assigned, err := hcl.GetAssignedVariables(pathToVars)
+	Expect(err).ToNot(HaveOccurred())
+
+	declaredNames := lo.Map(declared, func(v *hcl.Variable, _ int) string {
+		return v.Name
+	})
+	requiredNames := lo.FilterMap(declared, func(v *hcl.Variable, _ int) (string, bool) {
+		return v.Name, v.Required
+	})
+
+	Expect(declaredNames).To(ContainElements(assigned))
+	Expect(assigned).To(ContainElements(requiredNames))
+}
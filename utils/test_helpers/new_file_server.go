@@ -0,0 +1,28 @@
+package test_helpers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+// Serves the files by path, with HEAD and Range requests like releases.hashicorp.com. Other paths are not found.
+// Closing the server is up to the caller.
+//
+// Example:
+//
+//	(map[string][]byte{"/SHA256SUMS": sha256Sums, "/SHA256SUMS.sig": signature}) -> &httptest.Server{URL: "http://127.0.0.1:40123"}
+func NewFileServer(
+	files map[string][]byte,
+) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+
+		http.ServeContent(w, r, r.URL.Path, time.Time{}, bytes.NewReader(content))
+	}))
+}
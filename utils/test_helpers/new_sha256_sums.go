@@ -0,0 +1,26 @@
+package test_helpers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Returns the SHA256SUMS of a release of a zip with the given content, listed after another zip.
+//
+// Example:
+//
+//	("packer_1.9.2_linux_amd64.zip", []byte("packer")) -> []byte("0000...  other_1.0.0_linux_amd64.zip\n6f8d...  packer_1.9.2_linux_amd64.zip\n")
+func NewSha256Sums(
+	zipName string,
+	zipContent []byte,
+) []byte {
+	sum := sha256.Sum256(zipContent)
+
+	return []byte(fmt.Sprintf(
+		"%s  other_1.0.0_linux_amd64.zip\n%s  %s\n",
+		hex.EncodeToString(make([]byte, sha256.Size)),
+		hex.EncodeToString(sum[:]),
+		zipName,
+	))
+}
@@ -0,0 +1,27 @@
+package test_helpers
+
+import (
+	"bytes"
+
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+// Returns a new signing key and its armored public key, standing in for the HashiCorp one in tests.
+//
+// Example:
+//
+//	() -> (&openpgp.Entity{...}, "-----BEGIN PGP PUBLIC KEY BLOCK-----...")
+func NewSigningKey() (*openpgp.Entity, string) {
+	entity, err := openpgp.NewEntity("kumo", "test", "kumo@example.com", nil)
+	Expect(err).NotTo(HaveOccurred())
+
+	armoredPublicKey := &bytes.Buffer{}
+	armorWriter, err := armor.Encode(armoredPublicKey, openpgp.PublicKeyType, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(entity.Serialize(armorWriter)).To(Succeed())
+	Expect(armorWriter.Close()).To(Succeed())
+
+	return entity, armoredPublicKey.String()
+}
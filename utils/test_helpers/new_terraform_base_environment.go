@@ -0,0 +1,19 @@
+package test_helpers
+
+import (
+	"github.com/ed3899/kumo/manager/environment"
+)
+
+// Returns a terraform base environment allowing 203.0.113.10/32. Built by hand, environment.NewTerraformBaseEnvironment
+// looks up the public IP.
+//
+// Example:
+//
+//	() -> &environment.TerraformBaseEnvironment{Required: &environment.TerraformBaseRequired{ALLOWED_IP: "203.0.113.10/32"}}
+func NewTerraformBaseEnvironment() *environment.TerraformBaseEnvironment {
+	return &environment.TerraformBaseEnvironment{
+		Required: &environment.TerraformBaseRequired{
+			ALLOWED_IP: "203.0.113.10/32",
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package test_helpers
+
+import (
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/ed3899/kumo/common/iota"
+	"github.com/ed3899/kumo/utils/file"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Renders the cloud and base templates in the dir the way kumo does before running a tool, i.e templates/packer.
+// Returns the path to the rendered vars, in a temp dir.
+//
+// Example:
+//
+//	("../../../templates/packer", "local", &environment.Environment[any]{...}) -> "/tmp/Test.../001/vars"
+func RenderTemplates(
+	pathToTemplates string,
+	cloud iota.Cloud,
+	data any,
+) string {
+	dir := GinkgoT().TempDir()
+	merged := filepath.Join(dir, "merged.tmpl")
+	Expect(file.MergeFilesTo(
+		merged,
+		filepath.Join(pathToTemplates, cloud.TemplateFiles().Cloud),
+		filepath.Join(pathToTemplates, cloud.TemplateFiles().Base),
+	)).To(Succeed())
+
+	_template, err := template.ParseFiles(merged)
+	Expect(err).ToNot(HaveOccurred())
+
+	pathToVars := filepath.Join(dir, "vars")
+	vars, err := os.Create(pathToVars)
+	Expect(err).ToNot(HaveOccurred())
+	defer vars.Close()
+
+	Expect(_template.Execute(vars, data)).To(Succeed())
+
+	return pathToVars
+}
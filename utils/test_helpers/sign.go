@@ -0,0 +1,23 @@
+package test_helpers
+
+import (
+	"bytes"
+
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/openpgp"
+)
+
+// Returns the detached signature of signed by the key, see NewSigningKey.
+//
+// Example:
+//
+//	(key, []byte("...  packer_1.9.2_linux_amd64.zip")) -> []byte{0xc2, ...}
+func Sign(
+	key *openpgp.Entity,
+	signed []byte,
+) []byte {
+	signature := &bytes.Buffer{}
+	Expect(openpgp.DetachSign(signature, key, bytes.NewReader(signed), nil)).To(Succeed())
+
+	return signature.Bytes()
+}
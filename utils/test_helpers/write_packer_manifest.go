@@ -0,0 +1,43 @@
+package test_helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ed3899/kumo/utils/packer_manifest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// Writes a manifest of one build of the builder per artifact, in a temp dir. The last run built the last artifact.
+// Returns the path to the manifest.
+//
+// Example:
+//
+//	("docker", "sha256:1e2d...", "sha256:3f57...") -> "/tmp/Test.../001/manifest.json"
+func WritePackerManifest(
+	builderType string,
+	artifactIds ...string,
+) string {
+	manifest := &packer_manifest.PackerManifest{}
+
+	for i, artifactId := range artifactIds {
+		manifest.LastRunUUID = fmt.Sprintf("run_uuid_%d", i+1)
+		manifest.Builds = append(manifest.Builds, &packer_manifest.PackerBuild{
+			Name:          "kumo",
+			BuilderType:   builderType,
+			PackerRunUUID: manifest.LastRunUUID,
+			ArtifactId:    artifactId,
+		})
+	}
+
+	content, err := json.Marshal(manifest)
+	Expect(err).ToNot(HaveOccurred())
+
+	pathToManifest := filepath.Join(GinkgoT().TempDir(), "manifest.json")
+	Expect(os.WriteFile(pathToManifest, content, 0644)).To(Succeed())
+
+	return pathToManifest
+}
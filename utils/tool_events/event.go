@@ -0,0 +1,42 @@
+package tool_events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	MESSAGE            = "message"
+	PLANNED_CHANGE     = "planned_change"
+	RESOURCE_STARTED   = "resource_started"
+	RESOURCE_PROGRESS  = "resource_progress"
+	RESOURCE_COMPLETED = "resource_completed"
+	RESOURCE_ERRORED   = "resource_errored"
+	PROVISIONER_STEP   = "provisioner_step"
+	ARTIFACT           = "artifact"
+	OUTPUTS            = "outputs"
+	SUMMARY            = "summary"
+	ERROR              = "error"
+)
+
+const (
+	LEVEL_DEBUG = "debug"
+	LEVEL_INFO  = "info"
+	LEVEL_WARN  = "warn"
+	LEVEL_ERROR = "error"
+)
+
+// Something Terraform or Packer reported while running. Only the fields that make sense for the kind are set,
+// e.g. Resource and Action for the resource events or Id for an artifact.
+type Event struct {
+	Time     time.Time                  `json:"time"`
+	Tool     string                     `json:"tool"`
+	Kind     string                     `json:"kind"`
+	Level    string                     `json:"level"`
+	Message  string                     `json:"message,omitempty"`
+	Resource string                     `json:"resource,omitempty"`
+	Action   string                     `json:"action,omitempty"`
+	Id       string                     `json:"id,omitempty"`
+	Elapsed  float64                    `json:"elapsed_seconds,omitempty"`
+	Outputs  map[string]json.RawMessage `json:"outputs,omitempty"`
+}
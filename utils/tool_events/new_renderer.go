@@ -0,0 +1,101 @@
+package tool_events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+var actionVerbs = map[string][2]string{
+	"create":  {"creating", "created"},
+	"update":  {"updating", "updated"},
+	"replace": {"replacing", "replaced"},
+	"delete":  {"destroying", "destroyed"},
+	"read":    {"reading", "read"},
+}
+
+// Returns a renderer printing a compact progress to the output and, when eventLog isn't nil, every event as a
+// JSON line to the event log. The artifacts and outputs are kept for the caller, i.e kumo build prints the image
+// of the last artifact and kumo up reads the instance outputs without running terraform output.
+//
+// Example:
+//
+//	(os.Stdout, eventLogFile) -> *Renderer
+func NewRenderer(
+	output io.Writer,
+	eventLog io.Writer,
+) *Renderer {
+	return &Renderer{
+		Output:   output,
+		EventLog: eventLog,
+		Outputs:  map[string]json.RawMessage{},
+	}
+}
+
+type Renderer struct {
+	Output    io.Writer
+	EventLog  io.Writer
+	Artifacts []string
+	Outputs   map[string]json.RawMessage
+	mutex     sync.Mutex
+}
+
+// Records and renders the event. Debug messages only go to the event log.
+func (r *Renderer) Handle(event *Event) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.EventLog != nil {
+		if content, err := json.Marshal(event); err == nil {
+			r.EventLog.Write(append(content, '\n'))
+		}
+	}
+
+	verbs, ok := actionVerbs[event.Action]
+	if !ok {
+		verbs = [2]string{event.Action, event.Action}
+	}
+
+	switch event.Kind {
+	case RESOURCE_STARTED:
+		fmt.Fprintf(r.Output, "  %s: %s...\n", event.Resource, verbs[0])
+
+	case RESOURCE_PROGRESS:
+		fmt.Fprintf(r.Output, "  %s: still %s (%s)\n", event.Resource, verbs[0], elapsed(event.Elapsed))
+
+	case RESOURCE_COMPLETED:
+		if event.Id != "" {
+			fmt.Fprintf(r.Output, "✓ %s: %s in %s (%s)\n", event.Resource, verbs[1], elapsed(event.Elapsed), event.Id)
+		} else {
+			fmt.Fprintf(r.Output, "✓ %s: %s in %s\n", event.Resource, verbs[1], elapsed(event.Elapsed))
+		}
+
+	case RESOURCE_ERRORED:
+		fmt.Fprintf(r.Output, "✗ %s: failed while %s\n", event.Resource, verbs[0])
+
+	case ARTIFACT:
+		r.Artifacts = append(r.Artifacts, event.Id)
+		fmt.Fprintf(r.Output, "✓ %s: produced %s\n", event.Resource, event.Id)
+
+	case OUTPUTS:
+		for name, value := range event.Outputs {
+			r.Outputs[name] = value
+		}
+
+	case ERROR:
+		fmt.Fprintf(r.Output, "Error: %s\n", event.Message)
+
+	case PLANNED_CHANGE, PROVISIONER_STEP, SUMMARY, MESSAGE:
+		if event.Level == LEVEL_DEBUG || event.Message == "" {
+			return
+		}
+
+		fmt.Fprintf(r.Output, "%s\n", event.Message)
+	}
+}
+
+func elapsed(seconds float64) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
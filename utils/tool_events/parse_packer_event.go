@@ -0,0 +1,94 @@
+package tool_events
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+// Returns the event of a line printed by packer with -machine-readable, see
+// https://developer.hashicorp.com/packer/docs/commands#machine-readable-output. Lines kumo has no use for,
+// like the artifact count, return a nil event. The provisioner output is kept as debug messages.
+//
+// Example:
+//
+//	("1690000000,amazon-ebs.ubuntu,artifact,0,id,us-east-1:ami-0c3fd0f5d33134a76")
+//	-> (&Event{Kind: "artifact", Resource: "amazon-ebs.ubuntu", Id: "us-east-1:ami-0c3fd0f5d33134a76", ...}, nil)
+func ParsePackerEvent(
+	line string,
+) (*Event, error) {
+	oopsBuilder := oops.
+		Code("ParsePackerEvent").
+		In("utils").
+		In("tool_events").
+		With("line", line)
+
+	fields := strings.Split(line, ",")
+	if len(fields) < 3 {
+		return nil, oopsBuilder.
+			Errorf("Expected at least a timestamp, a target and a type")
+	}
+
+	timestamp, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while parsing the timestamp")
+	}
+
+	target, _type, data := fields[1], fields[2], fields[3:]
+	for i := range data {
+		data[i] = unescapePacker(data[i])
+	}
+
+	event := &Event{
+		Time:     time.Unix(timestamp, 0),
+		Tool:     "packer",
+		Kind:     MESSAGE,
+		Level:    LEVEL_INFO,
+		Resource: target,
+	}
+
+	switch {
+	case _type == "ui" && len(data) >= 2:
+		event.Message = strings.Join(data[1:], ",")
+
+		switch data[0] {
+		case "error":
+			event.Kind = ERROR
+			event.Level = LEVEL_ERROR
+
+		case "message":
+			event.Level = LEVEL_DEBUG
+
+		default:
+			if strings.Contains(event.Message, "Provisioning with") {
+				event.Kind = PROVISIONER_STEP
+			}
+		}
+
+	case _type == "artifact" && len(data) >= 3 && data[1] == "id":
+		event.Kind = ARTIFACT
+		event.Id = strings.Join(data[2:], ",")
+
+	case _type == "error" && len(data) >= 1:
+		event.Kind = ERROR
+		event.Level = LEVEL_ERROR
+		event.Message = strings.Join(data, ",")
+
+	default:
+		return nil, nil
+	}
+
+	return event, nil
+}
+
+// Packer escapes the commas and new lines of a field.
+func unescapePacker(field string) string {
+	return strings.NewReplacer(
+		"%!(PACKER_COMMA)", ",",
+		`\n`, "\n",
+		`\r`, "\r",
+	).Replace(field)
+}
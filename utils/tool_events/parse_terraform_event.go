@@ -0,0 +1,125 @@
+package tool_events
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/samber/oops"
+)
+
+type terraformEvent struct {
+	Level     string    `json:"@level"`
+	Message   string    `json:"@message"`
+	Timestamp time.Time `json:"@timestamp"`
+	Type      string    `json:"type"`
+	Hook      struct {
+		Resource struct {
+			Addr string `json:"addr"`
+		} `json:"resource"`
+		Action         string  `json:"action"`
+		IdValue        string  `json:"id_value"`
+		ElapsedSeconds float64 `json:"elapsed_seconds"`
+		Output         string  `json:"output"`
+	} `json:"hook"`
+	Change struct {
+		Resource struct {
+			Addr string `json:"addr"`
+		} `json:"resource"`
+		Action string `json:"action"`
+	} `json:"change"`
+	Diagnostic struct {
+		Severity string `json:"severity"`
+		Summary  string `json:"summary"`
+		Detail   string `json:"detail"`
+	} `json:"diagnostic"`
+	Outputs map[string]struct {
+		Value json.RawMessage `json:"value"`
+	} `json:"outputs"`
+}
+
+// Returns the event of a line printed by terraform with -json, see
+// https://developer.hashicorp.com/terraform/internals/machine-readable-ui. Types kumo doesn't know about are
+// kept as messages.
+//
+// Example:
+//
+//	(`{"@level": "info", "type": "apply_complete", "hook": {"resource": {"addr": "aws_instance.kumo-ec2-instance"}, "action": "create", "id_value": "i-0a1b2c3d4e5f67890"}, ...}`)
+//	-> (&Event{Kind: "resource_completed", Resource: "aws_instance.kumo-ec2-instance", Action: "create", Id: "i-0a1b2c3d4e5f67890", ...}, nil)
+func ParseTerraformEvent(
+	line []byte,
+) (*Event, error) {
+	oopsBuilder := oops.
+		Code("ParseTerraformEvent").
+		In("utils").
+		In("tool_events").
+		With("line", string(line))
+
+	_terraformEvent := &terraformEvent{}
+	err := json.Unmarshal(line, _terraformEvent)
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "Error occurred while decoding terraform event")
+	}
+
+	event := &Event{
+		Time:    _terraformEvent.Timestamp,
+		Tool:    "terraform",
+		Kind:    MESSAGE,
+		Level:   _terraformEvent.Level,
+		Message: _terraformEvent.Message,
+	}
+
+	hook := _terraformEvent.Hook
+
+	switch _terraformEvent.Type {
+	case "planned_change":
+		event.Kind = PLANNED_CHANGE
+		event.Resource = _terraformEvent.Change.Resource.Addr
+		event.Action = _terraformEvent.Change.Action
+
+	case "apply_start", "apply_progress", "apply_complete", "apply_errored":
+		event.Kind = map[string]string{
+			"apply_start":    RESOURCE_STARTED,
+			"apply_progress": RESOURCE_PROGRESS,
+			"apply_complete": RESOURCE_COMPLETED,
+			"apply_errored":  RESOURCE_ERRORED,
+		}[_terraformEvent.Type]
+		event.Resource = hook.Resource.Addr
+		event.Action = hook.Action
+		event.Id = hook.IdValue
+		event.Elapsed = hook.ElapsedSeconds
+
+	case "provision_start", "provision_progress", "provision_complete":
+		event.Kind = PROVISIONER_STEP
+		event.Resource = hook.Resource.Addr
+		if hook.Output != "" {
+			event.Message = hook.Output
+		}
+
+	case "provision_errored":
+		event.Kind = ERROR
+		event.Resource = hook.Resource.Addr
+
+	case "diagnostic":
+		if _terraformEvent.Diagnostic.Severity == "error" {
+			event.Kind = ERROR
+		}
+		event.Message = _terraformEvent.Diagnostic.Summary
+		if _terraformEvent.Diagnostic.Detail != "" {
+			event.Message = fmt.Sprintf("%s: %s", _terraformEvent.Diagnostic.Summary, _terraformEvent.Diagnostic.Detail)
+		}
+
+	case "change_summary":
+		event.Kind = SUMMARY
+
+	case "outputs":
+		event.Kind = OUTPUTS
+		event.Outputs = map[string]json.RawMessage{}
+		for name, output := range _terraformEvent.Outputs {
+			event.Outputs[name] = output.Value
+		}
+	}
+
+	return event, nil
+}
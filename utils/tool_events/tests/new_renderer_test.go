@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/ed3899/kumo/utils/tool_events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Renderer", Label("unit"), func() {
+	var (
+		output   *bytes.Buffer
+		eventLog *bytes.Buffer
+		renderer *tool_events.Renderer
+	)
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+		eventLog = &bytes.Buffer{}
+		renderer = tool_events.NewRenderer(output, eventLog)
+	})
+
+	It("should render the progress of resources", func() {
+		renderer.Handle(&tool_events.Event{Kind: tool_events.RESOURCE_STARTED, Resource: "aws_instance.kumo-ec2-instance", Action: "create"})
+		renderer.Handle(&tool_events.Event{Kind: tool_events.RESOURCE_COMPLETED, Resource: "aws_instance.kumo-ec2-instance", Action: "create", Id: "i-0a1b2c3d4e5f67890", Elapsed: 32})
+		renderer.Handle(&tool_events.Event{Kind: tool_events.RESOURCE_ERRORED, Resource: "aws_key_pair.kumo-key-pair", Action: "delete"})
+
+		Expect(output.String()).To(Equal(strings.Join([]string{
+			"  aws_instance.kumo-ec2-instance: creating...",
+			"✓ aws_instance.kumo-ec2-instance: created in 32s (i-0a1b2c3d4e5f67890)",
+			"✗ aws_key_pair.kumo-key-pair: failed while destroying",
+			"",
+		}, "\n")))
+	})
+
+	It("should keep the artifacts and outputs", func() {
+		renderer.Handle(&tool_events.Event{Kind: tool_events.ARTIFACT, Resource: "amazon-ebs.ubuntu", Id: "us-east-1:ami-0c3fd0f5d33134a76"})
+		renderer.Handle(&tool_events.Event{Kind: tool_events.OUTPUTS, Outputs: map[string]json.RawMessage{"public_ip": json.RawMessage(`"3.91.10.20"`)}})
+
+		Expect(renderer.Artifacts).To(Equal([]string{"us-east-1:ami-0c3fd0f5d33134a76"}))
+		Expect(renderer.Outputs).To(HaveKeyWithValue("public_ip", json.RawMessage(`"3.91.10.20"`)))
+	})
+
+	It("should only log debug messages", func() {
+		renderer.Handle(&tool_events.Event{Tool: "packer", Kind: tool_events.MESSAGE, Level: tool_events.LEVEL_DEBUG, Message: "apt-get output"})
+
+		Expect(output.String()).To(BeEmpty())
+		Expect(eventLog.String()).To(ContainSubstring(`"message":"apt-get output"`))
+	})
+
+	It("should log every event as a json line", func() {
+		renderer.Handle(&tool_events.Event{Tool: "terraform", Kind: tool_events.MESSAGE, Level: tool_events.LEVEL_INFO, Message: "Terraform 1.5.7"})
+		renderer.Handle(&tool_events.Event{Tool: "terraform", Kind: tool_events.ERROR, Level: tool_events.LEVEL_ERROR, Message: "creating EC2 Instance"})
+
+		lines := strings.Split(strings.TrimSpace(eventLog.String()), "\n")
+		Expect(lines).To(HaveLen(2))
+
+		event := &tool_events.Event{}
+		Expect(json.Unmarshal([]byte(lines[1]), event)).To(Succeed())
+		Expect(event.Kind).To(Equal(tool_events.ERROR))
+		Expect(output.String()).To(ContainSubstring("Error: creating EC2 Instance"))
+	})
+})
@@ -0,0 +1,57 @@
+package tests
+
+import (
+	"time"
+
+	"github.com/ed3899/kumo/utils/tool_events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParsePackerEvent", Label("unit"), func() {
+	It("should parse an artifact", func() {
+		event, err := tool_events.ParsePackerEvent("1690000000,amazon-ebs.ubuntu,artifact,0,id,us-east-1:ami-0c3fd0f5d33134a76")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event).To(Equal(&tool_events.Event{
+			Time:     time.Unix(1690000000, 0),
+			Tool:     "packer",
+			Kind:     tool_events.ARTIFACT,
+			Level:    tool_events.LEVEL_INFO,
+			Resource: "amazon-ebs.ubuntu",
+			Id:       "us-east-1:ami-0c3fd0f5d33134a76",
+		}))
+	})
+
+	It("should parse a provisioner step with escaped commas", func() {
+		event, err := tool_events.ParsePackerEvent("1690000000,,ui,say,==> amazon-ebs.ubuntu: Provisioning with Ansible%!(PACKER_COMMA) tags docker")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Kind).To(Equal(tool_events.PROVISIONER_STEP))
+		Expect(event.Message).To(Equal("==> amazon-ebs.ubuntu: Provisioning with Ansible, tags docker"))
+	})
+
+	It("should keep the provisioner output as debug messages", func() {
+		event, err := tool_events.ParsePackerEvent(`1690000000,,ui,message,    amazon-ebs.ubuntu: ok\n`)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Kind).To(Equal(tool_events.MESSAGE))
+		Expect(event.Level).To(Equal(tool_events.LEVEL_DEBUG))
+		Expect(event.Message).To(Equal("    amazon-ebs.ubuntu: ok\n"))
+	})
+
+	It("should parse errors", func() {
+		event, err := tool_events.ParsePackerEvent("1690000000,,ui,error,Build 'amazon-ebs.ubuntu' errored after 2 minutes")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Kind).To(Equal(tool_events.ERROR))
+		Expect(event.Level).To(Equal(tool_events.LEVEL_ERROR))
+	})
+
+	It("should skip the lines kumo has no use for", func() {
+		event, err := tool_events.ParsePackerEvent("1690000000,amazon-ebs.ubuntu,artifact-count,1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event).To(BeNil())
+	})
+
+	It("should return an error for a line that isn't machine readable", func() {
+		_, err := tool_events.ParsePackerEvent("==> amazon-ebs.ubuntu: Stopping the source instance...")
+		Expect(err).To(HaveOccurred())
+	})
+})
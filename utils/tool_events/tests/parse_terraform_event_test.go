@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ed3899/kumo/utils/tool_events"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseTerraformEvent", Label("unit"), func() {
+	It("should parse a completed resource", func() {
+		event, err := tool_events.ParseTerraformEvent([]byte(`{"@level":"info","@message":"aws_instance.kumo-ec2-instance: Creation complete after 32s [id=i-0a1b2c3d4e5f67890]","@module":"terraform.ui","@timestamp":"2023-10-18T10:00:00.000000Z","hook":{"resource":{"addr":"aws_instance.kumo-ec2-instance","module":"","resource":"aws_instance.kumo-ec2-instance","implied_provider":"aws","resource_type":"aws_instance","resource_name":"kumo-ec2-instance","resource_key":null},"action":"create","id_key":"id","id_value":"i-0a1b2c3d4e5f67890","elapsed_seconds":32},"type":"apply_complete"}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event).To(Equal(&tool_events.Event{
+			Time:     time.Date(2023, 10, 18, 10, 0, 0, 0, time.UTC),
+			Tool:     "terraform",
+			Kind:     tool_events.RESOURCE_COMPLETED,
+			Level:    tool_events.LEVEL_INFO,
+			Message:  "aws_instance.kumo-ec2-instance: Creation complete after 32s [id=i-0a1b2c3d4e5f67890]",
+			Resource: "aws_instance.kumo-ec2-instance",
+			Action:   "create",
+			Id:       "i-0a1b2c3d4e5f67890",
+			Elapsed:  32,
+		}))
+	})
+
+	It("should parse a planned change", func() {
+		event, err := tool_events.ParseTerraformEvent([]byte(`{"@level":"info","@message":"aws_instance.kumo-ec2-instance: Plan to create","type":"planned_change","change":{"resource":{"addr":"aws_instance.kumo-ec2-instance"},"action":"create"}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Kind).To(Equal(tool_events.PLANNED_CHANGE))
+		Expect(event.Resource).To(Equal("aws_instance.kumo-ec2-instance"))
+		Expect(event.Action).To(Equal("create"))
+	})
+
+	It("should parse an error diagnostic", func() {
+		event, err := tool_events.ParseTerraformEvent([]byte(`{"@level":"error","@message":"Error: creating EC2 Instance","type":"diagnostic","diagnostic":{"severity":"error","summary":"creating EC2 Instance","detail":"InsufficientInstanceCapacity"}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Kind).To(Equal(tool_events.ERROR))
+		Expect(event.Message).To(Equal("creating EC2 Instance: InsufficientInstanceCapacity"))
+	})
+
+	It("should keep a warning diagnostic as a message", func() {
+		event, err := tool_events.ParseTerraformEvent([]byte(`{"@level":"warn","@message":"Warning: deprecated","type":"diagnostic","diagnostic":{"severity":"warning","summary":"deprecated"}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Kind).To(Equal(tool_events.MESSAGE))
+		Expect(event.Level).To(Equal(tool_events.LEVEL_WARN))
+	})
+
+	It("should parse the outputs", func() {
+		event, err := tool_events.ParseTerraformEvent([]byte(`{"@level":"info","@message":"Outputs: 1","type":"outputs","outputs":{"public_ip":{"sensitive":false,"type":"string","value":"3.91.10.20"}}}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Kind).To(Equal(tool_events.OUTPUTS))
+		Expect(event.Outputs).To(Equal(map[string]json.RawMessage{"public_ip": json.RawMessage(`"3.91.10.20"`)}))
+	})
+
+	It("should keep unknown types as messages", func() {
+		event, err := tool_events.ParseTerraformEvent([]byte(`{"@level":"info","@message":"Terraform 1.5.7","type":"version","terraform":"1.5.7"}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(event.Kind).To(Equal(tool_events.MESSAGE))
+		Expect(event.Message).To(Equal("Terraform 1.5.7"))
+	})
+
+	It("should return an error for a line that isn't json", func() {
+		_, err := tool_events.ParseTerraformEvent([]byte(`Apply complete!`))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,13 @@
+package tests
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestToolEvents(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ToolEvents Suite", Label("utils", "tool_events"))
+}
@@ -0,0 +1,14 @@
+package url
+
+import "fmt"
+
+// Returns the URL of the Hashicorp checkpoint API reporting the latest version of a tool.
+//
+// Example:
+//
+//	("packer") -> "https://checkpoint-api.hashicorp.com/v1/check/packer"
+func BuildHashicorpCheckpointUrl(
+	name string,
+) string {
+	return fmt.Sprintf("https://checkpoint-api.hashicorp.com/v1/check/%s", name)
+}
@@ -0,0 +1,19 @@
+package url
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Returns the URL of the SHA256SUMS file of a Hashicorp release, listing the checksum of every zip of the release.
+//
+// Example:
+//
+//	("https://releases.hashicorp.com", "packer", "1.7.4") -> "https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_SHA256SUMS"
+func BuildHashicorpSha256SumsUrl(
+	baseUrl,
+	name,
+	version string,
+) string {
+	return fmt.Sprintf("%s/%s/%s/%s_%s_SHA256SUMS", strings.TrimSuffix(baseUrl, "/"), name, version, name, version)
+}
@@ -0,0 +1,16 @@
+package url
+
+import "fmt"
+
+// Returns the URL of the detached signature of the SHA256SUMS file of a Hashicorp release.
+//
+// Example:
+//
+//	("https://releases.hashicorp.com", "packer", "1.7.4") -> "https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_SHA256SUMS.sig"
+func BuildHashicorpSignatureUrl(
+	baseUrl,
+	name,
+	version string,
+) string {
+	return fmt.Sprintf("%s.sig", BuildHashicorpSha256SumsUrl(baseUrl, name, version))
+}
@@ -1,16 +1,20 @@
 package url
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
-// Returns a Hashicorp URL.
+// Returns a Hashicorp URL. The base URL is https://releases.hashicorp.com or a mirror of it.
 //
 // Example:
-// 	("packer", "1.7.4", "windows", "amd64") -> "https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_windows_amd64.zip"
+// 	("https://releases.hashicorp.com", "packer", "1.7.4", "windows", "amd64") -> "https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_windows_amd64.zip"
 func BuildHashicorpUrl(
+	baseUrl,
 	name,
 	version,
 	os,
 	arch string,
 ) string {
-	return fmt.Sprintf("https://releases.hashicorp.com/%s/%s/%s_%s_%s_%s.zip", name, version, name, version, os, arch)
+	return fmt.Sprintf("%s/%s/%s/%s_%s_%s_%s.zip", strings.TrimSuffix(baseUrl, "/"), name, version, name, version, os, arch)
 }
@@ -0,0 +1,29 @@
+package url
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/samber/lo"
+)
+
+// Returns an error when the status of the response isn't one of the expected ones. Only server errors, timeouts
+// and rate limits are worth retrying, i.e a 404 page is never saved as the file.
+func checkStatus(
+	response *http.Response,
+	expectedStatusCodes ...int,
+) error {
+	if lo.Contains(expectedStatusCodes, response.StatusCode) {
+		return nil
+	}
+
+	err := fmt.Errorf("unexpected status %s from %s", response.Status, response.Request.URL)
+
+	if response.StatusCode >= http.StatusInternalServerError ||
+		response.StatusCode == http.StatusRequestTimeout ||
+		response.StatusCode == http.StatusTooManyRequests {
+		return err
+	}
+
+	return permanent(err)
+}
@@ -1,24 +1,33 @@
 package url
 
 import (
+	"fmt"
+	"hash"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/samber/oops"
 )
 
 // Downloads the associated file from the given URL to the given path.
-// Sends the number of bytes downloaded to the given channel.
+// Writes the downloaded bytes to the given hash as well, so the file is hashed while streaming.
+// Sends the number of bytes downloaded to the given channel, a negative number when the download starts over.
+// A partial file left at the path by an interrupted download is resumed with a Range request, failed attempts are
+// retried according to the policy.
 //
 // Example:
-//	("https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_windows_amd64.zip", "/home/dev/download", bytesDownloadedChan) -> nil
+//
+//	("https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_windows_amd64.zip", "/home/dev/download", sha256.New(), bytesDownloadedChan, DefaultRetryPolicy()) -> nil
 func Download(
 	url,
 	path string,
+	checksum hash.Hash,
 	bytesDownloadedChan chan<- int,
-	) error {
+	policy *RetryPolicy,
+) error {
 	oopsBuilder := oops.
 		Code("Download").
 		In("utils").
@@ -27,64 +36,140 @@ func Download(
 		With("path", path).
 		With("bytesDownloadedChan", bytesDownloadedChan)
 
-	// Initiate download and defer closing the response body
-	response, err := http.Get(url)
+	// Create the destination dir
+	destDir := filepath.Dir(path)
+	err := os.MkdirAll(destDir, 0755)
 	if err != nil {
 		err := oopsBuilder.
-			Wrapf(err, "failed to initiate download from: %s", url)
+			Wrapf(err, "failed to create destination directory for: %s", destDir)
 		return err
 	}
-	defer response.Body.Close()
 
-	// Create the destination dir
-	destDir := filepath.Dir(path)
-	err = os.MkdirAll(destDir, 0755)
+	// Open the file to write to, keeping what a previous download left, and defer closing it
+	downloadFile, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0644)
 	if err != nil {
 		err := oopsBuilder.
-			Wrapf(err, "failed to create destination directory for: %s", destDir)
+			Wrapf(err, "failed to open file for: %s", path)
 		return err
 	}
+	defer downloadFile.Close()
 
-	// Create the file to write to and defer closing it
-	downloadFile, err := os.Create(path)
+	// Hash the partial file, the download resumes after it
+	offset, err := io.Copy(checksum, downloadFile)
 	if err != nil {
 		err := oopsBuilder.
-			Wrapf(err, "failed to create file for: %s", path)
+			Wrapf(err, "failed to read the partial file: %s", path)
 		return err
 	}
-	defer downloadFile.Close()
+	if offset > 0 {
+		bytesDownloadedChan <- int(offset)
+	}
 
-	// Iterate over the response body
-	bytesBuffer := make([]byte, 4096)
-	for {
-		// Read the response body into the bytes buffer
-		bytesDownloaded, err := response.Body.Read(bytesBuffer)
-		if err != nil && err != io.EOF {
-			err := oopsBuilder.
-				With("bytesDownloaded", bytesDownloaded).
-				With("bytesBuffer", bytesBuffer).
-				Wrapf(err, "failed to read response body of: %s", url)
-			return err
+	// Throw away the partial file when the server can't resume it
+	startOver := func() error {
+		err := downloadFile.Truncate(0)
+		if err != nil {
+			return permanent(err)
 		}
 
-		if bytesDownloaded == 0 {
-			break
+		_, err = downloadFile.Seek(0, io.SeekStart)
+		if err != nil {
+			return permanent(err)
 		}
 
-		// Send the number of bytes downloaded to the channel
-		bytesDownloadedChan <- bytesDownloaded
+		checksum.Reset()
+		bytesDownloadedChan <- -int(offset)
+		offset = 0
+
+		return nil
+	}
 
-		// Write the bytes to the file
-		bytesWritten, err := downloadFile.Write(bytesBuffer[:bytesDownloaded])
+	err = policy.Retry(func() error {
+		request, err := newRequest(http.MethodGet, url)
 		if err != nil {
-			err := oopsBuilder.
-				With("bytesWritten", bytesWritten).
-				With("bytesBuffer", bytesBuffer).
-				With("bytesDownloaded", bytesDownloaded).
-				Wrapf(err, "failed to write to file: %s", downloadFile.Name())
+			return err
+		}
+
+		if offset > 0 {
+			request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		}
 
+		// Initiate download and defer closing the response body
+		response, err := (&http.Client{Timeout: policy.Timeout}).Do(request)
+		if err != nil {
 			return err
 		}
+		defer response.Body.Close()
+
+		switch {
+		case response.StatusCode == http.StatusRequestedRangeNotSatisfiable:
+			err := startOver()
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("failed to resume %s with range %s", url, request.Header.Get("Range"))
+
+		case response.StatusCode == http.StatusPartialContent &&
+			!strings.HasPrefix(response.Header.Get("Content-Range"), fmt.Sprintf("bytes %d-", offset)):
+			err := startOver()
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("unexpected content range %q from %s", response.Header.Get("Content-Range"), url)
+
+		case response.StatusCode == http.StatusPartialContent:
+
+		default:
+			err := checkStatus(response, http.StatusOK)
+			if err != nil {
+				return err
+			}
+
+			// The server sent the whole file
+			if offset > 0 {
+				err := startOver()
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Iterate over the response body
+		bytesBuffer := make([]byte, 32*1024)
+		for {
+			// Read the response body into the bytes buffer
+			bytesDownloaded, readErr := response.Body.Read(bytesBuffer)
+
+			if bytesDownloaded > 0 {
+				// Write the bytes to the file
+				_, err := downloadFile.Write(bytesBuffer[:bytesDownloaded])
+				if err != nil {
+					return permanent(err)
+				}
+
+				// Never returns an error, see hash.Hash
+				checksum.Write(bytesBuffer[:bytesDownloaded])
+
+				// Send the number of bytes downloaded to the channel
+				bytesDownloadedChan <- bytesDownloaded
+				offset += int64(bytesDownloaded)
+			}
+
+			if readErr == io.EOF {
+				return nil
+			}
+			if readErr != nil {
+				return readErr
+			}
+		}
+	})
+	if err != nil {
+		err := oopsBuilder.
+			With("offset", offset).
+			Wrapf(err, "failed to download from: %s", url)
+		return err
 	}
 
 	return nil
@@ -0,0 +1,56 @@
+package url
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/samber/oops"
+)
+
+// Returns the body of the given URL. Meant for small files, the body is read in memory. Failed attempts are retried
+// according to the default policy.
+//
+// Example:
+//
+//	("https://releases.hashicorp.com/packer/1.7.4/packer_1.7.4_SHA256SUMS") -> []byte("9cd8f2e1...  packer_1.7.4_darwin_amd64.zip\n..."), nil
+func Get(
+	url string,
+) ([]byte, error) {
+	oopsBuilder := oops.
+		Code("Get").
+		In("utils").
+		In("url").
+		With("url", url)
+
+	policy := DefaultRetryPolicy()
+
+	var body []byte
+
+	err := policy.Retry(func() error {
+		request, err := newRequest(http.MethodGet, url)
+		if err != nil {
+			return err
+		}
+
+		response, err := (&http.Client{Timeout: policy.Timeout}).Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		err = checkStatus(response, http.StatusOK)
+		if err != nil {
+			return err
+		}
+
+		body, err = io.ReadAll(response.Body)
+
+		return err
+	})
+	if err != nil {
+		return nil, oopsBuilder.
+			Wrapf(err, "failed to get: %s", url)
+	}
+
+	return body, nil
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/samber/oops"
 )
 
-// Returns the content length of the given URL.
+// Returns the content length of the given URL. Failed attempts are retried according to the default policy.
 //
 // Example:
 //
@@ -19,15 +19,40 @@ func GetContentLength(
 ) {
 	oopsBuilder :=
 		oops.Code("GetContentLength").
+			In("utils").
+			In("url").
 			With("url", url)
 
-	response, err := http.Head(url)
+	policy := DefaultRetryPolicy()
+
+	var contentLength int64
+
+	err := policy.Retry(func() error {
+		request, err := newRequest(http.MethodHead, url)
+		if err != nil {
+			return err
+		}
+
+		response, err := (&http.Client{Timeout: policy.Timeout}).Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		err = checkStatus(response, http.StatusOK)
+		if err != nil {
+			return err
+		}
+
+		contentLength = response.ContentLength
+
+		return nil
+	})
 	if err != nil {
 		err := oopsBuilder.
 			Wrapf(err, "failed to get head response from: %s", url)
 		return 0, err
 	}
-	defer response.Body.Close()
 
-	return response.ContentLength, nil
+	return contentLength, nil
 }
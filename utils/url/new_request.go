@@ -0,0 +1,29 @@
+package url
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Returns a request for the URL, or a permanent error when it isn't an http or https one.
+func newRequest(
+	method,
+	_url string,
+) (*http.Request, error) {
+	parsedUrl, err := url.Parse(_url)
+	if err != nil {
+		return nil, permanent(err)
+	}
+
+	if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https" {
+		return nil, permanent(fmt.Errorf("unsupported url %q, expected an http or https one", _url))
+	}
+
+	request, err := http.NewRequest(method, _url, nil)
+	if err != nil {
+		return nil, permanent(err)
+	}
+
+	return request, nil
+}
@@ -0,0 +1,19 @@
+package url
+
+// An error retrying won't fix, i.e a 404.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Marks the error as one retrying won't fix.
+func permanent(err error) error {
+	return &permanentError{err: err}
+}
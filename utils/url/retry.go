@@ -0,0 +1,39 @@
+package url
+
+import (
+	"errors"
+	"time"
+)
+
+// Runs try until it succeeds, returns a permanent error or runs out of attempts. Returns the last error.
+func (p *RetryPolicy) Retry(
+	try func() error,
+) error {
+	var (
+		err     error
+		backoff = p.Backoff
+	)
+
+	for attempt := 1; ; attempt++ {
+		err = try()
+		if err == nil {
+			return nil
+		}
+
+		var _permanentError *permanentError
+		if errors.As(err, &_permanentError) {
+			return _permanentError.err
+		}
+
+		if attempt >= p.Attempts {
+			return err
+		}
+
+		time.Sleep(backoff)
+
+		backoff *= 2
+		if backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package url
+
+import "time"
+
+// How requests to release servers are retried. The wait between attempts starts at Backoff and doubles after every
+// failed attempt, up to MaxBackoff.
+type RetryPolicy struct {
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Of every attempt, including reading the body. An interrupted download resumes where it stopped.
+	Timeout time.Duration
+}
+
+// Returns the policy of the downloads of kumo.
+//
+// Example:
+//
+//	() -> &RetryPolicy{Attempts: 5, Backoff: time.Second, MaxBackoff: 30 * time.Second, Timeout: 5 * time.Minute}
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		Attempts:   5,
+		Backoff:    time.Second,
+		MaxBackoff: 30 * time.Second,
+		Timeout:    5 * time.Minute,
+	}
+}
@@ -0,0 +1,18 @@
+package tests
+
+import (
+	"github.com/ed3899/kumo/utils/url"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildHashicorpSha256SumsUrl", func() {
+	It("should build the URL of the checksums and of their signature", Label("unit"), func() {
+		Expect(url.BuildHashicorpSha256SumsUrl("https://releases.hashicorp.com", "terraform", "1.5.5")).To(Equal("https://releases.hashicorp.com/terraform/1.5.5/terraform_1.5.5_SHA256SUMS"))
+		Expect(url.BuildHashicorpSignatureUrl("https://releases.hashicorp.com", "terraform", "1.5.5")).To(Equal("https://releases.hashicorp.com/terraform/1.5.5/terraform_1.5.5_SHA256SUMS.sig"))
+	})
+
+	It("should build the URLs of a mirror", Label("unit"), func() {
+		Expect(url.BuildHashicorpSha256SumsUrl("https://artifactory.example.com/hashicorp/", "terraform", "1.5.5")).To(Equal("https://artifactory.example.com/hashicorp/terraform/1.5.5/terraform_1.5.5_SHA256SUMS"))
+	})
+})
@@ -17,7 +17,12 @@ var _ = Describe("BuildHashicorpUrl", func() {
 
 		expectedURL := fmt.Sprintf("https://releases.hashicorp.com/%s/%s/%s_%s_%s_%s.zip", name, version, name, version, os, arch)
 
-		_url := url.BuildHashicorpUrl(name, version, os, arch)
+		_url := url.BuildHashicorpUrl("https://releases.hashicorp.com", name, version, os, arch)
 		Expect(_url).To(Equal(expectedURL))
 	})
+
+	It("should build the URL of a mirror", Label("unit"), func() {
+		_url := url.BuildHashicorpUrl("https://artifactory.example.com/artifactory/hashicorp/", "packer", "1.9.2", "linux", "amd64")
+		Expect(_url).To(Equal("https://artifactory.example.com/artifactory/hashicorp/packer/1.9.2/packer_1.9.2_linux_amd64.zip"))
+	})
 })
@@ -1,12 +1,16 @@
 package tests
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/ed3899/kumo/utils/url"
 	. "github.com/onsi/ginkgo/v2"
@@ -19,8 +23,38 @@ var _ = Describe("Download", func() {
 
 		server *httptest.Server
 		path   string
+		policy *url.RetryPolicy
 	)
 
+	// Downloads while summing up the bytes sent to the channel
+	download := func(_url string) (int, error) {
+		bytesDownloaded := 0
+		bytesDownloadedChan := make(chan int, 1024)
+
+		wg := &sync.WaitGroup{}
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for b := range bytesDownloadedChan {
+				bytesDownloaded += b
+			}
+		}()
+
+		checksum := sha256.New()
+		err := url.Download(_url, path, checksum, bytesDownloadedChan, policy)
+		close(bytesDownloadedChan)
+		wg.Wait()
+
+		if err == nil {
+			expectedChecksum := sha256.Sum256([]byte(content))
+			Expect(checksum.Sum(nil)).To(Equal(expectedChecksum[:]))
+		}
+
+		return bytesDownloaded, err
+	}
+
 	BeforeEach(func() {
 		// Create a test server that returns the content
 		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -29,51 +63,142 @@ var _ = Describe("Download", func() {
 			w.Write([]byte(content))
 		}))
 
-		// Create a temporary file to download to
-		cwd, err := os.Getwd()
-		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(GinkgoT().TempDir(), "test_download_file.txt")
 
-		path = filepath.Join(cwd, "test_download_file.txt")
+		policy = &url.RetryPolicy{
+			Attempts:   3,
+			Backoff:    time.Millisecond,
+			MaxBackoff: 2 * time.Millisecond,
+			Timeout:    time.Second,
+		}
 	})
 
 	AfterEach(func() {
 		server.Close()
-		_ = os.Remove(path)
 	})
 
 	Context("when the URL is valid", Label("unit"), func() {
 		It("should download content from URL and save it to the file", func() {
-			bytesDownloaded := 0
-			bytesDownloadedChan := make(chan int, 1024)
+			bytesDownloaded, err := download(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bytesDownloaded).To(Equal(len(content))) // Length of "test data"
+			Expect(path).To(BeAnExistingFile())
 
-			wg := &sync.WaitGroup{}
-			wg.Add(1)
+			fileContent, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(fileContent)).To(Equal(content))
+		})
 
-			go func() {
-				defer wg.Done()
+		It("should resume a partial file with a Range request", func() {
+			var ranges []string
 
-				for b := range bytesDownloadedChan {
-					bytesDownloaded += b
-				}
-			}()
+			resumingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ranges = append(ranges, r.Header.Get("Range"))
+				http.ServeContent(w, r, "test_download_file.txt", time.Time{}, bytes.NewReader([]byte(content)))
+			}))
+			defer resumingServer.Close()
 
-			err := url.Download(server.URL, path, bytesDownloadedChan)
-			close(bytesDownloadedChan)
-			wg.Wait()
+			Expect(os.WriteFile(path, []byte(content[:4]), 0644)).To(Succeed())
 
+			bytesDownloaded, err := download(resumingServer.URL)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(bytesDownloaded).To(Equal(len(content))) // Length of "test data"
-			Expect(path).To(BeAnExistingFile())
+			Expect(bytesDownloaded).To(Equal(len(content)))
+			Expect(ranges).To(Equal([]string{"bytes=4-"}))
 
 			fileContent, err := os.ReadFile(path)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(string(fileContent)).To(Equal(content))
 		})
+
+		It("should start over when the server doesn't resume", func() {
+			Expect(os.WriteFile(path, []byte("test"), 0644)).To(Succeed())
+
+			bytesDownloaded, err := download(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bytesDownloaded).To(Equal(len(content)))
+
+			fileContent, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(fileContent)).To(Equal(content))
+		})
+
+		It("should retry server errors", func() {
+			var requests atomic.Int32
+
+			flakyServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) < 3 {
+					w.WriteHeader(http.StatusBadGateway)
+					return
+				}
+
+				w.Write([]byte(content))
+			}))
+			defer flakyServer.Close()
+
+			_, err := download(flakyServer.URL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests.Load()).To(Equal(int32(3)))
+		})
+
+		It("should retry a request exceeding the timeout", func() {
+			var requests atomic.Int32
+
+			slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests.Add(1) == 1 {
+					time.Sleep(2 * policy.Timeout)
+				}
+
+				w.Write([]byte(content))
+			}))
+			defer slowServer.Close()
+
+			policy.Timeout = 100 * time.Millisecond
+
+			_, err := download(slowServer.URL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests.Load()).To(Equal(int32(2)))
+		})
+	})
+
+	Context("when the server fails", Label("unit"), func() {
+		It("should not save an error page nor retry it", func() {
+			var requests atomic.Int32
+
+			missingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				http.NotFound(w, r)
+			}))
+			defer missingServer.Close()
+
+			_, err := download(missingServer.URL)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("404"))
+			Expect(requests.Load()).To(Equal(int32(1)))
+
+			fileContent, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fileContent).To(BeEmpty())
+		})
+
+		It("should give up after the last attempt", func() {
+			var requests atomic.Int32
+
+			failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer failingServer.Close()
+
+			_, err := download(failingServer.URL)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("503"))
+			Expect(requests.Load()).To(Equal(int32(policy.Attempts)))
+		})
 	})
 
 	Context("when the URL is invalid", Label("unit"), func() {
 		It("should return an error", func() {
-			err := url.Download("invalid-url", path, nil)
+			err := url.Download("invalid-url", path, sha256.New(), nil, policy)
 			Expect(err).To(HaveOccurred())
 		})
 	})
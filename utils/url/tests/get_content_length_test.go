@@ -25,6 +25,17 @@ var _ = Describe("GetContentLength", func() {
 	})
 
 	Context("when the url is not valid", Label("unit"), func() {
+		It("should return error for a missing file", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.NotFound(w, r)
+			}))
+			defer server.Close()
+
+			_, err := url.GetContentLength(server.URL)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("404"))
+		})
+
 		It("should return error for unsuccessful request", func() {
 			_, err := url.GetContentLength("non-existent-url")
 			Expect(err).To(HaveOccurred())
@@ -0,0 +1,48 @@
+package tests
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/ed3899/kumo/utils/url"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Get", func() {
+	var (
+		server *httptest.Server
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/SHA256SUMS" {
+				http.NotFound(w, r)
+				return
+			}
+
+			w.Write([]byte("test data"))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should return the body", Label("unit"), func() {
+		body, err := url.Get(server.URL + "/SHA256SUMS")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(Equal("test data"))
+	})
+
+	It("should return an error for a status other than 200", Label("unit"), func() {
+		_, err := url.Get(server.URL + "/missing")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("404"))
+	})
+
+	It("should return an error for an invalid url", Label("unit"), func() {
+		_, err := url.Get("invalid-url")
+		Expect(err).To(HaveOccurred())
+	})
+})
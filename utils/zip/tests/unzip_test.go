@@ -81,8 +81,12 @@ var _ = Describe("UnzipFile", func() {
 			mockZipReader, err = zip.NewReader(bytes.NewReader(mockZipFile.Bytes()), int64(mockZipFile.Len()))
 			Expect(err).NotTo(HaveOccurred())
 
+			// A directory can't be created under a regular file, on any OS and even as root
+			regularFile := filepath.Join(GinkgoT().TempDir(), "regular_file")
+			Expect(os.WriteFile(regularFile, []byte(content), 0644)).To(Succeed())
+
 			// Unzip the mock file
-			_, err = utils_zip.UnzipFile(mockZipReader.File[0], ":invalid_dir")
+			_, err = utils_zip.UnzipFile(mockZipReader.File[0], filepath.Join(regularFile, "invalid_dir"))
 			Expect(err).To(HaveOccurred())
 		})
 	})